@@ -3,56 +3,350 @@ package api
 import (
 	"context"
 	"encoding/json"
+	"log/slog"
 	"net/http"
 	"simple_twitter/models"
+	"slices"
 	"time"
 
 	"strconv"
+
+	"go.opentelemetry.io/otel/trace"
 )
 
 type TwitterService interface {
-	CreateTweet(ctx context.Context, message string, tag string) (models.Tweet, error)
-	ListTweets(ctx context.Context, tag string, offset int, limit int) ([]models.Tweet, error)
+	CreateTweet(ctx context.Context, authorID *int64, message string, tags []string) (models.Tweet, error)
+	GetTweet(ctx context.Context, id int64) (models.Tweet, error)
+	EditTweet(ctx context.Context, editor *models.Principal, id int64, patch models.TweetPatch) (models.Tweet, error)
+	ListRevisions(ctx context.Context, id int64) (models.TweetRevisions, error)
+	DeleteTweet(ctx context.Context, editor *models.Principal, id int64) error
+	ListTweets(ctx context.Context, query models.TweetQuery) (models.TweetPage, error)
+	SearchTweets(ctx context.Context, query models.SearchQuery) (models.SearchPage, error)
 	AggregateTweets(ctx context.Context, from time.Time, to time.Time, groupBy string) (models.AggregatedTweets, error)
+
+	CreateUser(ctx context.Context, handle string, displayName string) (models.User, error)
+	GetUser(ctx context.Context, handle string) (models.User, error)
+	ListUserTweets(ctx context.Context, handle string, query models.TweetQuery) (models.TweetPage, error)
+}
+
+type Option func(*server)
+
+// WithAuthentication requires requests to authenticate with a bearer token granting the scope the route requires,
+// except for requests to anonymousRoutes without a token. Routes are given by the pattern they're registered
+// with, e.g `GET /tweets`.
+func WithAuthentication(authenticator Authenticator, anonymousRoutes ...string) Option {
+	return func(s *server) {
+		s.authenticator = authenticator
+		s.anonymousRoutes = anonymousRoutes
+	}
+}
+
+type server struct {
+	logger  *slog.Logger
+	metrics Metrics
+	tracer  trace.Tracer
+	health  *Health
+
+	timeouts     Timeouts
+	maxBodyBytes int64
+
+	authenticator   Authenticator
+	anonymousRoutes []string
+
+	rateLimitStore RateLimitStore
+	rateLimits     map[string]RateLimit
+	proxyHeader    string
+	proxyHops      int
 }
 
-func NewServer(addr string, twitter TwitterService) http.Server {
+func NewServer(addr string, twitter TwitterService, options ...Option) http.Server {
+	s := server{logger: slog.Default(), tracer: defaultTracer(), health: NewHealth(), timeouts: DefaultTimeouts, maxBodyBytes: MAX_BODY_BYTES}
+	for _, option := range options {
+		option(&s)
+	}
+
 	var mux http.ServeMux
-	mux.HandleFunc("POST /tweets", createTweet(twitter))
-	mux.HandleFunc("GET /tweets", listTweets(twitter))
-	mux.HandleFunc("GET /tweets/_aggregate", aggregateTweets(twitter))
+	handle := func(pattern string, scope models.Scope, handler http.HandlerFunc) {
+		mux.HandleFunc(pattern, s.authenticate(pattern, scope, s.rateLimit(pattern, s.limitBody(handler))))
+	}
+
+	handle("POST /tweets", models.ScopeTweetsWrite, createTweet(twitter, s.authorOf))
+	handle("GET /tweets", models.ScopeTweetsRead, listTweets(twitter))
+	handle("GET /tweets/{id}", models.ScopeTweetsRead, getTweet(twitter))
+	handle("PATCH /tweets/{id}", models.ScopeTweetsWrite, editTweet(twitter, s.editorOf))
+	handle("GET /tweets/{id}/revisions", models.ScopeTweetsRead, listRevisions(twitter))
+	handle("DELETE /tweets/{id}", models.ScopeTweetsWrite, deleteTweet(twitter, s.editorOf))
+	handle("GET /tweets/_aggregate", models.ScopeAdminStats, aggregateTweets(twitter))
+	handle("GET /tweets/_search", models.ScopeTweetsRead, searchTweets(twitter))
+	handle("POST /users", models.ScopeUsersWrite, createUser(twitter))
+	handle("GET /users/{handle}", models.ScopeUsersRead, getUser(twitter))
+	handle("GET /users/{handle}/tweets", models.ScopeTweetsRead, listUserTweets(twitter))
+
+	// Probes are made by the orchestrator, which doesn't authenticate
+	mux.HandleFunc("GET /healthz", healthz())
+	mux.HandleFunc("GET /readyz", readyz(s.health))
 	return http.Server{
-		Addr:    addr,
-		Handler: &mux,
+		Addr:              addr,
+		Handler:           s.observeRequests(&mux),
+		ReadHeaderTimeout: s.timeouts.ReadHeader,
+		ReadTimeout:       s.timeouts.Read,
+		WriteTimeout:      s.timeouts.Write,
+		IdleTimeout:       s.timeouts.Idle,
+	}
+}
+
+func createTweet(twitter TwitterService, authorOf func(*http.Request, *int64) (*int64, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var t models.CreateTweetRequest
+		err := decodeJSON(r, &t)
+		if err != nil {
+			handleError(err, w, r)
+			return
+		}
+
+		// Clients that predate multiple tags send a single `tag`
+		tags := t.Tags
+		if t.Tag != "" && !slices.Contains(tags, t.Tag) {
+			tags = append([]string{t.Tag}, tags...)
+		}
+
+		authorID, err := authorOf(r, t.AuthorID)
+		if err != nil {
+			handleError(err, w, r)
+			return
+		}
+
+		tweet, err := twitter.CreateTweet(r.Context(), authorID, t.Message, tags)
+		if err != nil {
+			handleError(err, w, r)
+			return
+		}
+
+		writeJSONResponse(http.StatusCreated, tweet, w, r)
+	}
+}
+
+func getTweet(twitter TwitterService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+		if err != nil {
+			handleError(models.ErrInvalidWithCause("`id` must be an integer value", err), w, r)
+			return
+		}
+
+		tweet, err := twitter.GetTweet(r.Context(), id)
+		if err != nil {
+			handleError(err, w, r)
+			return
+		}
+
+		writeJSONResponse(http.StatusOK, tweet, w, r)
+	}
+}
+
+func editTweet(twitter TwitterService, editorOf func(*http.Request) *models.Principal) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+		if err != nil {
+			handleError(models.ErrInvalidWithCause("`id` must be an integer value", err), w, r)
+			return
+		}
+
+		var patch models.TweetPatch
+		err = decodeJSON(r, &patch)
+		if err != nil {
+			handleError(err, w, r)
+			return
+		}
+
+		tweet, err := twitter.EditTweet(r.Context(), editorOf(r), id, patch)
+		if err != nil {
+			handleError(err, w, r)
+			return
+		}
+
+		writeJSONResponse(http.StatusOK, tweet, w, r)
 	}
 }
 
-func createTweet(twitter TwitterService) http.HandlerFunc {
+func listRevisions(twitter TwitterService) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		var t models.Tweet
-		err := json.NewDecoder(r.Body).Decode(&t)
+		id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
 		if err != nil {
-			handleError(models.ErrInvalidWithCause("failed to parse request body", err), w, r)
+			handleError(models.ErrInvalidWithCause("`id` must be an integer value", err), w, r)
 			return
 		}
 
-		tweet, err := twitter.CreateTweet(r.Context(), t.Message, t.Tag)
+		revisions, err := twitter.ListRevisions(r.Context(), id)
 		if err != nil {
 			handleError(err, w, r)
 			return
 		}
 
-		writeJSONResponse(http.StatusCreated, tweet, w)
+		writeJSONResponse(http.StatusOK, revisions, w, r)
+	}
+}
+
+func deleteTweet(twitter TwitterService, editorOf func(*http.Request) *models.Principal) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+		if err != nil {
+			handleError(models.ErrInvalidWithCause("`id` must be an integer value", err), w, r)
+			return
+		}
+
+		err = twitter.DeleteTweet(r.Context(), editorOf(r), id)
+		if err != nil {
+			handleError(err, w, r)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
 	}
 }
 
 func listTweets(twitter TwitterService) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		var (
-			tag    = r.URL.Query().Get("tag")
-			offset = 0
-			limit  = 50
-		)
+		query, err := parseTweetQuery(r)
+		if err != nil {
+			handleError(err, w, r)
+			return
+		}
+
+		page, err := twitter.ListTweets(r.Context(), query)
+		if err != nil {
+			handleError(err, w, r)
+			return
+		}
+
+		writeJSONResponse(http.StatusOK, page, w, r)
+	}
+}
+
+func createUser(twitter TwitterService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var u models.CreateUserRequest
+		err := decodeJSON(r, &u)
+		if err != nil {
+			handleError(err, w, r)
+			return
+		}
+
+		user, err := twitter.CreateUser(r.Context(), u.Handle, u.DisplayName)
+		if err != nil {
+			handleError(err, w, r)
+			return
+		}
+
+		writeJSONResponse(http.StatusCreated, user, w, r)
+	}
+}
+
+func getUser(twitter TwitterService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		user, err := twitter.GetUser(r.Context(), r.PathValue("handle"))
+		if err != nil {
+			handleError(err, w, r)
+			return
+		}
+
+		writeJSONResponse(http.StatusOK, user, w, r)
+	}
+}
+
+func listUserTweets(twitter TwitterService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query, err := parseTweetQuery(r)
+		if err != nil {
+			handleError(err, w, r)
+			return
+		}
+
+		page, err := twitter.ListUserTweets(r.Context(), r.PathValue("handle"), query)
+		if err != nil {
+			handleError(err, w, r)
+			return
+		}
+
+		writeJSONResponse(http.StatusOK, page, w, r)
+	}
+}
+
+// parseTweetQuery parses the query parameters shared by the endpoints listing tweets
+func parseTweetQuery(r *http.Request) (models.TweetQuery, error) {
+	query := models.TweetQuery{
+		Tag:   r.URL.Query().Get("tag"),
+		Sort:  models.SortOrder(r.URL.Query().Get("sort")),
+		Limit: 50,
+	}
+
+	if r.URL.Query().Has("since") {
+		t, err := time.Parse(time.RFC3339, r.URL.Query().Get("since"))
+		if err != nil {
+			return models.TweetQuery{}, models.ErrInvalidWithCause("`since` must be a valid timestamp (RFC 3339)", err)
+		}
+		query.Since = t
+	}
+
+	if r.URL.Query().Has("until") {
+		t, err := time.Parse(time.RFC3339, r.URL.Query().Get("until"))
+		if err != nil {
+			return models.TweetQuery{}, models.ErrInvalidWithCause("`until` must be a valid timestamp (RFC 3339)", err)
+		}
+		query.Until = t
+	}
+
+	if r.URL.Query().Has("since_id") {
+		id, err := strconv.ParseInt(r.URL.Query().Get("since_id"), 10, 64)
+		if err != nil {
+			return models.TweetQuery{}, models.ErrInvalidWithCause("`since_id` must be an integer value", err)
+		}
+		query.SinceID = id
+	}
+
+	if r.URL.Query().Has("max_id") {
+		id, err := strconv.ParseInt(r.URL.Query().Get("max_id"), 10, 64)
+		if err != nil {
+			return models.TweetQuery{}, models.ErrInvalidWithCause("`max_id` must be an integer value", err)
+		}
+		query.MaxID = id
+	}
+
+	if r.URL.Query().Has("cursor") {
+		var cursor models.Cursor
+		err := cursor.UnmarshalText([]byte(r.URL.Query().Get("cursor")))
+		if err != nil {
+			return models.TweetQuery{}, models.ErrInvalidWithCause("`cursor` must be a cursor returned as `next_cursor`", err)
+		}
+		query.After = &cursor
+	}
+
+	if r.URL.Query().Has("offset") {
+		o, err := strconv.Atoi(r.URL.Query().Get("offset"))
+		if err != nil {
+			return models.TweetQuery{}, models.ErrInvalidWithCause("`offset` must be an integer value", err)
+		}
+		query.Offset = o
+	}
+
+	if r.URL.Query().Has("limit") {
+		l, err := strconv.Atoi(r.URL.Query().Get("limit"))
+		if err != nil {
+			return models.TweetQuery{}, models.ErrInvalidWithCause("`limit` must be an integer value", err)
+		}
+		query.Limit = l
+	}
+
+	return query, nil
+}
+
+func searchTweets(twitter TwitterService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query := models.SearchQuery{
+			Text:  r.URL.Query().Get("q"),
+			Tag:   r.URL.Query().Get("tag"),
+			Limit: 50,
+		}
 
 		if r.URL.Query().Has("offset") {
 			o, err := strconv.Atoi(r.URL.Query().Get("offset"))
@@ -60,7 +354,7 @@ func listTweets(twitter TwitterService) http.HandlerFunc {
 				handleError(models.ErrInvalidWithCause("`offset` must be an integer value", err), w, r)
 				return
 			}
-			offset = o
+			query.Offset = o
 		}
 
 		if r.URL.Query().Has("limit") {
@@ -69,16 +363,16 @@ func listTweets(twitter TwitterService) http.HandlerFunc {
 				handleError(models.ErrInvalidWithCause("`limit` must be an integer value", err), w, r)
 				return
 			}
-			limit = l
+			query.Limit = l
 		}
 
-		tweets, err := twitter.ListTweets(r.Context(), tag, offset, limit)
+		page, err := twitter.SearchTweets(r.Context(), query)
 		if err != nil {
 			handleError(err, w, r)
 			return
 		}
 
-		writeJSONResponse(http.StatusOK, tweets, w)
+		writeJSONResponse(http.StatusOK, page, w, r)
 	}
 }
 
@@ -114,7 +408,7 @@ func aggregateTweets(twitter TwitterService) http.HandlerFunc {
 			return
 		}
 
-		writeJSONResponse(http.StatusOK, tweets, w)
+		writeJSONResponse(http.StatusOK, tweets, w, r)
 	}
 }
 
@@ -126,18 +420,40 @@ func handleError(err error, w http.ResponseWriter, r *http.Request) {
 			statusCode = http.StatusNotFound
 		case models.ErrKindInvalid:
 			statusCode = http.StatusBadRequest
+		case models.ErrKindGone:
+			statusCode = http.StatusGone
+		case models.ErrKindConflict:
+			statusCode = http.StatusConflict
+		case models.ErrKindUnauthenticated:
+			statusCode = http.StatusUnauthorized
+			w.Header().Set("WWW-Authenticate", `Bearer realm="simple_twitter"`)
+		case models.ErrKindForbidden:
+			statusCode = http.StatusForbidden
+		case models.ErrKindRateLimited:
+			statusCode = http.StatusTooManyRequests
+		case models.ErrKindUnavailable:
+			statusCode = http.StatusServiceUnavailable
+		case models.ErrKindTooLarge:
+			statusCode = http.StatusRequestEntityTooLarge
+		case models.ErrKindUnsupportedMediaType:
+			statusCode = http.StatusUnsupportedMediaType
+		case models.ErrKindUnsupported:
+			statusCode = http.StatusNotImplemented
 		}
 	}
 
-	writeJSONResponse(statusCode, err, w)
+	logError(r.Context(), statusCode, err)
+	observeError(r.Context(), err)
+	writeJSONResponse(statusCode, err, w, r)
 }
 
-func writeJSONResponse(statusCode int, response interface{}, w http.ResponseWriter) {
+func writeJSONResponse(statusCode int, response interface{}, w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(statusCode)
 
 	err := json.NewEncoder(w).Encode(response)
 	if err != nil {
-		// Todo(frode): Nothing much to do but log here
+		// The status code is already sent, so there's nothing to do but log that the response is cut short
+		LoggerFromContext(r.Context()).ErrorContext(r.Context(), "failed to write response", slog.String("error", err.Error()))
 	}
 }
@@ -0,0 +1,107 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"simple_twitter/models"
+	"slices"
+	"strings"
+)
+
+// DefaultAnonymousRoutes are the routes that only read tweets and users, which can be requested without a token
+// unless configured otherwise
+var DefaultAnonymousRoutes = []string{
+	"GET /tweets",
+	"GET /tweets/{id}",
+	"GET /tweets/{id}/revisions",
+	"GET /tweets/_search",
+	"GET /users/{handle}",
+	"GET /users/{handle}/tweets",
+}
+
+// Authenticator authenticates the bearer tokens requests are made with
+type Authenticator interface {
+	Authenticate(ctx context.Context, token string) (models.Principal, error)
+}
+
+type principalKey struct{}
+
+// PrincipalFromContext returns the principal an authenticated request is made on behalf of
+func PrincipalFromContext(ctx context.Context) (models.Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(models.Principal)
+	return principal, ok
+}
+
+// authenticate authenticates requests to the route registered with pattern by their bearer token, and attaches the
+// principal to the request context. Requests without a token are rejected unless the route is anonymous, while
+// requests with an invalid token, or a token without scope, are always rejected.
+func (s server) authenticate(pattern string, scope models.Scope, next http.HandlerFunc) http.HandlerFunc {
+	if s.authenticator == nil {
+		return next
+	}
+
+	anonymous := slices.Contains(s.anonymousRoutes, pattern)
+	return func(w http.ResponseWriter, r *http.Request) {
+		authorization := r.Header.Get("Authorization")
+		if authorization == "" {
+			if !anonymous {
+				handleError(models.ErrUnauthenticated("a bearer token is required"), w, r)
+				return
+			}
+
+			next(w, r)
+			return
+		}
+
+		scheme, token, ok := strings.Cut(authorization, " ")
+		if !ok || !strings.EqualFold(scheme, "Bearer") {
+			handleError(models.ErrUnauthenticated("`Authorization` must be a bearer token"), w, r)
+			return
+		}
+
+		principal, err := s.authenticator.Authenticate(r.Context(), strings.TrimSpace(token))
+		if err != nil {
+			handleError(err, w, r)
+			return
+		}
+
+		if !principal.HasScope(scope) {
+			handleError(models.ErrForbiddenf("the token doesn't have the `%s` scope", scope), w, r)
+			return
+		}
+
+		next(w, r.WithContext(context.WithValue(r.Context(), principalKey{}, principal)))
+	}
+}
+
+// authorOf returns the user a request posts a tweet on behalf of, given the `author_id` the client asked for. Tokens
+// acting on behalf of a user post as that user, and only tokens with the `tweets:moderate` scope can post as
+// someone else. Without authentication every request is trusted to post as whoever it asks for.
+func (s server) authorOf(r *http.Request, requested *int64) (*int64, error) {
+	if s.authenticator == nil {
+		return requested, nil
+	}
+
+	principal, _ := PrincipalFromContext(r.Context())
+	if requested == nil {
+		return principal.UserID, nil
+	}
+
+	if principal.HasScope(models.ScopeTweetsModerate) || (principal.UserID != nil && *principal.UserID == *requested) {
+		return requested, nil
+	}
+
+	return nil, models.ErrForbiddenf("posting as another user requires the `%s` scope", models.ScopeTweetsModerate)
+}
+
+// editorOf returns the principal a request changes tweets on behalf of, see twitter.Twitter.EditTweet. Requests
+// without a token to routes that allow it act on behalf of nobody, who can't change any tweet. Without
+// authentication there is no principal, and every request is trusted to change every tweet.
+func (s server) editorOf(r *http.Request) *models.Principal {
+	if s.authenticator == nil {
+		return nil
+	}
+
+	principal, _ := PrincipalFromContext(r.Context())
+	return &principal
+}
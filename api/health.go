@@ -0,0 +1,83 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"simple_twitter/models"
+	"sync/atomic"
+	"time"
+)
+
+// READINESS_TIMEOUT is how long the dependencies of the server have to respond to a readiness probe
+const READINESS_TIMEOUT = 2 * time.Second
+
+// Pinger is a dependency of the server that has to be reachable for it to serve requests, e.g a database
+type Pinger interface {
+	PingContext(ctx context.Context) error
+}
+
+// Health tracks whether the server is ready to serve requests, for an orchestrator to probe with `GET /readyz`
+type Health struct {
+	pingers  []Pinger
+	draining atomic.Bool
+}
+
+// Drain fails readiness probes from now on, so the orchestrator stops routing requests to the server before
+// it's shut down
+func (h *Health) Drain() {
+	h.draining.Store(true)
+}
+
+// Ready returns an error if the server is draining or one of its dependencies can't be reached
+func (h *Health) Ready(ctx context.Context) error {
+	if h.draining.Load() {
+		return models.ErrUnavailable("the server is shutting down")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, READINESS_TIMEOUT)
+	defer cancel()
+
+	for _, pinger := range h.pingers {
+		if err := pinger.PingContext(ctx); err != nil {
+			return models.ErrUnavailableWithCause("the database can't be reached", err)
+		}
+	}
+
+	return nil
+}
+
+// NewHealth creates the health of a server that depends on pingers being reachable
+func NewHealth(pingers ...Pinger) *Health {
+	return &Health{pingers: pingers}
+}
+
+// WithHealth serves readiness probes with health, the server is ready as long as it's running otherwise
+func WithHealth(health *Health) Option {
+	return func(s *server) {
+		s.health = health
+	}
+}
+
+type status struct {
+	Status string `json:"status"`
+}
+
+// healthz responds as long as the server is running, for liveness probes
+func healthz() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		writeJSONResponse(http.StatusOK, status{Status: "ok"}, w, r)
+	}
+}
+
+// readyz responds with `503 Service Unavailable` while the server can't serve requests, for readiness probes
+func readyz(health *Health) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		err := health.Ready(r.Context())
+		if err != nil {
+			handleError(err, w, r)
+			return
+		}
+
+		writeJSONResponse(http.StatusOK, status{Status: "ok"}, w, r)
+	}
+}
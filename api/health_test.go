@@ -0,0 +1,126 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"simple_twitter/models"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testPinger struct {
+	err error
+}
+
+func (p *testPinger) PingContext(ctx context.Context) error {
+	return p.err
+}
+
+func TestHealth(t *testing.T) {
+	var (
+		assert = assert.New(t)
+		db     = &testPinger{}
+		health = NewHealth(db)
+		server = NewServer("", nil, WithHealth(health))
+	)
+
+	probe := func(path string) int {
+		res := httptest.NewRecorder()
+		server.Handler.ServeHTTP(res, httptest.NewRequest(http.MethodGet, path, nil))
+		return res.Code
+	}
+
+	assert.Equal(http.StatusOK, probe("/healthz"))
+	assert.Equal(http.StatusOK, probe("/readyz"))
+
+	db.err = errors.New("connection refused")
+	assert.Equal(http.StatusOK, probe("/healthz"), "Expected the server to be alive without its database")
+	assert.Equal(http.StatusServiceUnavailable, probe("/readyz"), "Expected the server to not be ready without its database")
+
+	db.err = nil
+	health.Drain()
+	assert.Equal(http.StatusOK, probe("/healthz"), "Expected the server to be alive while draining")
+	assert.Equal(http.StatusServiceUnavailable, probe("/readyz"), "Expected the server to not be ready while draining")
+
+	var e models.Error
+	if assert.ErrorAs(health.Ready(context.Background()), &e) {
+		assert.Equal(models.ErrKindUnavailable, e.Kind)
+	}
+}
+
+// slowTwitter gets tweets once it's released, to keep requests in flight
+type slowTwitter struct {
+	TwitterService
+	started chan struct{}
+	release chan struct{}
+}
+
+func (t slowTwitter) GetTweet(ctx context.Context, id int64) (models.Tweet, error) {
+	t.started <- struct{}{}
+	<-t.release
+	return models.Tweet{ID: id}, nil
+}
+
+func TestDrain(t *testing.T) {
+	var (
+		require = require.New(t)
+		assert  = assert.New(t)
+		twitter = slowTwitter{started: make(chan struct{}), release: make(chan struct{})}
+		health  = NewHealth()
+		server  = NewServer("", twitter, WithHealth(health), WithLogger(slog.New(slog.DiscardHandler)))
+	)
+
+	ts := httptest.NewUnstartedServer(server.Handler)
+	ts.Config = &server
+	ts.Start()
+	defer ts.Close()
+
+	// Connections the client dials ahead of time would hold up shutting down until they time out
+	client := &http.Client{Transport: &http.Transport{DisableKeepAlives: true}}
+
+	inFlight := make(chan int)
+	go func() {
+		res, err := client.Get(ts.URL + "/tweets/1")
+		if err != nil {
+			inFlight <- 0
+			return
+		}
+		res.Body.Close()
+		inFlight <- res.StatusCode
+	}()
+	<-twitter.started
+
+	health.Drain()
+
+	res, err := client.Get(ts.URL + "/readyz")
+	require.NoError(err)
+	res.Body.Close()
+	assert.Equal(http.StatusServiceUnavailable, res.StatusCode, "Expected the server to not be ready while draining")
+
+	res, err = client.Get(ts.URL + "/healthz")
+	require.NoError(err)
+	res.Body.Close()
+	assert.Equal(http.StatusOK, res.StatusCode, "Expected the server to keep serving while draining")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	shutdown := make(chan error)
+	go func() { shutdown <- server.Shutdown(ctx) }()
+
+	select {
+	case <-shutdown:
+		t.Fatal("Expected shutting down to wait for the requests in flight")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(twitter.release)
+	assert.Equal(http.StatusOK, <-inFlight, "Expected the request in flight to be served")
+	assert.NoError(<-shutdown, "Expected the server to shut down once the requests in flight are served")
+}
@@ -0,0 +1,216 @@
+package api
+
+import (
+	"bytes"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// verificationKey is a key JWTs signed with alg can be verified with
+type verificationKey struct {
+	id  string // The `kid` of the key, empty for keys loaded from PEM
+	alg string
+	key any // *rsa.PublicKey for RS256, *ecdsa.PublicKey for ES256 and []byte for HS256
+}
+
+// jsonWebKey is a key of a JSON Web Key Set (RFC 7517), with the members of the key types that are supported
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+
+	N string `json:"n"` // RSA
+	E string `json:"e"`
+
+	Crv string `json:"crv"` // EC
+	X   string `json:"x"`
+	Y   string `json:"y"`
+
+	K string `json:"k"` // Symmetric
+}
+
+// parseKeys parses the verification keys of a JSON Web Key Set or of one or more PEM blocks. Keys of a JWKS that
+// can't verify any of the supported algorithms are skipped, as key sets published by identity providers often
+// hold more keys than one service uses, but it's an error if no key is left.
+func parseKeys(data []byte) ([]verificationKey, error) {
+	var (
+		keys []verificationKey
+		err  error
+	)
+
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		keys, err = parseJWKS(data)
+	} else {
+		keys, err = parsePEM(data)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(keys) == 0 {
+		return nil, errors.New("found no keys for any of the supported algorithms [RS256, ES256, HS256]")
+	}
+
+	return keys, nil
+}
+
+func parseJWKS(data []byte) ([]verificationKey, error) {
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+
+	err := json.Unmarshal(data, &set)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse JWKS: %w", err)
+	}
+
+	var keys []verificationKey
+	for idx, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+
+		key, alg, err := jwk.verificationKey()
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %d of JWKS: %w", idx, err)
+		}
+
+		if key == nil || (jwk.Alg != "" && jwk.Alg != alg) {
+			continue
+		}
+
+		keys = append(keys, verificationKey{id: jwk.Kid, alg: alg, key: key})
+	}
+
+	return keys, nil
+}
+
+// verificationKey returns the key and the algorithm it verifies, or a nil key if the key type isn't supported
+func (jwk jsonWebKey) verificationKey() (any, string, error) {
+	switch jwk.Kty {
+	case "RSA":
+		n, err := decodeSegment(jwk.N)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid `n`: %w", err)
+		}
+
+		e, err := decodeSegment(jwk.E)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid `e`: %w", err)
+		}
+
+		if len(n) == 0 || len(e) == 0 || len(e) > 4 {
+			return nil, "", errors.New("invalid RSA public key")
+		}
+
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, "RS256", nil
+
+	case "EC":
+		if jwk.Crv != "P-256" {
+			return nil, "", nil
+		}
+
+		x, err := decodeSegment(jwk.X)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid `x`: %w", err)
+		}
+
+		y, err := decodeSegment(jwk.Y)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid `y`: %w", err)
+		}
+
+		if len(x) != 32 || len(y) != 32 {
+			return nil, "", errors.New("invalid P-256 public key")
+		}
+
+		// Reject points that aren't on the curve
+		_, err = ecdh.P256().NewPublicKey(append(append([]byte{4}, x...), y...))
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid P-256 public key: %w", err)
+		}
+
+		return &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, "ES256", nil
+
+	case "oct":
+		k, err := decodeSegment(jwk.K)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid `k`: %w", err)
+		}
+
+		if len(k) < 32 {
+			return nil, "", errors.New("HS256 keys must be at least 256 bits")
+		}
+
+		return k, "HS256", nil
+
+	default:
+		return nil, "", nil
+	}
+}
+
+func parsePEM(data []byte) ([]verificationKey, error) {
+	var keys []verificationKey
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+
+		var (
+			key any
+			err error
+		)
+
+		switch block.Type {
+		case "PUBLIC KEY":
+			key, err = x509.ParsePKIXPublicKey(block.Bytes)
+		case "RSA PUBLIC KEY":
+			key, err = x509.ParsePKCS1PublicKey(block.Bytes)
+		case "CERTIFICATE":
+			var certificate *x509.Certificate
+			certificate, err = x509.ParseCertificate(block.Bytes)
+			if err == nil {
+				key = certificate.PublicKey
+			}
+		default:
+			return nil, fmt.Errorf("unsupported PEM block %q, must be one of [PUBLIC KEY, RSA PUBLIC KEY, CERTIFICATE]", block.Type)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse PEM block %q: %w", block.Type, err)
+		}
+
+		switch key := key.(type) {
+		case *rsa.PublicKey:
+			keys = append(keys, verificationKey{alg: "RS256", key: key})
+		case *ecdsa.PublicKey:
+			if key.Curve != elliptic.P256() {
+				return nil, fmt.Errorf("unsupported curve %s, ES256 keys must be P-256", key.Curve.Params().Name)
+			}
+			keys = append(keys, verificationKey{alg: "ES256", key: key})
+		default:
+			return nil, fmt.Errorf("unsupported public key of type %T", key)
+		}
+	}
+
+	return keys, nil
+}
+
+// decodeSegment decodes the base64url encoded members of a JWK, which shouldn't be padded but sometimes are
+func decodeSegment(segment string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(segment, "="))
+}
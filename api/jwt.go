@@ -0,0 +1,182 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"simple_twitter/models"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// JWTConfig configures how JWTs issued by an identity provider are verified
+type JWTConfig struct {
+	KeysFile string // JWKS or PEM file holding the keys JWTs are signed with
+	Issuer   string // Required `iss` of JWTs, not checked if empty
+	Audience string // Required among the `aud` of JWTs, not checked if empty
+
+	// UserClaim names the claim holding the handle of the user a JWT acts on behalf of, if any
+	UserClaim string
+
+	// Leeway allows for clock skew between the identity provider and the server when checking `exp` and `nbf`
+	Leeway time.Duration
+}
+
+// UserGetter gets users by their handle
+type UserGetter interface {
+	GetUser(ctx context.Context, handle string) (models.User, error)
+}
+
+// JWTAuthenticator authenticates bearer tokens that are JWTs signed with RS256, ES256 or HS256. The principal is
+// the `sub` of the JWT, and its scopes are the known scopes of the space separated `scope` claim or the `scp` claim.
+type JWTAuthenticator struct {
+	config JWTConfig
+	users  UserGetter
+
+	mu   sync.RWMutex
+	keys []verificationKey
+}
+
+// Reload loads the keys from the keys file again, e.g after they're rotated. The keys in use are kept if the file
+// can't be loaded.
+func (a *JWTAuthenticator) Reload() error {
+	data, err := os.ReadFile(a.config.KeysFile)
+	if err != nil {
+		return fmt.Errorf("failed to read JWT keys: %w", err)
+	}
+
+	keys, err := parseKeys(data)
+	if err != nil {
+		return fmt.Errorf("failed to load JWT keys from %s: %w", a.config.KeysFile, err)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.keys = keys
+	return nil
+}
+
+func (a *JWTAuthenticator) Authenticate(ctx context.Context, token string) (models.Principal, error) {
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{"RS256", "ES256", "HS256"}),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(a.config.Leeway),
+	}
+
+	if a.config.Issuer != "" {
+		options = append(options, jwt.WithIssuer(a.config.Issuer))
+	}
+
+	if a.config.Audience != "" {
+		options = append(options, jwt.WithAudience(a.config.Audience))
+	}
+
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(token, claims, a.keyFor, options...)
+	switch {
+	case errors.Is(err, jwt.ErrTokenExpired):
+		return models.Principal{}, models.ErrWithCause(models.ErrKindUnauthenticated, "token has expired", err)
+	case errors.Is(err, jwt.ErrTokenNotValidYet):
+		return models.Principal{}, models.ErrWithCause(models.ErrKindUnauthenticated, "token isn't valid yet", err)
+	case errors.Is(err, jwt.ErrTokenInvalidIssuer):
+		return models.Principal{}, models.ErrWithCause(models.ErrKindUnauthenticated, "token was issued by another issuer", err)
+	case errors.Is(err, jwt.ErrTokenInvalidAudience):
+		return models.Principal{}, models.ErrWithCause(models.ErrKindUnauthenticated, "token was issued for another audience", err)
+	case err != nil:
+		return models.Principal{}, models.ErrWithCause(models.ErrKindUnauthenticated, "invalid token", err)
+	}
+
+	subject, err := claims.GetSubject()
+	if err != nil || subject == "" {
+		return models.Principal{}, models.ErrUnauthenticated("token has no `sub`")
+	}
+
+	principal := models.Principal{Name: subject, Scopes: scopesOf(claims)}
+
+	if a.config.UserClaim != "" && a.users != nil {
+		if handle, ok := claims[a.config.UserClaim].(string); ok && handle != "" {
+			user, err := a.users.GetUser(ctx, handle)
+			if e, ok := err.(models.Error); ok && (e.Kind == models.ErrKindMissing || e.Kind == models.ErrKindInvalid) {
+				return models.Principal{}, models.ErrWithCause(models.ErrKindUnauthenticated, fmt.Sprintf("token acts on behalf of unknown user %q", handle), err)
+			}
+
+			if err != nil {
+				return models.Principal{}, err
+			}
+
+			principal.UserID = &user.ID
+		}
+	}
+
+	return principal, nil
+}
+
+// keyFor returns the keys token can be verified with. Keys are matched by `kid` when the token has one, and
+// always by algorithm so a key is never used with an algorithm other than its own.
+func (a *JWTAuthenticator) keyFor(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	var keys []jwt.VerificationKey
+	for _, key := range a.keys {
+		if key.alg != token.Method.Alg() || (kid != "" && key.id != "" && key.id != kid) {
+			continue
+		}
+		keys = append(keys, key.key)
+	}
+
+	switch len(keys) {
+	case 0:
+		return nil, fmt.Errorf("found no %s key with kid %q", token.Method.Alg(), kid)
+	case 1:
+		return keys[0], nil
+	default:
+		return jwt.VerificationKeySet{Keys: keys}, nil
+	}
+}
+
+// scopesOf returns the known scopes granted by claims, either as a space separated `scope` string (RFC 8693) or as
+// a `scp` array
+func scopesOf(claims jwt.MapClaims) []models.Scope {
+	var granted []string
+	if scope, ok := claims["scope"].(string); ok {
+		granted = strings.Fields(scope)
+	}
+
+	if scp, ok := claims["scp"].([]any); ok {
+		for _, scope := range scp {
+			if scope, ok := scope.(string); ok {
+				granted = append(granted, scope)
+			}
+		}
+	}
+
+	var scopes []models.Scope
+	for _, scope := range granted {
+		if slices.Contains(models.Scopes, models.Scope(scope)) && !slices.Contains(scopes, models.Scope(scope)) {
+			scopes = append(scopes, models.Scope(scope))
+		}
+	}
+
+	return scopes
+}
+
+// NewJWTAuthenticator creates an authenticator verifying JWTs with the keys loaded from config.KeysFile. Users are
+// looked up by the handle in config.UserClaim, and can be nil if it isn't set.
+func NewJWTAuthenticator(config JWTConfig, users UserGetter) (*JWTAuthenticator, error) {
+	a := &JWTAuthenticator{config: config, users: users}
+	err := a.Reload()
+	if err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
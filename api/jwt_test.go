@@ -0,0 +1,266 @@
+package api
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"simple_twitter/models"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testKeys are keys of every supported algorithm, generated for each test
+type testKeys struct {
+	rsa  *rsa.PrivateKey
+	ec   *ecdsa.PrivateKey
+	hmac []byte
+}
+
+func newTestKeys(t *testing.T) testKeys {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	hmacKey := make([]byte, 32)
+	_, err = rand.Read(hmacKey)
+	require.NoError(t, err)
+
+	return testKeys{rsa: rsaKey, ec: ecKey, hmac: hmacKey}
+}
+
+// jwks returns the keys as a JSON Web Key Set, with the kids `rsa`, `ec` and `hmac`
+func (k testKeys) jwks(t *testing.T) []byte {
+	encode := func(b []byte) string { return base64.RawURLEncoding.EncodeToString(b) }
+	pad := func(n *big.Int) []byte { return n.FillBytes(make([]byte, 32)) }
+
+	set, err := json.Marshal(map[string]any{
+		"keys": []map[string]string{
+			{"kty": "RSA", "kid": "rsa", "alg": "RS256", "use": "sig", "n": encode(k.rsa.N.Bytes()), "e": encode(big.NewInt(int64(k.rsa.E)).Bytes())},
+			{"kty": "EC", "kid": "ec", "crv": "P-256", "x": encode(pad(k.ec.X)), "y": encode(pad(k.ec.Y))},
+			{"kty": "oct", "kid": "hmac", "k": encode(k.hmac)},
+			{"kty": "OKP", "kid": "ed25519", "crv": "Ed25519", "x": encode(make([]byte, 32))},
+		},
+	})
+	require.NoError(t, err)
+	return set
+}
+
+// pem returns the public keys of the asymmetric keys as PEM blocks
+func (k testKeys) pem(t *testing.T) []byte {
+	var blocks []byte
+	for _, key := range []any{&k.rsa.PublicKey, &k.ec.PublicKey} {
+		der, err := x509.MarshalPKIXPublicKey(key)
+		require.NoError(t, err)
+		blocks = append(blocks, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})...)
+	}
+	return blocks
+}
+
+func writeKeys(t *testing.T, path string, data []byte) {
+	err := os.WriteFile(path, data, 0o600)
+	require.NoError(t, err)
+}
+
+func sign(t *testing.T, method jwt.SigningMethod, kid string, key any, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return signed
+}
+
+func validClaims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"sub":   "frode",
+		"iss":   "https://id.example.com",
+		"aud":   "simple_twitter",
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"scope": "tweets:read tweets:write unknown:scope",
+	}
+}
+
+func newTestAuthenticator(t *testing.T, keys []byte, users UserGetter) *JWTAuthenticator {
+	path := filepath.Join(t.TempDir(), "keys")
+	writeKeys(t, path, keys)
+
+	authenticator, err := NewJWTAuthenticator(JWTConfig{
+		KeysFile:  path,
+		Issuer:    "https://id.example.com",
+		Audience:  "simple_twitter",
+		UserClaim: "preferred_username",
+	}, users)
+	require.NoError(t, err)
+	return authenticator
+}
+
+func TestJWTAuthenticatorAlgorithms(t *testing.T) {
+	var (
+		keys = newTestKeys(t)
+		jwks = newTestAuthenticator(t, keys.jwks(t), nil)
+		pem  = newTestAuthenticator(t, keys.pem(t), nil)
+	)
+
+	for _, tt := range []struct {
+		name          string
+		authenticator *JWTAuthenticator
+		token         string
+	}{
+		{"RS256 JWKS", jwks, sign(t, jwt.SigningMethodRS256, "rsa", keys.rsa, validClaims())},
+		{"ES256 JWKS", jwks, sign(t, jwt.SigningMethodES256, "ec", keys.ec, validClaims())},
+		{"HS256 JWKS", jwks, sign(t, jwt.SigningMethodHS256, "hmac", keys.hmac, validClaims())},
+		{"RS256 JWKS without kid", jwks, sign(t, jwt.SigningMethodRS256, "", keys.rsa, validClaims())},
+		{"RS256 PEM", pem, sign(t, jwt.SigningMethodRS256, "", keys.rsa, validClaims())},
+		{"ES256 PEM", pem, sign(t, jwt.SigningMethodES256, "any", keys.ec, validClaims())},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				require = require.New(t)
+				assert  = assert.New(t)
+			)
+
+			principal, err := tt.authenticator.Authenticate(context.Background(), tt.token)
+			require.NoError(err)
+			assert.Equal("frode", principal.Name)
+			assert.Equal([]models.Scope{models.ScopeTweetsRead, models.ScopeTweetsWrite}, principal.Scopes, "Expected unknown scopes to be left out")
+			assert.Nil(principal.UserID)
+		})
+	}
+}
+
+func TestJWTAuthenticatorRejects(t *testing.T) {
+	var (
+		keys          = newTestKeys(t)
+		other         = newTestKeys(t)
+		authenticator = newTestAuthenticator(t, keys.jwks(t), nil)
+	)
+
+	withClaim := func(name string, value any) jwt.MapClaims {
+		claims := validClaims()
+		if value == nil {
+			delete(claims, name)
+		} else {
+			claims[name] = value
+		}
+		return claims
+	}
+
+	// The RSA public key as an HS256 secret, which verifiers that don't tie keys to algorithms fall for
+	publicKey, err := x509.MarshalPKIXPublicKey(&keys.rsa.PublicKey)
+	require.NoError(t, err)
+
+	unsigned, err := jwt.NewWithClaims(jwt.SigningMethodNone, validClaims()).SignedString(jwt.UnsafeAllowNoneSignatureType)
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		name    string
+		token   string
+		message string
+	}{
+		{"expired", sign(t, jwt.SigningMethodRS256, "rsa", keys.rsa, withClaim("exp", time.Now().Add(-time.Minute).Unix())), "token has expired"},
+		{"without exp", sign(t, jwt.SigningMethodRS256, "rsa", keys.rsa, withClaim("exp", nil)), "invalid token"},
+		{"not valid yet", sign(t, jwt.SigningMethodRS256, "rsa", keys.rsa, withClaim("nbf", time.Now().Add(time.Minute).Unix())), "token isn't valid yet"},
+		{"other issuer", sign(t, jwt.SigningMethodRS256, "rsa", keys.rsa, withClaim("iss", "https://evil.example.com")), "token was issued by another issuer"},
+		{"other audience", sign(t, jwt.SigningMethodRS256, "rsa", keys.rsa, withClaim("aud", []string{"other"})), "token was issued for another audience"},
+		{"without sub", sign(t, jwt.SigningMethodRS256, "rsa", keys.rsa, withClaim("sub", nil)), "token has no `sub`"},
+		{"other key", sign(t, jwt.SigningMethodRS256, "rsa", other.rsa, validClaims()), "invalid token"},
+		{"unknown kid", sign(t, jwt.SigningMethodRS256, "unknown", keys.rsa, validClaims()), "invalid token"},
+		{"kid of another algorithm", sign(t, jwt.SigningMethodES256, "rsa", keys.ec, validClaims()), "invalid token"},
+		{"public key as HS256 secret", sign(t, jwt.SigningMethodHS256, "", publicKey, validClaims()), "invalid token"},
+		{"unsigned", unsigned, "invalid token"},
+		{"malformed", "not.a.jwt", "invalid token"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := authenticator.Authenticate(context.Background(), tt.token)
+
+			var e models.Error
+			require.ErrorAs(t, err, &e)
+			assert.Equal(t, models.ErrKindUnauthenticated, e.Kind, "Expected `error kind` to be `unauthenticated`")
+			assert.Equal(t, tt.message, e.Message)
+		})
+	}
+}
+
+func TestJWTAuthenticatorReload(t *testing.T) {
+	var (
+		require = require.New(t)
+		old     = newTestKeys(t)
+		rotated = newTestKeys(t)
+		path    = filepath.Join(t.TempDir(), "jwks.json")
+	)
+
+	writeKeys(t, path, old.jwks(t))
+	authenticator, err := NewJWTAuthenticator(JWTConfig{KeysFile: path}, nil)
+	require.NoError(err)
+
+	_, err = authenticator.Authenticate(context.Background(), sign(t, jwt.SigningMethodES256, "ec", old.ec, validClaims()))
+	require.NoError(err)
+
+	writeKeys(t, path, rotated.jwks(t))
+	err = authenticator.Reload()
+	require.NoError(err)
+
+	_, err = authenticator.Authenticate(context.Background(), sign(t, jwt.SigningMethodES256, "ec", old.ec, validClaims()))
+	require.Error(err, "Expected tokens signed with the old keys to be rejected")
+
+	_, err = authenticator.Authenticate(context.Background(), sign(t, jwt.SigningMethodES256, "ec", rotated.ec, validClaims()))
+	require.NoError(err, "Expected tokens signed with the rotated keys to be accepted")
+
+	writeKeys(t, path, []byte(`{"keys": []}`))
+	err = authenticator.Reload()
+	require.Error(err, "Expected a key set without keys to not be loaded")
+
+	_, err = authenticator.Authenticate(context.Background(), sign(t, jwt.SigningMethodES256, "ec", rotated.ec, validClaims()))
+	require.NoError(err, "Expected the keys in use to be kept when reloading fails")
+}
+
+type testUsers map[string]models.User
+
+func (u testUsers) GetUser(ctx context.Context, handle string) (models.User, error) {
+	user, ok := u[handle]
+	if !ok {
+		return models.User{}, models.ErrMissingf("found no user with handle %s", handle)
+	}
+	return user, nil
+}
+
+func TestJWTAuthenticatorUserClaim(t *testing.T) {
+	var (
+		require       = require.New(t)
+		assert        = assert.New(t)
+		keys          = newTestKeys(t)
+		authenticator = newTestAuthenticator(t, keys.jwks(t), testUsers{"frode": {ID: 42, Handle: "frode"}})
+	)
+
+	claims := validClaims()
+	claims["preferred_username"] = "frode"
+
+	principal, err := authenticator.Authenticate(context.Background(), sign(t, jwt.SigningMethodRS256, "rsa", keys.rsa, claims))
+	require.NoError(err)
+	require.NotNil(principal.UserID, "Expected the principal to act on behalf of the user in the claim")
+	assert.Equal(int64(42), *principal.UserID)
+
+	claims["preferred_username"] = "someone_else"
+	_, err = authenticator.Authenticate(context.Background(), sign(t, jwt.SigningMethodRS256, "rsa", keys.rsa, claims))
+
+	var e models.Error
+	require.ErrorAs(err, &e)
+	assert.Equal(models.ErrKindUnauthenticated, e.Kind, "Expected tokens of unknown users to be rejected")
+}
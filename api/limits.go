@@ -0,0 +1,91 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"simple_twitter/models"
+	"strings"
+	"time"
+)
+
+// MAX_BODY_BYTES is the default size limit of request bodies, far more than the largest tweet
+const MAX_BODY_BYTES = 64 << 10
+
+// Timeouts bounds how long a client can hold on to a connection, so slow clients can't exhaust the server
+type Timeouts struct {
+	ReadHeader time.Duration // To read the headers of a request
+	Read       time.Duration // To read a whole request, including its body
+	Write      time.Duration // From the end of reading the headers of a request to the end of writing its response
+	Idle       time.Duration // To wait for the next request on a kept-alive connection
+}
+
+var DefaultTimeouts = Timeouts{
+	ReadHeader: 5 * time.Second,
+	Read:       10 * time.Second,
+	Write:      30 * time.Second,
+	Idle:       2 * time.Minute,
+}
+
+// WithTimeouts replaces the DefaultTimeouts of the server, a zero timeout means no timeout
+func WithTimeouts(timeouts Timeouts) Option {
+	return func(s *server) {
+		s.timeouts = timeouts
+	}
+}
+
+// WithMaxBodyBytes replaces MAX_BODY_BYTES as the size limit of request bodies
+func WithMaxBodyBytes(n int64) Option {
+	return func(s *server) {
+		s.maxBodyBytes = n
+	}
+}
+
+// limitBody fails reading the body of requests past the size limit, see decodeJSON
+func (s server) limitBody(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		r.Body = http.MaxBytesReader(w, r.Body, s.maxBodyBytes)
+		next(w, r)
+	}
+}
+
+// decodeJSON decodes the body of r into v. The body must be `application/json` and hold a single JSON value
+// without fields v doesn't have, so typos in field names aren't silently ignored.
+func decodeJSON(r *http.Request, v any) error {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil || mediaType != "application/json" {
+		return models.ErrUnsupportedMediaType("request body must be `application/json`")
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+
+	err = decoder.Decode(v)
+	if err != nil {
+		return bodyError(err)
+	}
+
+	// Anything but whitespace after the value, including a second value, is rejected
+	err = decoder.Decode(&json.RawMessage{})
+	if err != io.EOF {
+		if err == nil {
+			return models.ErrInvalid("request body must be a single JSON value")
+		}
+		return bodyError(err)
+	}
+
+	return nil
+}
+
+// bodyError returns the error for a request body that failed decoding with err
+func bodyError(err error) error {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return models.ErrTooLargef("request body must be at most %d bytes", maxBytesErr.Limit)
+	}
+
+	// The errors of encoding/json say what's wrong with the body, e.g `json: unknown field "mesage"`
+	return models.ErrInvalidWithCause("failed to parse request body: "+strings.TrimPrefix(err.Error(), "json: "), err)
+}
@@ -0,0 +1,89 @@
+package api
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"simple_twitter/models"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeJSON(t *testing.T) {
+	var (
+		require = require.New(t)
+		assert  = assert.New(t)
+	)
+
+	req := httptest.NewRequest(http.MethodPost, "/tweets", strings.NewReader(`{"message":"Hello world!","tags":["greetings"]}`+"\n"))
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+
+	var tweet models.Tweet
+	err := decodeJSON(req, &tweet)
+	require.NoError(err)
+	assert.Equal("Hello world!", tweet.Message)
+	assert.Equal([]string{"greetings"}, tweet.Tags)
+}
+
+func TestRejectBodies(t *testing.T) {
+	server := NewServer("", nil, WithLogger(slog.New(slog.DiscardHandler)), WithMaxBodyBytes(64))
+
+	tests := []struct {
+		name        string
+		route       string
+		contentType string
+		body        string
+		status      int
+		kind        models.ErrorKind
+	}{
+		{"no content type", "POST /tweets", "", `{"message":"Hello world!"}`, http.StatusUnsupportedMediaType, models.ErrKindUnsupportedMediaType},
+		{"form", "POST /tweets", "application/x-www-form-urlencoded", `message=Hello`, http.StatusUnsupportedMediaType, models.ErrKindUnsupportedMediaType},
+		{"text", "PATCH /tweets/1", "text/plain", `{"message":"Hello world!"}`, http.StatusUnsupportedMediaType, models.ErrKindUnsupportedMediaType},
+		{"too large", "POST /tweets", "application/json", `{"message":"` + strings.Repeat("a", 64) + `"}`, http.StatusRequestEntityTooLarge, models.ErrKindTooLarge},
+		{"unknown field", "POST /tweets", "application/json", `{"mesage":"Hello world!"}`, http.StatusBadRequest, models.ErrKindInvalid},
+		{"trailing value", "POST /users", "application/json", `{"handle":"frode"} {"handle":"frode"}`, http.StatusBadRequest, models.ErrKindInvalid},
+		{"trailing garbage", "PATCH /tweets/1", "application/json", `{"message":"Hello world!"}garbage`, http.StatusBadRequest, models.ErrKindInvalid},
+		{"malformed", "POST /users", "application/json", `{"handle":`, http.StatusBadRequest, models.ErrKindInvalid},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				require = require.New(t)
+				assert  = assert.New(t)
+			)
+
+			method, path, _ := strings.Cut(test.route, " ")
+			req := httptest.NewRequest(method, path, strings.NewReader(test.body))
+			if test.contentType != "" {
+				req.Header.Set("Content-Type", test.contentType)
+			}
+			res := httptest.NewRecorder()
+			server.Handler.ServeHTTP(res, req)
+
+			assert.Equal(test.status, res.Code)
+
+			var e models.Error
+			err := json.NewDecoder(res.Body).Decode(&e)
+			require.NoError(err)
+			assert.Equal(test.kind, e.Kind)
+		})
+	}
+}
+
+func TestTimeouts(t *testing.T) {
+	assert := assert.New(t)
+
+	server := NewServer("", nil)
+	assert.Equal(DefaultTimeouts.ReadHeader, server.ReadHeaderTimeout, "Expected the server to time out by default")
+	assert.Equal(DefaultTimeouts.Idle, server.IdleTimeout)
+
+	timeouts := Timeouts{ReadHeader: time.Second, Read: 2 * time.Second, Write: 3 * time.Second, Idle: 4 * time.Second}
+	server = NewServer("", nil, WithTimeouts(timeouts))
+	assert.Equal(Timeouts{ReadHeader: server.ReadHeaderTimeout, Read: server.ReadTimeout, Write: server.WriteTimeout, Idle: server.IdleTimeout}, timeouts)
+}
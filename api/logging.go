@@ -0,0 +1,55 @@
+package api
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"simple_twitter/models"
+)
+
+// WithLogger logs requests and the causes of the errors they fail with to logger, slog.Default() is used otherwise
+func WithLogger(logger *slog.Logger) Option {
+	return func(s *server) {
+		s.logger = logger
+	}
+}
+
+type loggerKey struct{}
+
+// LoggerFromContext returns the logger of the request, which logs the request ID with every record
+func LoggerFromContext(ctx context.Context) *slog.Logger {
+	if logger, ok := ctx.Value(loggerKey{}).(*slog.Logger); ok {
+		return logger
+	}
+	return slog.Default()
+}
+
+// logError logs an error a request failed with. Internal errors are logged with the chain of their causes, as
+// they're hidden from clients, while others are the client's own doing and only logged when debugging. The
+// server being unavailable, e.g while it shuts down, is only a warning.
+func logError(ctx context.Context, statusCode int, err error) {
+	logger := LoggerFromContext(ctx)
+	switch {
+	case statusCode < http.StatusInternalServerError:
+		logger.DebugContext(ctx, "request failed", slog.String("error", err.Error()))
+	case statusCode == http.StatusServiceUnavailable:
+		logger.WarnContext(ctx, "request failed", slog.String("error", err.Error()), slog.Any("causes", causes(err)))
+	default:
+		logger.ErrorContext(ctx, "request failed", slog.String("error", err.Error()), slog.Any("causes", causes(err)))
+	}
+}
+
+// causes returns the messages of the chain of causes of err, which ends with the first cause that isn't a
+// models.Error as its message holds the rest of the chain
+func causes(err error) []string {
+	var messages []string
+	for {
+		e, ok := err.(models.Error)
+		if !ok || e.Cause == nil {
+			return messages
+		}
+
+		err = e.Cause
+		messages = append(messages, err.Error())
+	}
+}
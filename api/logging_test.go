@@ -0,0 +1,76 @@
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"simple_twitter/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// records decodes the JSON log records written to buf
+func records(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	var records []map[string]any
+	scanner := bufio.NewScanner(buf)
+	for scanner.Scan() {
+		var record map[string]any
+		err := json.Unmarshal(scanner.Bytes(), &record)
+		require.NoError(t, err)
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestObserveRequestsLogs(t *testing.T) {
+	var (
+		require = require.New(t)
+		assert  = assert.New(t)
+		buf     bytes.Buffer
+		s       = server{logger: slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})), tracer: noop.Tracer{}}
+	)
+
+	var mux http.ServeMux
+	mux.HandleFunc("GET /tweets/{id}", func(w http.ResponseWriter, r *http.Request) {
+		cause := models.ErrInternalWithCause("failed to get tweet", errors.New("connection refused"))
+		handleError(models.ErrInternalWithCause("failed to get tweet 1", cause), w, r)
+	})
+	handler := s.observeRequests(&mux)
+
+	req := httptest.NewRequest(http.MethodGet, "/tweets/1", nil)
+	req.Header.Set("X-Request-ID", "abc-123")
+	res := httptest.NewRecorder()
+	handler.ServeHTTP(res, req)
+
+	assert.Equal("abc-123", res.Header().Get("X-Request-ID"), "Expected the request ID to be echoed")
+
+	logs := records(t, &buf)
+	require.Len(logs, 2)
+
+	assert.Equal("ERROR", logs[0]["level"])
+	assert.Equal("abc-123", logs[0]["request_id"], "Expected the error to be logged with the request ID")
+	assert.Equal("failed to get tweet 1", logs[0]["error"])
+	assert.Equal([]any{"failed to get tweet", "connection refused"}, logs[0]["causes"])
+
+	assert.Equal("served request", logs[1]["msg"])
+	assert.Equal("abc-123", logs[1]["request_id"])
+	assert.Equal("GET", logs[1]["method"])
+	assert.Equal("GET /tweets/{id}", logs[1]["route"])
+	assert.Equal(float64(http.StatusInternalServerError), logs[1]["status"])
+	assert.Contains(logs[1], "latency")
+	assert.Contains(logs[1], "remote_addr")
+
+	req = httptest.NewRequest(http.MethodGet, "/tweets/1", nil)
+	req.Header.Set("X-Request-ID", "forged\nrequest")
+	res = httptest.NewRecorder()
+	handler.ServeHTTP(res, req)
+
+	assert.Len(res.Header().Get("X-Request-ID"), 32, "Expected invalid request IDs to be replaced")
+}
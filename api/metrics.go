@@ -0,0 +1,37 @@
+package api
+
+import (
+	"context"
+	"simple_twitter/models"
+	"time"
+)
+
+// Metrics observes the requests served and the errors they fail with
+type Metrics interface {
+	ObserveRequest(route string, status int, latency time.Duration)
+	ObserveError(kind models.ErrorKind)
+}
+
+// WithMetrics observes every request with metrics, by the pattern of the route it's made to, see observeRequests
+func WithMetrics(metrics Metrics) Option {
+	return func(s *server) {
+		s.metrics = metrics
+	}
+}
+
+type metricsKey struct{}
+
+// observeError observes the kind of err with the metrics of the request, if any
+func observeError(ctx context.Context, err error) {
+	metrics, ok := ctx.Value(metricsKey{}).(Metrics)
+	if !ok {
+		return
+	}
+
+	kind := models.ErrKindInternal
+	if e, ok := err.(models.Error); ok {
+		kind = e.Kind
+	}
+
+	metrics.ObserveError(kind)
+}
@@ -0,0 +1,59 @@
+package api
+
+import (
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"simple_twitter/models"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+type request struct {
+	route  string
+	status int
+}
+
+type testMetrics struct {
+	requests []request
+	errors   []models.ErrorKind
+}
+
+func (m *testMetrics) ObserveRequest(route string, status int, latency time.Duration) {
+	m.requests = append(m.requests, request{route, status})
+}
+
+func (m *testMetrics) ObserveError(kind models.ErrorKind) {
+	m.errors = append(m.errors, kind)
+}
+
+func TestObserveRequestsMetrics(t *testing.T) {
+	var (
+		assert  = assert.New(t)
+		metrics = &testMetrics{}
+		s       = server{logger: slog.New(slog.DiscardHandler), metrics: metrics, tracer: noop.Tracer{}}
+	)
+
+	var mux http.ServeMux
+	mux.HandleFunc("GET /tweets/{id}", func(w http.ResponseWriter, r *http.Request) {
+		handleError(models.ErrMissing("found no tweet with id 1"), w, r)
+	})
+	mux.HandleFunc("GET /tweets", func(w http.ResponseWriter, r *http.Request) {
+		writeJSONResponse(http.StatusOK, []models.Tweet{}, w, r)
+	})
+	handler := s.observeRequests(&mux)
+
+	for _, path := range []string{"/tweets/1", "/tweets", "/unknown"} {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	assert.Equal([]request{
+		{"GET /tweets/{id}", http.StatusNotFound},
+		{"GET /tweets", http.StatusOK},
+		{"", http.StatusNotFound},
+	}, metrics.requests, "Expected requests to be observed by the pattern of their route")
+	assert.Equal([]models.ErrorKind{models.ErrKindMissing}, metrics.errors)
+}
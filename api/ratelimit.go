@@ -0,0 +1,240 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"net"
+	"net/http"
+	"simple_twitter/models"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimit allows a client to make bursts of up to Requests requests to a route, refilled evenly over Period
+type RateLimit struct {
+	Requests int
+	Period   time.Duration
+}
+
+// RateLimitStatus is the state of a client's bucket after it has taken a request from it
+type RateLimitStatus struct {
+	Allowed    bool
+	Remaining  int           // Requests left in the bucket
+	Reset      time.Duration // Time until the bucket is full again
+	RetryAfter time.Duration // Time until the next request is allowed, if this one wasn't
+}
+
+// RateLimitStore holds the token buckets of the clients of rate limited routes. Buckets live in memory by default,
+// and a store shared between replicas makes the limits hold across them.
+type RateLimitStore interface {
+	// Take takes a request from the bucket with key, which is full the first time it's used
+	Take(ctx context.Context, key string, limit RateLimit) (RateLimitStatus, error)
+}
+
+// WithRateLimits limits the rate clients can make requests to routes, given by the pattern they're registered with
+// e.g `POST /tweets`. Clients are told apart by their token, or by their IP address when they don't have one.
+func WithRateLimits(store RateLimitStore, limits map[string]RateLimit) Option {
+	return func(s *server) {
+		s.rateLimitStore = store
+		s.rateLimits = limits
+	}
+}
+
+// WithTrustedProxies tells clients without a token apart by the IP address hops trusted proxies in front of the
+// server forwarded them from, e.g with header `X-Forwarded-For`. Every proxy appends the address it got the request
+// from to header, so the hops-th address from its end is the one the closest untrusted client connected from,
+// and the entries before it can be forged by clients. Without trusted proxies clients are told apart by the address
+// they connected from, which behind a proxy is the address of the proxy for every client.
+func WithTrustedProxies(header string, hops int) Option {
+	return func(s *server) {
+		s.proxyHeader = header
+		s.proxyHops = hops
+	}
+}
+
+// rateLimit rejects requests to the route registered with pattern from clients that exceed its rate limit, and
+// tells clients their limit with the `RateLimit-*` headers. It must run after authenticate to tell clients apart
+// by their token.
+func (s server) rateLimit(pattern string, next http.HandlerFunc) http.HandlerFunc {
+	limit, ok := s.rateLimits[pattern]
+	if !ok || s.rateLimitStore == nil {
+		return next
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		status, err := s.rateLimitStore.Take(r.Context(), pattern+" "+s.client(r), limit)
+		if err != nil {
+			// Requests are let through rather than failing while the store is unavailable
+			LoggerFromContext(r.Context()).WarnContext(r.Context(), "failed to rate limit request", slog.String("error", err.Error()))
+			next(w, r)
+			return
+		}
+
+		w.Header().Set("RateLimit-Limit", strconv.Itoa(limit.Requests))
+		w.Header().Set("RateLimit-Remaining", strconv.Itoa(status.Remaining))
+		w.Header().Set("RateLimit-Reset", seconds(status.Reset))
+		w.Header().Set("RateLimit-Policy", fmt.Sprintf("%d;w=%s", limit.Requests, seconds(limit.Period)))
+
+		if !status.Allowed {
+			w.Header().Set("Retry-After", seconds(status.RetryAfter))
+			handleError(models.ErrRateLimited(fmt.Sprintf("rate limit of %d requests per %s exceeded", limit.Requests, limit.Period)), w, r)
+			return
+		}
+
+		next(w, r)
+	}
+}
+
+// client identifies the client making r, by the token it's authenticated with or by its IP address
+func (s server) client(r *http.Request) string {
+	if principal, ok := PrincipalFromContext(r.Context()); ok {
+		if principal.TokenID != 0 {
+			return fmt.Sprintf("token:%d", principal.TokenID)
+		}
+		return "sub:" + principal.Name
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+
+	if s.proxyHops > 0 {
+		var addresses []string
+		for _, value := range r.Header.Values(s.proxyHeader) {
+			for _, address := range strings.Split(value, ",") {
+				addresses = append(addresses, strings.TrimSpace(address))
+			}
+		}
+
+		// The connection itself comes from the closest proxy, which is the first hop. Requests that went through
+		// fewer proxies than expected are identified by the farthest address they have.
+		addresses = append(addresses, host)
+		host = addresses[max(len(addresses)-1-s.proxyHops, 0)]
+	}
+
+	return "ip:" + host
+}
+
+// seconds formats d as whole seconds, rounded up so clients don't retry too early
+func seconds(d time.Duration) string {
+	return strconv.FormatInt(int64(math.Ceil(d.Seconds())), 10)
+}
+
+// ParseRateLimits parses comma separated rate limits of routes, e.g `POST /tweets=30/1m,PATCH /tweets/{id}=10/1m`
+func ParseRateLimits(value string) (map[string]RateLimit, error) {
+	limits := map[string]RateLimit{}
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		route, limit, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid rate limit %q, must be `ROUTE=REQUESTS/PERIOD`", entry)
+		}
+
+		requests, period, ok := strings.Cut(limit, "/")
+		if !ok {
+			return nil, fmt.Errorf("invalid rate limit %q, must be `ROUTE=REQUESTS/PERIOD`", entry)
+		}
+
+		n, err := strconv.Atoi(requests)
+		if err != nil || n < 1 {
+			return nil, fmt.Errorf("invalid rate limit %q, requests must be a positive integer", entry)
+		}
+
+		d, err := time.ParseDuration(period)
+		if err != nil || d <= 0 {
+			return nil, fmt.Errorf("invalid rate limit %q, period must be a positive duration e.g `1m`", entry)
+		}
+
+		limits[strings.TrimSpace(route)] = RateLimit{Requests: n, Period: d}
+	}
+
+	return limits, nil
+}
+
+type bucket struct {
+	tokens   float64
+	capacity float64
+	rate     float64 // Requests refilled per second
+	updated  time.Time
+}
+
+// full reports whether the bucket has been refilled by now
+func (b bucket) full(now time.Time) bool {
+	return b.tokens+now.Sub(b.updated).Seconds()*b.rate >= b.capacity
+}
+
+// MemoryRateLimitStore holds token buckets in memory, so the limits only hold for a single server
+type MemoryRateLimitStore struct {
+	now func() time.Time
+
+	mu        sync.Mutex
+	buckets   map[string]bucket
+	lastSweep time.Time
+}
+
+func (m *MemoryRateLimitStore) Take(ctx context.Context, key string, limit RateLimit) (RateLimitStatus, error) {
+	var (
+		now      = m.now()
+		capacity = float64(limit.Requests)
+		rate     = capacity / limit.Period.Seconds()
+	)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.sweep(now)
+
+	b, ok := m.buckets[key]
+	if !ok {
+		b = bucket{tokens: capacity, updated: now}
+	}
+
+	b.tokens = math.Min(capacity, b.tokens+now.Sub(b.updated).Seconds()*rate)
+	b.capacity, b.rate, b.updated = capacity, rate, now
+
+	var status RateLimitStatus
+	if b.tokens >= 1 {
+		b.tokens--
+		status.Allowed = true
+	} else {
+		status.RetryAfter = durationOf((1 - b.tokens) / rate)
+	}
+
+	m.buckets[key] = b
+
+	status.Remaining = int(b.tokens)
+	status.Reset = durationOf((capacity - b.tokens) / rate)
+	return status, nil
+}
+
+// sweep removes the buckets that have been refilled since they were last used, as they're no different from
+// buckets that were never used, at most once a minute
+func (m *MemoryRateLimitStore) sweep(now time.Time) {
+	if now.Sub(m.lastSweep) < time.Minute {
+		return
+	}
+	m.lastSweep = now
+
+	for key, b := range m.buckets {
+		if b.full(now) {
+			delete(m.buckets, key)
+		}
+	}
+}
+
+func durationOf(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
+
+func NewMemoryRateLimitStore() *MemoryRateLimitStore {
+	return &MemoryRateLimitStore{now: time.Now, buckets: map[string]bucket{}}
+}
@@ -0,0 +1,131 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryRateLimitStore(t *testing.T) {
+	var (
+		require = require.New(t)
+		assert  = assert.New(t)
+		now     = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+		store   = NewMemoryRateLimitStore()
+		limit   = RateLimit{Requests: 3, Period: 3 * time.Second}
+	)
+
+	store.now = func() time.Time { return now }
+
+	for remaining := 2; remaining >= 0; remaining-- {
+		status, err := store.Take(context.Background(), "client", limit)
+		require.NoError(err)
+		assert.True(status.Allowed, "Expected a burst of up to `requests` requests to be allowed")
+		assert.Equal(remaining, status.Remaining)
+	}
+
+	status, err := store.Take(context.Background(), "client", limit)
+	require.NoError(err)
+	assert.False(status.Allowed, "Expected requests beyond the burst to be rejected")
+	assert.Equal(0, status.Remaining)
+	assert.Equal(time.Second, status.RetryAfter)
+	assert.Equal(3*time.Second, status.Reset)
+
+	status, err = store.Take(context.Background(), "other client", limit)
+	require.NoError(err)
+	assert.True(status.Allowed, "Expected clients to have a bucket each")
+
+	now = now.Add(time.Second)
+	status, err = store.Take(context.Background(), "client", limit)
+	require.NoError(err)
+	assert.True(status.Allowed, "Expected the bucket to be refilled evenly over the period")
+	assert.Equal(0, status.Remaining)
+
+	now = now.Add(time.Hour)
+	status, err = store.Take(context.Background(), "client", limit)
+	require.NoError(err)
+	assert.True(status.Allowed)
+	assert.Equal(2, status.Remaining, "Expected the bucket to not be refilled beyond `requests`")
+	assert.Len(store.buckets, 1, "Expected refilled buckets to be swept")
+}
+
+func TestParseRateLimits(t *testing.T) {
+	var (
+		require = require.New(t)
+		assert  = assert.New(t)
+	)
+
+	limits, err := ParseRateLimits("POST /tweets=30/1m, PATCH /tweets/{id}=10/1h,")
+	require.NoError(err)
+	assert.Equal(map[string]RateLimit{
+		"POST /tweets":       {Requests: 30, Period: time.Minute},
+		"PATCH /tweets/{id}": {Requests: 10, Period: time.Hour},
+	}, limits)
+
+	limits, err = ParseRateLimits("")
+	require.NoError(err)
+	assert.Empty(limits)
+
+	for _, value := range []string{"POST /tweets", "POST /tweets=30", "POST /tweets=0/1m", "POST /tweets=30/0s", "POST /tweets=thirty/1m"} {
+		_, err := ParseRateLimits(value)
+		assert.Error(err, "Expected %q to be rejected", value)
+	}
+}
+
+func TestClient(t *testing.T) {
+	tests := []struct {
+		name         string
+		hops         int
+		forwardedFor []string
+		expected     string
+	}{
+		{
+			name:         "without trusted proxies",
+			forwardedFor: []string{"203.0.113.7"},
+			expected:     "ip:10.0.0.1",
+		},
+		{
+			name:         "one trusted proxy",
+			hops:         1,
+			forwardedFor: []string{"198.51.100.9, 203.0.113.7"},
+			expected:     "ip:203.0.113.7",
+		},
+		{
+			name:         "two trusted proxies across headers",
+			hops:         2,
+			forwardedFor: []string{"198.51.100.9, 203.0.113.7", "10.0.0.2"},
+			expected:     "ip:203.0.113.7",
+		},
+		{
+			name:         "fewer addresses than trusted proxies",
+			hops:         2,
+			forwardedFor: []string{"203.0.113.7"},
+			expected:     "ip:203.0.113.7",
+		},
+		{
+			name:     "trusted proxy without the header",
+			hops:     1,
+			expected: "ip:10.0.0.1",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := server{}
+			WithTrustedProxies("X-Forwarded-For", test.hops)(&s)
+
+			r := httptest.NewRequest(http.MethodPost, "/tweets", nil)
+			r.RemoteAddr = "10.0.0.1:54321"
+			for _, value := range test.forwardedFor {
+				r.Header.Add("X-Forwarded-For", value)
+			}
+
+			assert.Equal(t, test.expected, s.client(r))
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package api
+
+import (
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// propagator propagates traces with the W3C `traceparent` and `tracestate` headers
+var propagator = propagation.TraceContext{}
+
+// WithTracerProvider traces requests with the tracers of provider, otel.GetTracerProvider() is used otherwise
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(s *server) {
+		s.tracer = provider.Tracer("simple_twitter/api")
+	}
+}
+
+func defaultTracer() trace.Tracer {
+	return otel.GetTracerProvider().Tracer("simple_twitter/api")
+}
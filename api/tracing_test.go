@@ -0,0 +1,58 @@
+package api
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"simple_twitter/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestObserveRequestsTraces(t *testing.T) {
+	var (
+		require  = require.New(t)
+		assert   = assert.New(t)
+		buf      bytes.Buffer
+		recorder = tracetest.NewSpanRecorder()
+		s        = server{logger: slog.New(slog.NewJSONHandler(&buf, nil))}
+	)
+
+	WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))(&s)
+
+	var mux http.ServeMux
+	mux.HandleFunc("GET /tweets/{id}", func(w http.ResponseWriter, r *http.Request) {
+		assert.True(trace.SpanContextFromContext(r.Context()).IsValid(), "Expected the span to be in the context of handlers")
+		handleError(models.ErrInternalWithCause("failed to get tweet", nil), w, r)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/tweets/1", nil)
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	s.observeRequests(&mux).ServeHTTP(httptest.NewRecorder(), req)
+
+	spans := recorder.Ended()
+	require.Len(spans, 1)
+
+	span := spans[0]
+	assert.Equal("GET /tweets/{id}", span.Name(), "Expected the span to be named by the route")
+	assert.Equal(trace.SpanKindServer, span.SpanKind())
+	assert.Equal("4bf92f3577b34da6a3ce929d0e0e4736", span.SpanContext().TraceID().String(), "Expected the trace of `traceparent` to be continued")
+	assert.Equal("00f067aa0ba902b7", span.Parent().SpanID().String())
+	assert.Equal(codes.Error, span.Status().Code)
+	assert.Contains(span.Attributes(), attribute.String("http.route", "GET /tweets/{id}"))
+	assert.Contains(span.Attributes(), attribute.Int("http.response.status_code", http.StatusInternalServerError))
+
+	logs := records(t, &buf)
+	require.NotEmpty(logs)
+	for _, record := range logs {
+		assert.Equal("4bf92f3577b34da6a3ce929d0e0e4736", record["trace_id"], "Expected records to be logged with the trace id")
+	}
+}
@@ -0,0 +1,208 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"simple_twitter/database"
+	"simple_twitter/twitter"
+	"strconv"
+	"strings"
+	"time"
+
+	ff "github.com/peterbourgon/ff/v3"
+	"github.com/peterbourgon/ff/v3/ffcli"
+)
+
+// admin runs maintenance tasks directly against the storage of the server
+func main() {
+	fs := flag.NewFlagSet("admin", flag.ExitOnError)
+
+	var (
+		storageDriver = fs.String("storage-driver", "mysql", "storage backend to administer, one of [mysql, postgres, sqlite]")
+
+		mysqlAddr     = fs.String("mysql-addr", "127.0.0.1:3308", "")
+		mysqlUser     = fs.String("mysql-user", "root", "")
+		mysqlPassword = fs.String("mysql-password", "TopSecret", "")
+		mysqlDatabase = fs.String("mysql-database", "simple_twitter", "")
+
+		postgresAddr     = fs.String("postgres-addr", "127.0.0.1:5433", "")
+		postgresUser     = fs.String("postgres-user", "postgres", "")
+		postgresPassword = fs.String("postgres-password", "TopSecret", "")
+		postgresDatabase = fs.String("postgres-database", "simple_twitter", "")
+		postgresSSLMode  = fs.String("postgres-sslmode", "disable", "one of the libpq sslmode values, e.g [disable, require, verify-full]")
+
+		sqlitePath = fs.String("sqlite-path", "simple_twitter.db", "path to the SQLite database file")
+
+		rolesFile = fs.String("roles-file", "", "path to a JSON file configuring the roles tokens can be given, the default roles are used if empty")
+	)
+
+	// connect opens the storage selected by the flags, the returned closer must be closed when done
+	connect := func(ctx context.Context) (database.TwitterDatabase, io.Closer, error) {
+		switch *storageDriver {
+		case "mysql":
+			conn, err := database.Connect(*mysqlAddr, *mysqlUser, *mysqlPassword, *mysqlDatabase)
+			if err != nil {
+				return database.TwitterDatabase{}, nil, err
+			}
+
+			return database.NewTwitterDatabase(conn), conn, nil
+
+		case "postgres":
+			conn, err := database.ConnectPostgres(*postgresAddr, *postgresUser, *postgresPassword, *postgresDatabase, *postgresSSLMode)
+			if err != nil {
+				return database.TwitterDatabase{}, nil, err
+			}
+
+			return database.NewPostgresTwitterDatabase(conn), conn, nil
+
+		case "sqlite":
+			conn, err := database.ConnectSQLite(*sqlitePath)
+			if err != nil {
+				return database.TwitterDatabase{}, nil, err
+			}
+
+			err = database.MigrateSQLite(ctx, conn)
+			if err != nil {
+				conn.Close()
+				return database.TwitterDatabase{}, nil, err
+			}
+
+			return database.NewSQLiteTwitterDatabase(conn), conn, nil
+
+		default:
+			return database.TwitterDatabase{}, nil, fmt.Errorf("unknown storage driver %q, must be one of [mysql, postgres, sqlite]", *storageDriver)
+		}
+	}
+
+	purgeFlags := flag.NewFlagSet("admin purge-tweets", flag.ExitOnError)
+	retention := purgeFlags.Duration("retention", 30*24*time.Hour, "how long deleted tweets are kept before they are purged")
+
+	purgeTweets := &ffcli.Command{
+		Name:       "purge-tweets",
+		ShortUsage: "admin [flags] purge-tweets [-retention <duration>]",
+		ShortHelp:  "Permanently remove tweets that were deleted more than the retention ago",
+		FlagSet:    purgeFlags,
+		Options:    []ff.Option{ff.WithEnvVarNoPrefix()},
+		Exec: func(ctx context.Context, args []string) error {
+			storage, closer, err := connect(ctx)
+			if err != nil {
+				return err
+			}
+			defer closer.Close()
+
+			purged, err := twitter.NewTwitter(storage).PurgeTweets(ctx, *retention)
+			if err != nil {
+				return err
+			}
+
+			log.Printf("purged %d tweets deleted more than %s ago", purged, *retention)
+			return nil
+		},
+	}
+
+	createTokenFlags := flag.NewFlagSet("admin create-token", flag.ExitOnError)
+	tokenName := createTokenFlags.String("name", "", "describes who or what the token is handed out to")
+	tokenUser := createTokenFlags.String("user", "", "handle of the user the token acts on behalf of, if any")
+	tokenRoles := createTokenFlags.String("roles", "writer", "comma separated roles of the token, e.g [reader, writer, admin]")
+
+	createToken := &ffcli.Command{
+		Name:       "create-token",
+		ShortUsage: "admin [flags] create-token -name <name> [-user <handle>] [-roles <roles>]",
+		ShortHelp:  "Create an API token and print its secret",
+		LongHelp:   "Only a hash of the secret is stored, so it's printed once and can't be retrieved later.",
+		FlagSet:    createTokenFlags,
+		Exec: func(ctx context.Context, args []string) error {
+			storage, closer, err := connect(ctx)
+			if err != nil {
+				return err
+			}
+			defer closer.Close()
+
+			roles, err := loadRoles(*rolesFile)
+			if err != nil {
+				return err
+			}
+
+			// Unknown roles, e.g misspelled ones, are rejected by CreateToken
+			var names []string
+			for _, name := range strings.Split(*tokenRoles, ",") {
+				if name = strings.TrimSpace(name); name != "" {
+					names = append(names, name)
+				}
+			}
+
+			token, secret, err := twitter.NewTwitter(storage, twitter.WithRoles(roles)).CreateToken(ctx, *tokenName, *tokenUser, names)
+			if err != nil {
+				return err
+			}
+
+			log.Printf("created token %d named %q with roles %v", token.ID, token.Name, token.Roles)
+			fmt.Println(secret)
+			return nil
+		},
+	}
+
+	revokeToken := &ffcli.Command{
+		Name:       "revoke-token",
+		ShortUsage: "admin [flags] revoke-token <id>",
+		ShortHelp:  "Revoke an API token, requests made with it are rejected from then on",
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) != 1 {
+				return fmt.Errorf("revoke-token takes the id of a token, got %d arguments", len(args))
+			}
+
+			id, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("id of the token must be an integer value: %w", err)
+			}
+
+			storage, closer, err := connect(ctx)
+			if err != nil {
+				return err
+			}
+			defer closer.Close()
+
+			err = twitter.NewTwitter(storage).RevokeToken(ctx, id)
+			if err != nil {
+				return err
+			}
+
+			log.Printf("revoked token %d", id)
+			return nil
+		},
+	}
+
+	root := &ffcli.Command{
+		ShortUsage:  "admin [flags] <subcommand> [flags]",
+		FlagSet:     fs,
+		Options:     []ff.Option{ff.WithEnvVarNoPrefix()},
+		Subcommands: []*ffcli.Command{purgeTweets, createToken, revokeToken},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+
+	err := root.ParseAndRun(context.Background(), os.Args[1:])
+	if err != nil && !errors.Is(err, flag.ErrHelp) {
+		log.Fatal(err)
+	}
+}
+
+// loadRoles reads the roles configured in path, or returns the default roles if path is empty
+func loadRoles(path string) (twitter.Roles, error) {
+	if path == "" {
+		return twitter.DefaultRoles(), nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return twitter.ParseRoles(data)
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"simple_twitter/database"
+	"simple_twitter/twitter"
+
+	ff "github.com/peterbourgon/ff/v3"
+)
+
+// normalize-tags rewrites the tags stored before tags were normalized to their canonical form, see
+// twitter.NormalizeTag. The migrations do a best effort backfill in SQL, this does the rest.
+func main() {
+	fs := flag.NewFlagSet("normalize-tags", flag.ExitOnError)
+
+	var (
+		storageDriver = fs.String("storage-driver", "mysql", "storage backend to normalize, one of [mysql, postgres, sqlite]")
+
+		mysqlAddr     = fs.String("mysql-addr", "127.0.0.1:3308", "")
+		mysqlUser     = fs.String("mysql-user", "root", "")
+		mysqlPassword = fs.String("mysql-password", "TopSecret", "")
+		mysqlDatabase = fs.String("mysql-database", "simple_twitter", "")
+
+		postgresAddr     = fs.String("postgres-addr", "127.0.0.1:5433", "")
+		postgresUser     = fs.String("postgres-user", "postgres", "")
+		postgresPassword = fs.String("postgres-password", "TopSecret", "")
+		postgresDatabase = fs.String("postgres-database", "simple_twitter", "")
+		postgresSSLMode  = fs.String("postgres-sslmode", "disable", "one of the libpq sslmode values, e.g [disable, require, verify-full]")
+
+		sqlitePath = fs.String("sqlite-path", "simple_twitter.db", "path to the SQLite database file")
+	)
+
+	err := ff.Parse(fs, os.Args[1:], ff.WithEnvVarNoPrefix())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var storage database.TwitterDatabase
+	switch *storageDriver {
+	case "mysql":
+		conn, err := database.Connect(*mysqlAddr, *mysqlUser, *mysqlPassword, *mysqlDatabase)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer conn.Close()
+
+		storage = database.NewTwitterDatabase(conn)
+
+	case "postgres":
+		conn, err := database.ConnectPostgres(*postgresAddr, *postgresUser, *postgresPassword, *postgresDatabase, *postgresSSLMode)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer conn.Close()
+
+		storage = database.NewPostgresTwitterDatabase(conn)
+
+	case "sqlite":
+		conn, err := database.ConnectSQLite(*sqlitePath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer conn.Close()
+
+		err = database.MigrateSQLite(context.Background(), conn)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		storage = database.NewSQLiteTwitterDatabase(conn)
+
+	default:
+		log.Fatalf("unknown storage driver %q, must be one of [mysql, postgres, sqlite]", *storageDriver)
+	}
+
+	normalized, invalid, err := storage.NormalizeTags(context.Background(), twitter.NormalizeTag)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	log.Printf("normalized %d tags", normalized)
+	for _, tag := range invalid {
+		log.Printf("tag %q can't be normalized and was left as is", tag)
+	}
+}
@@ -31,11 +31,24 @@ func main() {
 	}
 	sort.Slice(tweets, func(i, j int) bool { return tweets[i].createdAt.Before(tweets[j].createdAt) })
 
+	// MySQL accepts double quoted string literals, which is what the messages are written to be quoted with
+	err = os.WriteFile("database/seeds/20250314164410_tweets.up.sql", []byte(insertStatement(tweets, "`Tweets`", func(value string) string {
+		return `"` + value + `"`
+	})), 0777)
+	fatal(err)
+
+	err = os.WriteFile("database/postgres/seeds/20250314164410_tweets.up.sql", []byte(insertStatement(tweets, "Tweets", func(value string) string {
+		return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+	})), 0777)
+	fatal(err)
+}
+
+func insertStatement(tweets []tweet, table string, quote func(string) string) string {
 	var builder strings.Builder
-	builder.WriteString("INSERT INTO `Tweets` (message, tag, created_at) VALUES")
+	builder.WriteString(fmt.Sprintf("INSERT INTO %s (message, tag, created_at) VALUES", table))
 	builder.WriteString("\n")
 	for idx, tweet := range tweets {
-		builder.WriteString(fmt.Sprintf(`("%s", "%s", "%s")`, tweet.message, tweet.tag, tweet.createdAt.Format(time.DateTime)))
+		builder.WriteString(fmt.Sprintf(`(%s, %s, %s)`, quote(tweet.message), quote(tweet.tag), quote(tweet.createdAt.Format(time.DateTime))))
 		if idx != len(tweets)-1 {
 			builder.WriteString(",")
 		} else {
@@ -44,8 +57,7 @@ func main() {
 		builder.WriteString("\n")
 	}
 
-	err = os.WriteFile("database/seeds/20250314164410_tweets.up.sql", []byte(builder.String()), 0777)
-	fatal(err)
+	return builder.String()
 }
 
 func fatal(err error) {
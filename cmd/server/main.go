@@ -1,44 +1,402 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"flag"
-	"log"
+	"fmt"
+	"log/slog"
+	"net/http"
 	"os"
+	"os/signal"
 	"simple_twitter/api"
 	"simple_twitter/database"
+	"simple_twitter/metrics"
+	"simple_twitter/search"
 	"simple_twitter/twitter"
+	"strings"
+	"syscall"
+	"time"
 
 	ff "github.com/peterbourgon/ff/v3"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
 )
 
 func main() {
+	err := run()
+	if err != nil {
+		slog.Error("failed to run the server", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+}
+
+// run serves the API until it's told to stop, and returns once the requests in flight are drained. The storage is
+// closed by the time it returns.
+func run() error {
 	fs := flag.NewFlagSet("simple-twitter", flag.ExitOnError)
 
 	var (
+		storageDriver = fs.String("storage-driver", "mysql", "storage backend to use, one of [mysql, postgres, sqlite, memory]")
+
 		mysqlAddr     = fs.String("mysql-addr", "127.0.0.1:3308", "")
 		mysqlUser     = fs.String("mysql-user", "root", "")
 		mysqlPassword = fs.String("mysql-password", "TopSecret", "")
 		mysqlDatabase = fs.String("mysql-database", "simple_twitter", "")
 
-		listenAddr = fs.String("listen-addr", "localhost:3000", "")
+		postgresAddr     = fs.String("postgres-addr", "127.0.0.1:5433", "")
+		postgresUser     = fs.String("postgres-user", "postgres", "")
+		postgresPassword = fs.String("postgres-password", "TopSecret", "")
+		postgresDatabase = fs.String("postgres-database", "simple_twitter", "")
+		postgresSSLMode  = fs.String("postgres-sslmode", "disable", "one of the libpq sslmode values, e.g [disable, require, verify-full]")
+
+		sqlitePath = fs.String("sqlite-path", "simple_twitter.db", "path to the SQLite database file, created if it doesn't exist")
+
+		maxTweetTags = fs.Int("max-tweet-tags", twitter.MAX_TWEET_TAGS, "maximum number of tags a tweet can have")
+
+		authMode        = fs.String("auth-mode", "none", "how requests authenticate, one of [token, jwt, none]. Requests aren't authenticated by default, tokens are created with `admin create-token`")
+		rolesFile       = fs.String("roles-file", "", "path to a JSON file configuring the roles tokens can be given, the default roles are used if empty")
+		anonymousRoutes = fs.String("anonymous-routes", strings.Join(api.DefaultAnonymousRoutes, ","), "comma separated routes that can be requested without a token, e.g `GET /tweets`")
+
+		jwtKeysFile  = fs.String("jwt-keys-file", "", "JWKS or PEM file with the keys JWTs are signed with, reloaded on SIGHUP")
+		jwtIssuer    = fs.String("jwt-issuer", "", "required `iss` of JWTs, not checked if empty")
+		jwtAudience  = fs.String("jwt-audience", "", "required `aud` of JWTs, not checked if empty")
+		jwtUserClaim = fs.String("jwt-user-claim", "", "claim holding the handle of the user a JWT acts on behalf of, if any")
+		jwtLeeway    = fs.Duration("jwt-leeway", time.Minute, "allowed clock skew when checking `exp` and `nbf` of JWTs")
+
+		rateLimits = fs.String("rate-limits", "POST /tweets=30/1m", "comma separated rate limits of routes per client as `ROUTE=REQUESTS/PERIOD`, rate limiting is turned off if empty")
+		// Clients without a token are rate limited by IP address, which behind a proxy is the proxy's for every client
+		// unless the proxies are trusted to forward the address of the client
+		trustedProxyHops   = fs.Int("trusted-proxy-hops", 0, "number of proxies in front of the server trusted to append the address of their client to `-trusted-proxy-header`, clients are rate limited by the address they connect from if 0")
+		trustedProxyHeader = fs.String("trusted-proxy-header", "X-Forwarded-For", "header trusted proxies forward the address of their client in")
+
+		logFormat = fs.String("log-format", "text", "format of the logs, one of [text, json]")
+		logLevel  = fs.String("log-level", "info", "minimum level of the logs, one of [debug, info, warn, error]")
+
+		traceExporter = fs.String("trace-exporter", "none", "where to export traces to, one of [none, otlp, stdout]. The OTLP exporter is configured with the `OTEL_EXPORTER_OTLP_*` environment variables")
+		traceFile     = fs.String("trace-file", "", "file the stdout exporter appends traces to instead of stdout")
+
+		drainDelay   = fs.Duration("drain-delay", 0, "how long to keep serving requests after `/readyz` starts failing on SIGTERM, for load balancers to stop sending requests first")
+		drainTimeout = fs.Duration("drain-timeout", 30*time.Second, "how long in-flight requests have to finish on SIGTERM before they're cut off")
+
+		readHeaderTimeout = fs.Duration("read-header-timeout", api.DefaultTimeouts.ReadHeader, "how long a client has to send the headers of a request")
+		readTimeout       = fs.Duration("read-timeout", api.DefaultTimeouts.Read, "how long a client has to send a whole request, including its body")
+		writeTimeout      = fs.Duration("write-timeout", api.DefaultTimeouts.Write, "how long the server has to respond to a request once its headers are read")
+		idleTimeout       = fs.Duration("idle-timeout", api.DefaultTimeouts.Idle, "how long a kept-alive connection is kept open without requests")
+		maxBodyBytes      = fs.Int64("max-body-bytes", api.MAX_BODY_BYTES, "size limit of request bodies, larger bodies are rejected with `413`")
+
+		listenAddr      = fs.String("listen-addr", "localhost:3000", "")
+		adminListenAddr = fs.String("admin-listen-addr", "localhost:3001", "address serving `/metrics`, which shouldn't be public. Metrics are turned off if empty")
 	)
 
 	err := ff.Parse(fs, os.Args[1:], ff.WithEnvVarNoPrefix())
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
 
-	conn, err := database.Connect(*mysqlAddr, *mysqlUser, *mysqlPassword, *mysqlDatabase)
+	var level slog.Level
+	err = level.UnmarshalText([]byte(*logLevel))
 	if err != nil {
-		log.Fatal(err)
+		return fmt.Errorf("unknown log level %q, must be one of [debug, info, warn, error]", *logLevel)
+	}
+
+	var handler slog.Handler
+	switch *logFormat {
+	case "text":
+		handler = slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: level})
+	case "json":
+		handler = slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{Level: level})
+	default:
+		return fmt.Errorf("unknown log format %q, must be one of [text, json]", *logFormat)
+	}
+
+	logger := slog.New(handler)
+	slog.SetDefault(logger)
+
+	tracerProvider, err := newTracerProvider(*traceExporter, *traceFile)
+	if err != nil {
+		return err
+	}
+
+	var serverMetrics *metrics.Metrics
+	if *adminListenAddr != "" {
+		serverMetrics = metrics.NewMetrics()
 	}
-	defer conn.Close()
 
 	var (
-		tweetStorage = database.NewTwitterDatabase(conn)
-		twitter      = twitter.NewTwitter(tweetStorage)
-		apiServer    = api.NewServer(*listenAddr, twitter)
+		storage twitter.Storage
+		pingers []api.Pinger
 	)
 
-	apiServer.ListenAndServe()
+	switch *storageDriver {
+	case "mysql":
+		conn, err := database.Connect(*mysqlAddr, *mysqlUser, *mysqlPassword, *mysqlDatabase)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+
+		if serverMetrics != nil {
+			serverMetrics.RegisterDB(*storageDriver, conn.DB)
+		}
+		pingers = append(pingers, conn)
+
+		storage = database.NewTwitterDatabase(database.NewTracedDB(conn, tracerProvider))
+
+	case "postgres":
+		conn, err := database.ConnectPostgres(*postgresAddr, *postgresUser, *postgresPassword, *postgresDatabase, *postgresSSLMode)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+
+		if serverMetrics != nil {
+			serverMetrics.RegisterDB(*storageDriver, conn.DB)
+		}
+		pingers = append(pingers, conn)
+
+		storage = database.NewPostgresTwitterDatabase(database.NewTracedPostgresDB(conn, tracerProvider))
+
+	case "sqlite":
+		conn, err := database.ConnectSQLite(*sqlitePath)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+
+		err = database.MigrateSQLite(context.Background(), conn)
+		if err != nil {
+			return err
+		}
+
+		if serverMetrics != nil {
+			serverMetrics.RegisterDB(*storageDriver, conn.DB)
+		}
+		pingers = append(pingers, conn)
+
+		storage = database.NewSQLiteTwitterDatabase(database.NewTracedSQLiteDB(conn, tracerProvider))
+
+	case "memory":
+		storage = database.NewInMemoryTwitterDatabase()
+
+	default:
+		return fmt.Errorf("unknown storage driver %q, must be one of [mysql, postgres, sqlite, memory]", *storageDriver)
+	}
+
+	roles, err := loadRoles(*rolesFile)
+	if err != nil {
+		return err
+	}
+
+	options := []twitter.Option{twitter.WithMaxTags(*maxTweetTags), twitter.WithRoles(roles)}
+	if serverMetrics != nil {
+		options = append(options, twitter.WithMetrics(serverMetrics))
+	}
+	if *storageDriver != "mysql" {
+		// Only MySQL can search tweets itself, the other storages are searched with an in process index
+		options = append(options, twitter.WithSearchIndex(search.NewIndex()))
+	}
+
+	twitter := twitter.NewTracedTwitter(twitter.NewTwitter(storage, options...), tracerProvider)
+
+	var routes []string
+	for _, route := range strings.Split(*anonymousRoutes, ",") {
+		if route = strings.TrimSpace(route); route != "" {
+			routes = append(routes, route)
+		}
+	}
+
+	health := api.NewHealth(pingers...)
+	apiOptions := []api.Option{
+		api.WithLogger(logger),
+		api.WithTracerProvider(tracerProvider),
+		api.WithHealth(health),
+		api.WithTimeouts(api.Timeouts{ReadHeader: *readHeaderTimeout, Read: *readTimeout, Write: *writeTimeout, Idle: *idleTimeout}),
+		api.WithMaxBodyBytes(*maxBodyBytes),
+	}
+	switch *authMode {
+	case "token":
+		if *storageDriver == "memory" {
+			return errors.New("`-auth-mode=token` can't be used with `-storage-driver=memory` as tokens can't be created for the in-memory storage, use `-auth-mode=none` or `-auth-mode=jwt` instead")
+		}
+
+		apiOptions = append(apiOptions, api.WithAuthentication(twitter, routes...))
+
+	case "jwt":
+		authenticator, err := api.NewJWTAuthenticator(api.JWTConfig{
+			KeysFile:  *jwtKeysFile,
+			Issuer:    *jwtIssuer,
+			Audience:  *jwtAudience,
+			UserClaim: *jwtUserClaim,
+			Leeway:    *jwtLeeway,
+		}, twitter)
+		if err != nil {
+			return err
+		}
+
+		// Reload the keys on SIGHUP, so they can be rotated without a restart
+		reload := make(chan os.Signal, 1)
+		signal.Notify(reload, syscall.SIGHUP)
+		go func() {
+			for range reload {
+				if err := authenticator.Reload(); err != nil {
+					logger.Error("failed to reload JWT keys, keeping the keys in use", slog.String("error", err.Error()))
+					continue
+				}
+				logger.Info("reloaded JWT keys", slog.String("file", *jwtKeysFile))
+			}
+		}()
+
+		apiOptions = append(apiOptions, api.WithAuthentication(authenticator, routes...))
+
+	case "none":
+
+	default:
+		return fmt.Errorf("unknown auth mode %q, must be one of [token, jwt, none]", *authMode)
+	}
+
+	limits, err := api.ParseRateLimits(*rateLimits)
+	if err != nil {
+		return err
+	}
+
+	if len(limits) > 0 {
+		apiOptions = append(apiOptions, api.WithRateLimits(api.NewMemoryRateLimitStore(), limits))
+	}
+
+	if *trustedProxyHops < 0 {
+		return fmt.Errorf("invalid `-trusted-proxy-hops` %d, must not be negative", *trustedProxyHops)
+	}
+
+	if *trustedProxyHops > 0 {
+		apiOptions = append(apiOptions, api.WithTrustedProxies(*trustedProxyHeader, *trustedProxyHops))
+	}
+
+	if serverMetrics != nil {
+		apiOptions = append(apiOptions, api.WithMetrics(serverMetrics))
+	}
+
+	apiServer := api.NewServer(*listenAddr, twitter, apiOptions...)
+
+	err = twitter.IndexTweets(context.Background())
+	if err != nil {
+		return err
+	}
+
+	// Servers that fail to serve stop the server the same way a signal does
+	errs := make(chan error, 2)
+
+	var adminServer *http.Server
+	if serverMetrics != nil {
+		var mux http.ServeMux
+		mux.Handle("GET /metrics", serverMetrics.Handler())
+		adminServer = &http.Server{Addr: *adminListenAddr, Handler: &mux, ReadHeaderTimeout: *readHeaderTimeout}
+
+		go func() {
+			logger.Info("serving metrics", slog.String("addr", *adminListenAddr))
+			if err := adminServer.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+				errs <- fmt.Errorf("failed to serve metrics: %w", err)
+			}
+		}()
+	}
+
+	go func() {
+		logger.Info("serving the API", slog.String("addr", *listenAddr))
+		if err := apiServer.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+			errs <- fmt.Errorf("failed to serve the API: %w", err)
+		}
+	}()
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
+
+	var shutdownErr error
+	select {
+	case sig := <-stop:
+		logger.Info("shutting down", slog.String("signal", sig.String()))
+	case shutdownErr = <-errs:
+		logger.Info("shutting down after failing to serve")
+	}
+
+	// Fail readiness probes first, and keep serving until load balancers have stopped sending requests
+	health.Drain()
+	time.Sleep(*drainDelay)
+
+	ctx, cancel := context.WithTimeout(context.Background(), *drainTimeout)
+	defer cancel()
+
+	// Stop accepting requests and wait for the requests in flight to finish, and cut them off if they don't
+	err = apiServer.Shutdown(ctx)
+	if err != nil {
+		apiServer.Close()
+		shutdownErr = errors.Join(shutdownErr, fmt.Errorf("failed to drain requests in flight: %w", err))
+	}
+
+	if adminServer != nil {
+		adminServer.Shutdown(ctx)
+	}
+
+	err = tracerProvider.Shutdown(ctx)
+	if err != nil {
+		logger.Error("failed to flush traces", slog.String("error", err.Error()))
+	}
+
+	logger.Info("shut down")
+	return shutdownErr
+}
+
+// loadRoles reads the roles configured in path, or returns the default roles if path is empty
+func loadRoles(path string) (twitter.Roles, error) {
+	if path == "" {
+		return twitter.DefaultRoles(), nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return twitter.ParseRoles(data)
+}
+
+// newTracerProvider creates the provider of the tracers that trace requests, exporting spans with exporter
+func newTracerProvider(exporter string, file string) (*sdktrace.TracerProvider, error) {
+	var options []sdktrace.TracerProviderOption
+	switch exporter {
+	case "none":
+
+	case "otlp":
+		otlp, err := otlptracehttp.New(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("failed to create OTLP exporter: %w", err)
+		}
+		options = append(options, sdktrace.WithBatcher(otlp))
+
+	case "stdout":
+		w := os.Stdout
+		if file != "" {
+			var err error
+			w, err = os.OpenFile(file, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+			if err != nil {
+				return nil, fmt.Errorf("failed to open trace file: %w", err)
+			}
+		}
+
+		stdout, err := stdouttrace.New(stdouttrace.WithWriter(w))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create stdout exporter: %w", err)
+		}
+
+		// Spans are written as they end, so they can be followed as requests are served
+		options = append(options, sdktrace.WithSyncer(stdout))
+
+	default:
+		return nil, fmt.Errorf("unknown trace exporter %q, must be one of [none, otlp, stdout]", exporter)
+	}
+
+	options = append(options, sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName("simple_twitter"))))
+	return sdktrace.NewTracerProvider(options...), nil
 }
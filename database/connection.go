@@ -2,11 +2,14 @@ package database
 
 import (
 	"fmt"
+	"net/url"
 
 	_ "database/sql"
 
 	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/jackc/pgx/v5/stdlib"
 	"github.com/jmoiron/sqlx"
+	_ "modernc.org/sqlite"
 )
 
 func Connect(host, username, password, database string) (*sqlx.DB, error) {
@@ -21,3 +24,48 @@ func Connect(host, username, password, database string) (*sqlx.DB, error) {
 
 	return conn, nil
 }
+
+func ConnectPostgres(host, username, password, database, sslMode string) (*sqlx.DB, error) {
+	dsn := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(username, password),
+		Host:     host,
+		Path:     database,
+		RawQuery: url.Values{"sslmode": {sslMode}}.Encode(),
+	}
+
+	conn, err := sqlx.Open("pgx", dsn.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	if err := conn.Ping(); err != nil {
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	return conn, nil
+}
+
+// ConnectSQLite opens the SQLite database file at path, creating it if it doesn't exist
+func ConnectSQLite(path string) (*sqlx.DB, error) {
+	dsn := url.URL{
+		Scheme: "file",
+		Opaque: path,
+		RawQuery: url.Values{
+			// Let readers and the single writer work concurrently, and have writers wait
+			// for each other instead of failing with `database is locked`
+			"_pragma": {"journal_mode(WAL)", "busy_timeout(5000)", "foreign_keys(1)"},
+		}.Encode(),
+	}
+
+	conn, err := sqlx.Open("sqlite", dsn.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	if err := conn.Ping(); err != nil {
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	return conn, nil
+}
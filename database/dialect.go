@@ -0,0 +1,114 @@
+package database
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// dialect captures the differences in SQL between the database engines TwitterDatabase supports
+type dialect int
+
+const (
+	dialectMySQL dialect = iota
+	dialectSQLite
+	dialectPostgres
+)
+
+func (d dialect) String() string {
+	switch d {
+	case dialectSQLite:
+		return "sqlite"
+	case dialectPostgres:
+		return "postgres"
+	default:
+		return "mysql"
+	}
+}
+
+// fullText reports whether the dialect supports `MATCH ... AGAINST` full-text search, which needs the
+// FULLTEXT index on `Tweets.message`
+func (d dialect) fullText() bool {
+	return d == dialectMySQL
+}
+
+// year returns an expression extracting the year, as an integer, from a datetime column. Postgres truncates the
+// column to the start of its year with `date_trunc` first, so rows are grouped by the year they fall in.
+func (d dialect) year(column string) string {
+	switch d {
+	case dialectSQLite:
+		return fmt.Sprintf("CAST(strftime('%%Y', %s) AS INTEGER)", column)
+	case dialectPostgres:
+		return fmt.Sprintf("CAST(EXTRACT(YEAR FROM date_trunc('year', %s)) AS INTEGER)", column)
+	default:
+		return fmt.Sprintf("YEAR(%s)", column)
+	}
+}
+
+// month returns an expression extracting the month, as an integer, from a datetime column. Postgres truncates
+// the column to the start of its month with `date_trunc` first, the same as year.
+func (d dialect) month(column string) string {
+	switch d {
+	case dialectSQLite:
+		return fmt.Sprintf("CAST(strftime('%%m', %s) AS INTEGER)", column)
+	case dialectPostgres:
+		return fmt.Sprintf("CAST(EXTRACT(MONTH FROM date_trunc('month', %s)) AS INTEGER)", column)
+	default:
+		return fmt.Sprintf("MONTH(%s)", column)
+	}
+}
+
+// time converts t to a value that can be compared with a datetime column. SQLite stores datetimes as
+// text in UTC, so they have to be formatted the same way as `CURRENT_TIMESTAMP` for comparisons to hold.
+func (d dialect) time(t time.Time) any {
+	switch d {
+	case dialectSQLite:
+		return t.UTC().Format("2006-01-02 15:04:05.999999999")
+	case dialectPostgres:
+		// `timestamp without time zone` columns hold UTC wall clock time, and the driver encodes the
+		// wall clock of t as is. `timestamp(0)` rounds fractional seconds where MySQL truncates them, so
+		// they're truncated here to store the same second.
+		return t.UTC().Truncate(time.Second)
+	default:
+		return t
+	}
+}
+
+// now returns an expression for the current time, the same way the `created_at` column defaults to it. Postgres
+// truncates it to the second, as `timestamp(0)` columns would round it up otherwise.
+func (d dialect) now() string {
+	switch d {
+	case dialectPostgres:
+		return "date_trunc('second', CURRENT_TIMESTAMP AT TIME ZONE 'UTC')"
+	default:
+		return "CURRENT_TIMESTAMP"
+	}
+}
+
+// forUpdate returns the clause locking the rows selected in a transaction until it ends, SQLite doesn't need
+// one as it only allows a single writer at a time
+func (d dialect) forUpdate() string {
+	switch d {
+	case dialectSQLite:
+		return ""
+	default:
+		return "FOR UPDATE"
+	}
+}
+
+// returning reports whether the dialect supports `INSERT ... RETURNING`, in which case it must be used to
+// get the id of inserted rows as the driver doesn't support `LastInsertId`
+func (d dialect) returning() bool {
+	return d == dialectPostgres
+}
+
+// rebind rewrites the `?` bindvars used by the queries in this package into the bindvars of the dialect
+func (d dialect) rebind(query string) string {
+	switch d {
+	case dialectPostgres:
+		return sqlx.Rebind(sqlx.DOLLAR, query)
+	default:
+		return query
+	}
+}
@@ -0,0 +1,527 @@
+package database
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"simple_twitter/models"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+)
+
+// InMemoryTwitterDatabase is a concurrency safe, in process implementation of the tweet storage
+// that mirrors the behaviour of TwitterDatabase. It is intended for tests and local development
+// where running MySQL isn't practical, all data is lost when the process exits.
+type InMemoryTwitterDatabase struct {
+	mu        sync.RWMutex
+	nextID    int64
+	tweets    []models.Tweet // Ordered by id, ascending
+	deleted   map[int64]time.Time
+	revisions map[int64][]models.TweetRevision
+
+	nextUserID int64
+	users      []models.User // Ordered by id, ascending
+
+	tokens      []models.Token // Ordered by id, ascending
+	tokenHashes map[string]int64
+}
+
+func (t *InMemoryTwitterDatabase) CreateTweet(ctx context.Context, authorID *int64, message string, tags []string) (int64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, fmt.Errorf("failed to insert tweet: %w", err)
+	}
+
+	if len(tags) == 0 {
+		return 0, errors.New("failed to insert tweet: a tweet must have at least one tag")
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if authorID != nil {
+		id := *authorID
+		authorID = &id
+	}
+
+	t.nextID++
+	t.tweets = append(t.tweets, models.Tweet{
+		ID:       t.nextID,
+		Message:  message,
+		Tag:      tags[0],
+		Tags:     slices.Clone(tags),
+		AuthorID: authorID,
+		// Mirror the `datetime DEFAULT CURRENT_TIMESTAMP` column which only has second precision
+		CreatedAt: time.Now().UTC().Truncate(time.Second),
+	})
+
+	return t.nextID, nil
+}
+
+func (t *InMemoryTwitterDatabase) GetTweet(ctx context.Context, id int64) (models.Tweet, error) {
+	if err := ctx.Err(); err != nil {
+		return models.Tweet{}, fmt.Errorf("failed to get tweet: %w", err)
+	}
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	idx, err := t.find(id)
+	if err != nil {
+		return models.Tweet{}, err
+	}
+
+	return clone(t.tweets[idx]), nil
+}
+
+// GetTweets returns the tweets with ids in no particular order, leaving out those that are missing or deleted
+func (t *InMemoryTwitterDatabase) GetTweets(ctx context.Context, ids []int64) ([]models.Tweet, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get tweets: %w", err)
+	}
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	tweets := []models.Tweet{}
+	for _, id := range ids {
+		idx, err := t.find(id)
+		if err != nil {
+			continue
+		}
+
+		tweets = append(tweets, clone(t.tweets[idx]))
+	}
+
+	return tweets, nil
+}
+
+func (t *InMemoryTwitterDatabase) ListTweets(ctx context.Context, query models.TweetQuery) ([]models.Tweet, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get tweets: %w", err)
+	}
+
+	if query.Offset < 0 || query.Limit < 0 {
+		return nil, fmt.Errorf("failed to get tweets: invalid offset (%d) or limit (%d)", query.Offset, query.Limit)
+	}
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	tweets := []models.Tweet{}
+	for _, tweet := range t.tweets {
+		if _, deleted := t.deleted[tweet.ID]; deleted || !matches(tweet, query) {
+			continue
+		}
+
+		if query.After != nil && !after(tweet, *query.After, query.Sort) {
+			continue
+		}
+
+		tweets = append(tweets, clone(tweet))
+	}
+
+	sort.Slice(tweets, func(i, j int) bool {
+		return after(tweets[j], *models.CursorOf(tweets[i]), query.Sort)
+	})
+
+	tweets = tweets[min(query.Offset, len(tweets)):]
+	return tweets[:min(query.Limit, len(tweets))], nil
+}
+
+func (t *InMemoryTwitterDatabase) SearchTweets(ctx context.Context, query models.SearchQuery) ([]models.Tweet, error) {
+	return nil, models.ErrUnsupported("full-text search isn't supported by the memory storage")
+}
+
+func (t *InMemoryTwitterDatabase) UpdateTweet(ctx context.Context, id int64, edit func(current models.Tweet) (string, []string, error)) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("failed to update tweet: %w", err)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	idx, err := t.find(id)
+	if err != nil {
+		return err
+	}
+
+	message, tags, err := edit(clone(t.tweets[idx]))
+	if err != nil {
+		return err
+	}
+
+	if len(tags) == 0 {
+		return errors.New("failed to update tweet: a tweet must have at least one tag")
+	}
+
+	tweet := &t.tweets[idx]
+	previousCreatedAt := tweet.CreatedAt
+	if tweet.EditedAt != nil {
+		previousCreatedAt = *tweet.EditedAt
+	}
+
+	t.revisions[id] = append(t.revisions[id], models.TweetRevision{
+		Revision:  tweet.Revisions + 1,
+		Message:   tweet.Message,
+		Tags:      slices.Clone(tweet.Tags),
+		CreatedAt: previousCreatedAt,
+	})
+
+	// Mirror the `datetime` column which only has second precision
+	editedAt := time.Now().UTC().Truncate(time.Second)
+	tweet.Message = message
+	tweet.Tag = tags[0]
+	tweet.Tags = slices.Clone(tags)
+	tweet.EditedAt = &editedAt
+	tweet.Revisions++
+	return nil
+}
+
+func (t *InMemoryTwitterDatabase) ListRevisions(ctx context.Context, id int64) ([]models.TweetRevision, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get tweet revisions: %w", err)
+	}
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	if _, err := t.find(id); err != nil {
+		return nil, err
+	}
+
+	revisions := make([]models.TweetRevision, 0, len(t.revisions[id]))
+	for _, revision := range t.revisions[id] {
+		revision.Tags = slices.Clone(revision.Tags)
+		revisions = append(revisions, revision)
+	}
+
+	return revisions, nil
+}
+
+func (t *InMemoryTwitterDatabase) DeleteTweet(ctx context.Context, id int64) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("failed to delete tweet: %w", err)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, err := t.find(id); err != nil {
+		return err
+	}
+
+	// Mirror the `datetime` column which only has second precision
+	t.deleted[id] = time.Now().UTC().Truncate(time.Second)
+	return nil
+}
+
+func (t *InMemoryTwitterDatabase) PurgeTweets(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, fmt.Errorf("failed to purge tweets: %w", err)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var purged int64
+	t.tweets = slices.DeleteFunc(t.tweets, func(tweet models.Tweet) bool {
+		deletedAt, deleted := t.deleted[tweet.ID]
+		if !deleted || !deletedAt.Before(deletedBefore) {
+			return false
+		}
+
+		delete(t.deleted, tweet.ID)
+		delete(t.revisions, tweet.ID)
+		purged++
+		return true
+	})
+
+	return purged, nil
+}
+
+func (t *InMemoryTwitterDatabase) AggregateTweetsByYear(ctx context.Context, from time.Time, to time.Time) ([]models.YearlyAggregate, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("failed to aggregate tweets: %w", err)
+	}
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	var aggregates []models.YearlyAggregate
+	for _, tweet := range t.tweets {
+		if _, deleted := t.deleted[tweet.ID]; deleted || !between(tweet.CreatedAt, from, to) {
+			continue
+		}
+
+		year := tweet.CreatedAt.Year()
+		idx := sort.Search(len(aggregates), func(i int) bool { return aggregates[i].Year >= year })
+		if idx == len(aggregates) || aggregates[idx].Year != year {
+			aggregates = append(aggregates, models.YearlyAggregate{})
+			copy(aggregates[idx+1:], aggregates[idx:])
+			aggregates[idx] = models.YearlyAggregate{Year: year}
+		}
+		aggregates[idx].Tweets++
+	}
+
+	return aggregates, nil
+}
+
+func (t *InMemoryTwitterDatabase) AggregateTweetsByMonth(ctx context.Context, from time.Time, to time.Time) ([]models.MonthlyAggregate, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("failed to aggregate tweets: %w", err)
+	}
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	var aggregates []models.MonthlyAggregate
+	for _, tweet := range t.tweets {
+		if _, deleted := t.deleted[tweet.ID]; deleted || !between(tweet.CreatedAt, from, to) {
+			continue
+		}
+
+		year, month := tweet.CreatedAt.Year(), int(tweet.CreatedAt.Month())
+		idx := sort.Search(len(aggregates), func(i int) bool {
+			return aggregates[i].Year > year || (aggregates[i].Year == year && aggregates[i].Month >= month)
+		})
+		if idx == len(aggregates) || aggregates[idx].Year != year || aggregates[idx].Month != month {
+			aggregates = append(aggregates, models.MonthlyAggregate{})
+			copy(aggregates[idx+1:], aggregates[idx:])
+			aggregates[idx] = models.MonthlyAggregate{Year: year, Month: month}
+		}
+		aggregates[idx].Tweets++
+	}
+
+	return aggregates, nil
+}
+
+func (t *InMemoryTwitterDatabase) CreateUser(ctx context.Context, handle string, displayName string) (int64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, fmt.Errorf("failed to insert user: %w", err)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, user := range t.users {
+		if user.Handle == handle {
+			return 0, models.ErrConflictf("handle %q is already taken", handle)
+		}
+	}
+
+	t.nextUserID++
+	t.users = append(t.users, models.User{
+		ID:          t.nextUserID,
+		Handle:      handle,
+		DisplayName: displayName,
+		// Mirror the `datetime DEFAULT CURRENT_TIMESTAMP` column which only has second precision
+		CreatedAt: time.Now().UTC().Truncate(time.Second),
+	})
+
+	return t.nextUserID, nil
+}
+
+func (t *InMemoryTwitterDatabase) GetUser(ctx context.Context, id int64) (models.User, error) {
+	if err := ctx.Err(); err != nil {
+		return models.User{}, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	idx, found := sort.Find(len(t.users), func(i int) int {
+		return cmp.Compare(id, t.users[i].ID)
+	})
+
+	if !found {
+		return models.User{}, models.ErrMissingf("found no user with id %d", id)
+	}
+
+	return t.users[idx], nil
+}
+
+func (t *InMemoryTwitterDatabase) GetUserByHandle(ctx context.Context, handle string) (models.User, error) {
+	if err := ctx.Err(); err != nil {
+		return models.User{}, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	for _, user := range t.users {
+		if user.Handle == handle {
+			return user, nil
+		}
+	}
+
+	return models.User{}, models.ErrMissingf("found no user with handle %s", handle)
+}
+
+func (t *InMemoryTwitterDatabase) CreateToken(ctx context.Context, name string, userID *int64, roles []string, hash string) (int64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, fmt.Errorf("failed to insert token: %w", err)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, taken := t.tokenHashes[hash]; taken {
+		return 0, errors.New("failed to insert token: duplicate token hash")
+	}
+
+	if userID != nil {
+		id := *userID
+		userID = &id
+	}
+
+	id := int64(len(t.tokens) + 1)
+	t.tokens = append(t.tokens, models.Token{
+		ID:     id,
+		Name:   name,
+		UserID: userID,
+		Roles:  append([]string{}, roles...),
+		// Mirror the `datetime DEFAULT CURRENT_TIMESTAMP` column which only has second precision
+		CreatedAt: time.Now().UTC().Truncate(time.Second),
+	})
+	t.tokenHashes[hash] = id
+
+	return id, nil
+}
+
+func (t *InMemoryTwitterDatabase) GetTokenByHash(ctx context.Context, hash string) (models.Token, error) {
+	if err := ctx.Err(); err != nil {
+		return models.Token{}, fmt.Errorf("failed to get token: %w", err)
+	}
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	id, found := t.tokenHashes[hash]
+	if !found {
+		return models.Token{}, models.ErrMissing("found no such token")
+	}
+
+	return cloneToken(t.tokens[id-1]), nil
+}
+
+func (t *InMemoryTwitterDatabase) RevokeToken(ctx context.Context, id int64) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("failed to revoke token: %w", err)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if id <= 0 || id > int64(len(t.tokens)) {
+		return models.ErrMissing("found no such token")
+	}
+
+	token := &t.tokens[id-1]
+	if token.RevokedAt != nil {
+		return models.ErrGonef("token with id %d has already been revoked", id)
+	}
+
+	// Mirror the `datetime` column which only has second precision
+	revokedAt := time.Now().UTC().Truncate(time.Second)
+	token.RevokedAt = &revokedAt
+	return nil
+}
+
+// find returns the index of the tweet with id in t.tweets, or an error if it's missing or deleted. The caller
+// must hold t.mu.
+func (t *InMemoryTwitterDatabase) find(id int64) (int, error) {
+	idx, found := sort.Find(len(t.tweets), func(i int) int {
+		switch {
+		case id < t.tweets[i].ID:
+			return -1
+		case id > t.tweets[i].ID:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	if !found {
+		return 0, models.ErrMissingf("found no tweet with id %d", id)
+	}
+
+	if _, deleted := t.deleted[id]; deleted {
+		return 0, models.ErrGonef("tweet with id %d has been deleted", id)
+	}
+
+	return idx, nil
+}
+
+// matches reports whether tweet satisfies the filters of query
+func matches(tweet models.Tweet, query models.TweetQuery) bool {
+	switch {
+	case query.Tag != "" && !slices.Contains(tweet.Tags, query.Tag):
+		return false
+	case query.AuthorID > 0 && (tweet.AuthorID == nil || *tweet.AuthorID != query.AuthorID):
+		return false
+	case !query.Since.IsZero() && tweet.CreatedAt.Before(query.Since):
+		return false
+	case !query.Until.IsZero() && !tweet.CreatedAt.Before(query.Until):
+		return false
+	case query.SinceID > 0 && tweet.ID <= query.SinceID:
+		return false
+	case query.MaxID > 0 && tweet.ID > query.MaxID:
+		return false
+	default:
+		return true
+	}
+}
+
+// after reports whether tweet is positioned after cursor when ordered by `created_at` and then `id` in the
+// given sort order
+func after(tweet models.Tweet, cursor models.Cursor, order models.SortOrder) bool {
+	if order == models.SortNewest {
+		return tweet.CreatedAt.Before(cursor.CreatedAt) || (tweet.CreatedAt.Equal(cursor.CreatedAt) && tweet.ID < cursor.ID)
+	}
+
+	return tweet.CreatedAt.After(cursor.CreatedAt) || (tweet.CreatedAt.Equal(cursor.CreatedAt) && tweet.ID > cursor.ID)
+}
+
+// clone returns a copy of tweet that doesn't share its tags, author or edit time with the stored tweet
+func clone(tweet models.Tweet) models.Tweet {
+	tweet.Tags = slices.Clone(tweet.Tags)
+	if tweet.AuthorID != nil {
+		authorID := *tweet.AuthorID
+		tweet.AuthorID = &authorID
+	}
+	if tweet.EditedAt != nil {
+		editedAt := *tweet.EditedAt
+		tweet.EditedAt = &editedAt
+	}
+	return tweet
+}
+
+// cloneToken returns a copy of token that doesn't share its user, roles or revocation time with the stored token
+func cloneToken(token models.Token) models.Token {
+	token.Roles = append([]string{}, token.Roles...)
+	if token.UserID != nil {
+		userID := *token.UserID
+		token.UserID = &userID
+	}
+	if token.RevokedAt != nil {
+		revokedAt := *token.RevokedAt
+		token.RevokedAt = &revokedAt
+	}
+	return token
+}
+
+// between mirrors the inclusive SQL `BETWEEN from AND to` operator
+func between(t time.Time, from time.Time, to time.Time) bool {
+	return !t.Before(from) && !t.After(to)
+}
+
+func NewInMemoryTwitterDatabase() *InMemoryTwitterDatabase {
+	return &InMemoryTwitterDatabase{
+		deleted:     map[int64]time.Time{},
+		revisions:   map[int64][]models.TweetRevision{},
+		tokenHashes: map[string]int64{},
+	}
+}
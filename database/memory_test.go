@@ -0,0 +1,27 @@
+package database
+
+import (
+	"context"
+	"simple_twitter/models"
+	"simple_twitter/twitter/storagetest"
+	"testing"
+)
+
+func TestInMemoryTwitterDatabaseConformance(t *testing.T) {
+	storagetest.Run(t, func(t *testing.T) storagetest.Harness {
+		storage := NewInMemoryTwitterDatabase()
+		return storagetest.Harness{
+			Storage: storage,
+			InsertTweet: func(ctx context.Context, tweet models.Tweet) (int64, error) {
+				storage.mu.Lock()
+				defer storage.mu.Unlock()
+
+				storage.nextID++
+				tweet.ID = storage.nextID
+				tweet.Tag = tweet.Tags[0]
+				storage.tweets = append(storage.tweets, tweet)
+				return tweet.ID, nil
+			},
+		}
+	})
+}
@@ -0,0 +1,114 @@
+package database
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database/sqlite"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+	"github.com/jmoiron/sqlx"
+)
+
+//go:embed sqlite/migrations/*.sql
+var sqliteMigrations embed.FS
+
+// MigrateSQLite applies the migrations in `database/sqlite/migrations` that haven't already been applied.
+// The migrations are embedded in the binary so a SQLite backed server doesn't need any files besides its
+// database.
+func MigrateSQLite(ctx context.Context, conn *sqlx.DB) error {
+	m, err := sqliteMigrator(ctx, conn)
+	if err != nil {
+		return err
+	}
+
+	err = m.Up()
+	if err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return fmt.Errorf("failed to apply migrations: %w", err)
+	}
+
+	return nil
+}
+
+// sqliteMigrator returns a golang-migrate instance for the embedded SQLite migrations, the same tool that
+// migrates the MySQL and PostgreSQL databases. It must not be closed, that would close conn as well.
+func sqliteMigrator(ctx context.Context, conn *sqlx.DB) (*migrate.Migrate, error) {
+	err := convertLegacyMigrations(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := iofs.New(sqliteMigrations, "sqlite/migrations")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations: %w", err)
+	}
+
+	driver, err := sqlite.WithInstance(conn.DB, &sqlite.Config{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create migrations table: %w", err)
+	}
+
+	m, err := migrate.NewWithInstance("iofs", source, "sqlite", driver)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create migrator: %w", err)
+	}
+
+	return m, nil
+}
+
+// convertLegacyMigrations rewrites the `schema_migrations` table of databases migrated before SQLite was
+// migrated by golang-migrate, which recorded a row per applied version, into golang-migrate's single
+// `(version, dirty)` row.
+func convertLegacyMigrations(ctx context.Context, conn *sqlx.DB) error {
+	var legacy bool
+	err := conn.GetContext(
+		ctx,
+		&legacy,
+		`SELECT COUNT(*) = 1 FROM pragma_table_info('schema_migrations') WHERE name = 'version' AND pk = 1`,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to inspect migrations table: %w", err)
+	}
+
+	if !legacy {
+		return nil
+	}
+
+	tx, err := conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin converting migrations table: %w", err)
+	}
+	defer tx.Rollback()
+
+	var version int64
+	err = tx.GetContext(ctx, &version, `SELECT COALESCE(MAX(version), 0) FROM schema_migrations`)
+	if err != nil {
+		return fmt.Errorf("failed to get current migration version: %w", err)
+	}
+
+	statements := []string{
+		`DROP TABLE schema_migrations`,
+		`CREATE TABLE schema_migrations (version uint64, dirty bool)`,
+		`CREATE UNIQUE INDEX version_unique ON schema_migrations (version)`,
+	}
+	for _, statement := range statements {
+		if _, err := tx.ExecContext(ctx, statement); err != nil {
+			return fmt.Errorf("failed to convert migrations table: %w", err)
+		}
+	}
+
+	if version > 0 {
+		_, err = tx.ExecContext(ctx, `INSERT INTO schema_migrations (version, dirty) VALUES (?, false)`, version)
+		if err != nil {
+			return fmt.Errorf("failed to record migration version %d: %w", version, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit converted migrations table: %w", err)
+	}
+
+	return nil
+}
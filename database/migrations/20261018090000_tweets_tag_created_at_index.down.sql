@@ -0,0 +1,3 @@
+ALTER TABLE `Tweets`
+  ADD KEY `TAG` (`tag`) USING BTREE,
+  DROP KEY `TAG_CREATED_AT_ID`;
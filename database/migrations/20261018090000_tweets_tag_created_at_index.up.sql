@@ -0,0 +1,3 @@
+ALTER TABLE `Tweets`
+  ADD KEY `TAG_CREATED_AT_ID` (`tag`, `created_at`, `id`) USING BTREE,
+  DROP KEY `TAG`;
@@ -0,0 +1,12 @@
+CREATE TABLE `TweetTags` (
+  `tweet_id` BIGINT NOT NULL,
+  `position` INT NOT NULL,
+  `tag` varchar(32) NOT NULL,
+  `created_at` datetime NOT NULL,
+  PRIMARY KEY (`tweet_id`, `position`),
+  KEY `TAG_CREATED_AT_TWEET_ID` (`tag`, `created_at`, `tweet_id`) USING BTREE,
+  CONSTRAINT `TWEET_TAGS_TWEET_ID` FOREIGN KEY (`tweet_id`) REFERENCES `Tweets` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+
+INSERT INTO `TweetTags` (tweet_id, position, tag, created_at)
+SELECT id, 0, tag, created_at FROM `Tweets`;
@@ -0,0 +1,3 @@
+-- The original casing of the tags is lost, only the collation is restored
+ALTER TABLE `Tweets` MODIFY `tag` varchar(32) NOT NULL COLLATE utf8mb4_0900_ai_ci;
+ALTER TABLE `TweetTags` MODIFY `tag` varchar(32) NOT NULL COLLATE utf8mb4_0900_ai_ci;
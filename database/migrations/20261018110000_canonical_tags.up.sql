@@ -0,0 +1,10 @@
+-- Tags are compared as they are stored, Go code decides which tags are the same by normalizing them
+ALTER TABLE `Tweets` MODIFY `tag` varchar(32) NOT NULL COLLATE utf8mb4_bin;
+ALTER TABLE `TweetTags` MODIFY `tag` varchar(32) NOT NULL COLLATE utf8mb4_bin;
+
+-- Best effort backfill of the canonical tags, run `normalize-tags` for the full canonicalization
+UPDATE `Tweets` SET tag = LOWER(TRIM(tag));
+UPDATE `TweetTags` SET tag = LOWER(TRIM(tag));
+
+DELETE duplicate FROM `TweetTags` duplicate
+JOIN `TweetTags` original ON original.tweet_id = duplicate.tweet_id AND original.tag = duplicate.tag AND original.position < duplicate.position;
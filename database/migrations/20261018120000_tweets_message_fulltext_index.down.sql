@@ -0,0 +1 @@
+ALTER TABLE `Tweets` DROP INDEX `MESSAGE_FULLTEXT`;
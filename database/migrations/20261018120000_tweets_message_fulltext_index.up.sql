@@ -0,0 +1 @@
+ALTER TABLE `Tweets` ADD FULLTEXT INDEX `MESSAGE_FULLTEXT` (`message`);
@@ -0,0 +1,3 @@
+ALTER TABLE `Tweets`
+  DROP KEY `DELETED_AT`,
+  DROP COLUMN `deleted_at`;
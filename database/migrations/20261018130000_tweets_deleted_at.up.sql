@@ -0,0 +1,3 @@
+ALTER TABLE `Tweets`
+  ADD COLUMN `deleted_at` datetime NULL DEFAULT NULL,
+  ADD KEY `DELETED_AT` (`deleted_at`) USING BTREE;
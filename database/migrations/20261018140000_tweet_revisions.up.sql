@@ -0,0 +1,13 @@
+ALTER TABLE `Tweets`
+  ADD COLUMN `edited_at` datetime NULL DEFAULT NULL,
+  ADD COLUMN `revisions` INT NOT NULL DEFAULT 0;
+
+CREATE TABLE `TweetRevisions` (
+  `tweet_id` BIGINT NOT NULL,
+  `revision` INT NOT NULL,
+  `message` text NOT NULL,
+  `tags` text NOT NULL,
+  `created_at` datetime NOT NULL,
+  PRIMARY KEY (`tweet_id`, `revision`),
+  CONSTRAINT `TWEET_REVISIONS_TWEET_ID` FOREIGN KEY (`tweet_id`) REFERENCES `Tweets` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
@@ -0,0 +1,6 @@
+ALTER TABLE `Tweets`
+  DROP FOREIGN KEY `TWEETS_AUTHOR_ID`,
+  DROP KEY `AUTHOR_ID_CREATED_AT_ID`,
+  DROP COLUMN `author_id`;
+
+DROP TABLE `Users`;
@@ -0,0 +1,13 @@
+CREATE TABLE `Users` (
+  `id` BIGINT NOT NULL AUTO_INCREMENT,
+  `handle` varchar(15) NOT NULL,
+  `display_name` varchar(50) NOT NULL DEFAULT '',
+  `created_at` datetime DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `HANDLE` (`handle`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+
+ALTER TABLE `Tweets`
+  ADD COLUMN `author_id` BIGINT NULL DEFAULT NULL,
+  ADD KEY `AUTHOR_ID_CREATED_AT_ID` (`author_id`, `created_at`, `id`) USING BTREE,
+  ADD CONSTRAINT `TWEETS_AUTHOR_ID` FOREIGN KEY (`author_id`) REFERENCES `Users` (`id`);
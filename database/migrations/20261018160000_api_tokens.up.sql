@@ -0,0 +1,11 @@
+CREATE TABLE `ApiTokens` (
+  `id` BIGINT NOT NULL AUTO_INCREMENT,
+  `name` varchar(64) NOT NULL,
+  `user_id` BIGINT NULL DEFAULT NULL,
+  `token_hash` char(64) NOT NULL,
+  `created_at` datetime DEFAULT CURRENT_TIMESTAMP,
+  `revoked_at` datetime NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `TOKEN_HASH` (`token_hash`),
+  CONSTRAINT `API_TOKENS_USER_ID` FOREIGN KEY (`user_id`) REFERENCES `Users` (`id`)
+) ENGINE=InnoDB AUTO_INCREMENT=1 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
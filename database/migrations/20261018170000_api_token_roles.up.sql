@@ -0,0 +1,4 @@
+ALTER TABLE `ApiTokens` ADD COLUMN `roles` varchar(255) NOT NULL DEFAULT '';
+
+-- Tokens created before roles could do anything
+UPDATE `ApiTokens` SET `roles` = 'admin';
@@ -0,0 +1 @@
+DROP TABLE Tweets;
\ No newline at end of file
@@ -0,0 +1,8 @@
+CREATE TABLE Tweets (
+  id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  message text NOT NULL,
+  tag varchar(32) NOT NULL,
+  created_at timestamp(0) DEFAULT (CURRENT_TIMESTAMP AT TIME ZONE 'UTC')
+);
+CREATE INDEX tweets_created_at ON Tweets (created_at);
+CREATE INDEX tweets_tag ON Tweets (tag);
@@ -0,0 +1,2 @@
+CREATE INDEX tweets_tag ON Tweets (tag);
+DROP INDEX tweets_tag_created_at_id;
@@ -0,0 +1,2 @@
+CREATE INDEX tweets_tag_created_at_id ON Tweets (tag, created_at, id);
+DROP INDEX tweets_tag;
@@ -0,0 +1 @@
+DROP TABLE TweetTags;
\ No newline at end of file
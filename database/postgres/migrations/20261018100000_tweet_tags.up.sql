@@ -0,0 +1,11 @@
+CREATE TABLE TweetTags (
+  tweet_id BIGINT NOT NULL REFERENCES Tweets (id) ON DELETE CASCADE,
+  position INT NOT NULL,
+  tag varchar(32) NOT NULL,
+  created_at timestamp(0) NOT NULL,
+  PRIMARY KEY (tweet_id, position)
+);
+CREATE INDEX tweet_tags_tag_created_at_tweet_id ON TweetTags (tag, created_at, tweet_id);
+
+INSERT INTO TweetTags (tweet_id, position, tag, created_at)
+SELECT id, 0, tag, created_at FROM Tweets;
@@ -0,0 +1,2 @@
+-- The original casing of the tags is lost, there is nothing to restore
+SELECT 1;
@@ -0,0 +1,9 @@
+-- Best effort backfill of the canonical tags, run `normalize-tags` for the full canonicalization
+UPDATE Tweets SET tag = normalize(lower(btrim(tag)), NFC);
+UPDATE TweetTags SET tag = normalize(lower(btrim(tag)), NFC);
+
+DELETE FROM TweetTags
+WHERE EXISTS (
+  SELECT 1 FROM TweetTags original
+  WHERE original.tweet_id = TweetTags.tweet_id AND original.tag = TweetTags.tag AND original.position < TweetTags.position
+);
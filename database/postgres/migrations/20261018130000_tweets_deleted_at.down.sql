@@ -0,0 +1,2 @@
+DROP INDEX tweets_deleted_at;
+ALTER TABLE Tweets DROP COLUMN deleted_at;
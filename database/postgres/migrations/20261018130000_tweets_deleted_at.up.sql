@@ -0,0 +1,2 @@
+ALTER TABLE Tweets ADD COLUMN deleted_at timestamp(0) NULL DEFAULT NULL;
+CREATE INDEX tweets_deleted_at ON Tweets (deleted_at);
@@ -0,0 +1,5 @@
+DROP TABLE TweetRevisions;
+
+ALTER TABLE Tweets
+  DROP COLUMN revisions,
+  DROP COLUMN edited_at;
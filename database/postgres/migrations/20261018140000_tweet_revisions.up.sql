@@ -0,0 +1,12 @@
+ALTER TABLE Tweets
+  ADD COLUMN edited_at timestamp(0) NULL DEFAULT NULL,
+  ADD COLUMN revisions INT NOT NULL DEFAULT 0;
+
+CREATE TABLE TweetRevisions (
+  tweet_id BIGINT NOT NULL REFERENCES Tweets (id) ON DELETE CASCADE,
+  revision INT NOT NULL,
+  message text NOT NULL,
+  tags text NOT NULL,
+  created_at timestamp(0) NOT NULL,
+  PRIMARY KEY (tweet_id, revision)
+);
@@ -0,0 +1,4 @@
+DROP INDEX tweets_author_id_created_at_id;
+ALTER TABLE Tweets DROP COLUMN author_id;
+
+DROP TABLE Users;
@@ -0,0 +1,9 @@
+CREATE TABLE Users (
+  id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  handle varchar(15) NOT NULL UNIQUE,
+  display_name varchar(50) NOT NULL DEFAULT '',
+  created_at timestamp(0) DEFAULT (CURRENT_TIMESTAMP AT TIME ZONE 'UTC')
+);
+
+ALTER TABLE Tweets ADD COLUMN author_id BIGINT NULL DEFAULT NULL REFERENCES Users (id);
+CREATE INDEX tweets_author_id_created_at_id ON Tweets (author_id, created_at, id);
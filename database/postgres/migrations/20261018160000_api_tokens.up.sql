@@ -0,0 +1,8 @@
+CREATE TABLE ApiTokens (
+  id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  name varchar(64) NOT NULL,
+  user_id BIGINT NULL DEFAULT NULL REFERENCES Users (id),
+  token_hash char(64) NOT NULL UNIQUE,
+  created_at timestamp(0) DEFAULT (CURRENT_TIMESTAMP AT TIME ZONE 'UTC'),
+  revoked_at timestamp(0) NULL DEFAULT NULL
+);
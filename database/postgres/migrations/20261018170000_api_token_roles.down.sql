@@ -0,0 +1 @@
+ALTER TABLE ApiTokens DROP COLUMN roles;
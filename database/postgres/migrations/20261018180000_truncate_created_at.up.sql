@@ -0,0 +1,4 @@
+-- `timestamp(0)` rounds fractional seconds, truncate them instead like MySQL does
+ALTER TABLE Tweets ALTER COLUMN created_at SET DEFAULT date_trunc('second', CURRENT_TIMESTAMP AT TIME ZONE 'UTC');
+ALTER TABLE Users ALTER COLUMN created_at SET DEFAULT date_trunc('second', CURRENT_TIMESTAMP AT TIME ZONE 'UTC');
+ALTER TABLE ApiTokens ALTER COLUMN created_at SET DEFAULT date_trunc('second', CURRENT_TIMESTAMP AT TIME ZONE 'UTC');
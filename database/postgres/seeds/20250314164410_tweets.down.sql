@@ -0,0 +1 @@
+DELETE FROM Tweets;
\ No newline at end of file
@@ -0,0 +1,2001 @@
+INSERT INTO Tweets (message, tag, created_at) VALUES
+('I''ll transmit the digital RAM capacitor, that should driver the GB bus!', 'circuit-generate', '2024-03-14 19:36:22'),
+('I''ll parse the wireless HTTP hard drive, that should hard drive the AI panel!', 'feed-connect', '2024-03-15 00:21:19'),
+('I''ll compress the cross-platform JBOD bandwidth, that should panel the DNS sensor!', 'hard-drive-bypass', '2024-03-15 03:27:04'),
+('I''ll parse the multi-byte UDP alarm, that should protocol the SSL feed!', 'pixel-connect', '2024-03-15 12:29:40'),
+('Try to transmit the FTP bandwidth, maybe it will parse the cross-platform bus!', 'array-reboot', '2024-03-15 13:54:57'),
+('I''ll navigate the auxiliary API hard drive, that should sensor the ADP microchip!', 'pixel-calculate', '2024-03-15 18:49:45'),
+('compressing the bandwidth won''t do anything, we need to compress the optical AI firewall!', 'system-back-up', '2024-03-15 21:05:53'),
+('The ASCII card is down, transmit the wireless matrix so we can synthesize the OCR microchip!', 'port-copy', '2024-03-17 10:57:17'),
+('If we bypass the matrix, we can get to the HTTP program through the primary PCI driver!', 'system-program', '2024-03-18 04:59:17'),
+('generating the protocol won''t do anything, we need to index the wireless COM feed!', 'interface-program', '2024-03-18 06:34:33'),
+('Use the online TCP hard drive, then you can transmit the primary pixel!', 'driver-parse', '2024-03-19 20:35:19'),
+('If we input the port, we can get to the SQL capacitor through the optical SSD monitor!', 'interface-index', '2024-03-19 21:29:38'),
+('Try to synthesize the TLS hard drive, maybe it will index the optical transmitter!', 'sensor-quantify', '2024-03-20 12:54:10'),
+('Use the mobile SSD capacitor, then you can quantify the redundant capacitor!', 'microchip-reboot', '2024-03-20 13:20:15'),
+('generating the hard drive won''t do anything, we need to connect the optical JSON alarm!', 'hard-drive-index', '2024-03-21 11:06:50'),
+('Use the multi-byte RAM circuit, then you can program the solid state array!', 'pixel-bypass', '2024-03-21 18:06:19'),
+('I''ll navigate the optical UTF8 card, that should panel the JSON hard drive!', 'pixel-connect', '2024-03-21 22:07:10'),
+('I''ll synthesize the bluetooth XSS application, that should driver the AGP panel!', 'pixel-synthesize', '2024-03-22 06:42:43'),
+('I''ll parse the haptic VGA driver, that should protocol the SDD feed!', 'matrix-calculate', '2024-03-22 06:51:52'),
+('If we parse the port, we can get to the SCSI card through the optical ADP interface!', 'feed-input', '2024-03-22 23:23:45'),
+('Try to index the EXE interface, maybe it will reboot the open-source sensor!', 'driver-index', '2024-03-23 09:47:18'),
+('Use the solid state RSS bus, then you can quantify the neural port!', 'port-compress', '2024-03-23 20:05:07'),
+('You can''t connect the sensor without programming the primary UTF8 protocol!', 'bandwidth-transmit', '2024-03-24 12:44:28'),
+('Try to calculate the HEX hard drive, maybe it will navigate the haptic application!', 'transmitter-compress', '2024-03-24 14:25:37'),
+('You can''t index the capacitor without generating the online FTP feed!', 'program-back-up', '2024-03-24 18:20:13'),
+('Try to copy the JBOD pixel, maybe it will index the primary feed!', 'panel-connect', '2024-03-25 01:56:46'),
+('Try to connect the UDP firewall, maybe it will quantify the bluetooth hard drive!', 'monitor-compress', '2024-03-25 08:49:13'),
+('We need to calculate the redundant HDD bus!', 'firewall-copy', '2024-03-27 04:41:27'),
+('The EXE microchip is down, transmit the online pixel so we can reboot the GB matrix!', 'panel-input', '2024-03-28 06:35:06'),
+('Use the virtual DRAM monitor, then you can calculate the redundant panel!', 'pixel-input', '2024-03-28 11:36:47'),
+('Use the wireless PNG port, then you can connect the primary panel!', 'system-input', '2024-03-29 00:59:21'),
+('I''ll reboot the auxiliary SCSI protocol, that should pixel the DNS monitor!', 'hard-drive-hack', '2024-03-29 04:24:13'),
+('generating the application won''t do anything, we need to input the optical RAM bandwidth!', 'matrix-program', '2024-03-29 09:41:05'),
+('We need to hack the haptic SCSI monitor!', 'alarm-reboot', '2024-03-29 11:38:32'),
+('quantifying the firewall won''t do anything, we need to bypass the 1080p UDP driver!', 'circuit-input', '2024-03-29 13:47:06'),
+('Use the wireless DNS circuit, then you can bypass the mobile application!', 'array-copy', '2024-03-29 19:34:18'),
+('overriding the panel won''t do anything, we need to index the multi-byte OCR protocol!', 'application-program', '2024-03-29 20:26:36'),
+('The AGP monitor is down, index the back-end bandwidth so we can navigate the SAS sensor!', 'bus-quantify', '2024-03-31 03:20:16'),
+('Use the primary HEX interface, then you can generate the solid state pixel!', 'sensor-navigate', '2024-03-31 18:34:25'),
+('We need to override the mobile API port!', 'circuit-reboot', '2024-03-31 21:59:40'),
+('Use the optical DRAM alarm, then you can bypass the cross-platform circuit!', 'port-hack', '2024-04-01 20:10:51'),
+('I''ll connect the bluetooth XSS firewall, that should transmitter the PNG panel!', 'array-program', '2024-04-02 20:28:46'),
+('You can''t calculate the port without compressing the optical XML matrix!', 'sensor-calculate', '2024-04-03 03:54:47'),
+('Use the wireless SMTP interface, then you can compress the optical transmitter!', 'array-compress', '2024-04-03 06:21:08'),
+('We need to parse the redundant OCR sensor!', 'matrix-override', '2024-04-03 13:10:42'),
+('I''ll compress the mobile COM sensor, that should transmitter the AGP pixel!', 'monitor-program', '2024-04-03 16:15:41'),
+('We need to compress the online UDP bus!', 'transmitter-override', '2024-04-04 06:01:25'),
+('transmitting the alarm won''t do anything, we need to quantify the multi-byte HTTP protocol!', 'sensor-hack', '2024-04-04 14:15:19'),
+('I''ll reboot the online TCP monitor, that should capacitor the ASCII hard drive!', 'pixel-index', '2024-04-05 10:55:38'),
+('Use the virtual TLS system, then you can hack the open-source port!', 'microchip-calculate', '2024-04-05 20:05:36'),
+('If we back up the microchip, we can get to the SMS hard drive through the virtual SMTP bandwidth!', 'monitor-hack', '2024-04-05 20:06:16'),
+('The IB system is down, hack the neural hard drive so we can transmit the UTF8 matrix!', 'pixel-copy', '2024-04-06 08:44:26'),
+('Use the wireless RAM transmitter, then you can index the neural panel!', 'card-navigate', '2024-04-06 16:56:11'),
+('I''ll transmit the 1080p JBOD protocol, that should interface the SCSI transmitter!', 'port-index', '2024-04-06 20:07:55'),
+('The SQL hard drive is down, index the open-source system so we can override the SMTP firewall!', 'circuit-back-up', '2024-04-06 20:52:21'),
+('Try to copy the DNS transmitter, maybe it will back up the haptic capacitor!', 'circuit-bypass', '2024-04-06 22:03:42'),
+('The SMTP alarm is down, reboot the primary bandwidth so we can parse the TCP panel!', 'bus-synthesize', '2024-04-07 08:44:07'),
+('You can''t input the card without parsing the back-end RSS circuit!', 'pixel-calculate', '2024-04-07 09:14:21'),
+('If we index the feed, we can get to the AGP application through the 1080p SDD pixel!', 'transmitter-synthesize', '2024-04-08 22:49:04'),
+('The API sensor is down, reboot the neural card so we can transmit the UTF8 driver!', 'capacitor-copy', '2024-04-09 05:41:23'),
+('programming the panel won''t do anything, we need to override the wireless API matrix!', 'capacitor-synthesize', '2024-04-09 19:02:51'),
+('I''ll synthesize the solid state TLS driver, that should program the IB program!', 'application-bypass', '2024-04-10 02:10:14'),
+('You can''t override the microchip without quantifying the auxiliary SDD matrix!', 'microchip-program', '2024-04-10 02:18:21'),
+('Try to bypass the DRAM panel, maybe it will connect the digital panel!', 'matrix-input', '2024-04-10 12:57:50'),
+('Try to reboot the ASCII panel, maybe it will back up the online alarm!', 'system-index', '2024-04-10 16:12:16'),
+('Try to connect the XSS panel, maybe it will navigate the mobile program!', 'alarm-generate', '2024-04-10 18:54:40'),
+('Use the multi-byte DRAM application, then you can override the auxiliary monitor!', 'matrix-calculate', '2024-04-11 17:06:58'),
+('The SMTP transmitter is down, program the open-source panel so we can navigate the SMTP panel!', 'transmitter-quantify', '2024-04-11 17:40:40'),
+('You can''t transmit the monitor without compressing the virtual SCSI transmitter!', 'protocol-connect', '2024-04-14 06:49:30'),
+('compressing the array won''t do anything, we need to override the open-source SDD program!', 'program-copy', '2024-04-14 09:04:48'),
+('parsing the firewall won''t do anything, we need to connect the 1080p AI hard drive!', 'bandwidth-compress', '2024-04-14 16:37:05'),
+('Use the virtual CSS pixel, then you can compress the open-source card!', 'panel-calculate', '2024-04-15 14:22:27'),
+('transmitting the transmitter won''t do anything, we need to quantify the haptic PNG microchip!', 'bandwidth-input', '2024-04-16 00:46:50'),
+('Try to synthesize the ASCII protocol, maybe it will parse the wireless capacitor!', 'driver-reboot', '2024-04-16 01:15:01'),
+('The CLI transmitter is down, override the back-end alarm so we can hack the VGA panel!', 'array-generate', '2024-04-16 01:28:13'),
+('We need to navigate the open-source SSD interface!', 'firewall-input', '2024-04-16 02:59:23'),
+('If we override the sensor, we can get to the TCP alarm through the online CSS panel!', 'driver-override', '2024-04-16 16:27:23'),
+('I''ll reboot the optical COM panel, that should port the XML panel!', 'panel-override', '2024-04-16 17:20:29'),
+('We need to input the optical SSL sensor!', 'system-override', '2024-04-16 17:21:04'),
+('Use the online SSD program, then you can connect the auxiliary array!', 'protocol-override', '2024-04-16 23:14:08'),
+('We need to transmit the wireless SSL microchip!', 'application-override', '2024-04-18 18:57:42'),
+('We need to transmit the neural GB system!', 'feed-parse', '2024-04-19 12:26:23'),
+('Use the online OCR program, then you can parse the solid state feed!', 'port-calculate', '2024-04-19 18:11:17'),
+('Use the redundant TCP pixel, then you can index the wireless protocol!', 'matrix-connect', '2024-04-20 00:28:46'),
+('The SCSI application is down, override the redundant feed so we can hack the TCP circuit!', 'transmitter-copy', '2024-04-20 11:04:53'),
+('The XML array is down, copy the multi-byte pixel so we can transmit the PNG alarm!', 'feed-reboot', '2024-04-21 15:34:08'),
+('Try to synthesize the AI bandwidth, maybe it will override the virtual interface!', 'bus-copy', '2024-04-21 20:03:40'),
+('I''ll bypass the bluetooth SSL driver, that should sensor the HDD transmitter!', 'firewall-parse', '2024-04-22 05:54:12'),
+('You can''t quantify the pixel without navigating the solid state COM application!', 'driver-bypass', '2024-04-22 09:07:09'),
+('synthesizing the array won''t do anything, we need to parse the open-source HDD system!', 'protocol-back-up', '2024-04-22 10:55:27'),
+('calculating the system won''t do anything, we need to parse the haptic CSS microchip!', 'application-bypass', '2024-04-22 18:43:35'),
+('hacking the microchip won''t do anything, we need to quantify the haptic RSS application!', 'pixel-back-up', '2024-04-23 01:37:14'),
+('Use the multi-byte RSS system, then you can back up the auxiliary panel!', 'system-navigate', '2024-04-23 03:55:22'),
+('I''ll calculate the 1080p XML microchip, that should transmitter the IP panel!', 'pixel-synthesize', '2024-04-24 19:23:42'),
+('The CSS interface is down, program the mobile hard drive so we can calculate the AGP hard drive!', 'card-connect', '2024-04-24 21:53:47'),
+('We need to quantify the digital PNG array!', 'feed-copy', '2024-04-25 17:42:53'),
+('If we generate the capacitor, we can get to the SCSI program through the multi-byte UDP program!', 'microchip-program', '2024-04-25 17:52:37'),
+('We need to back up the open-source SMS bandwidth!', 'matrix-reboot', '2024-04-26 12:30:08'),
+('We need to calculate the 1080p HEX pixel!', 'microchip-generate', '2024-04-26 14:57:06'),
+('If we hack the program, we can get to the IP bus through the auxiliary SDD port!', 'transmitter-input', '2024-04-27 05:57:51'),
+('Try to navigate the ADP firewall, maybe it will navigate the open-source circuit!', 'capacitor-navigate', '2024-04-27 07:36:42'),
+('I''ll parse the redundant AI transmitter, that should hard drive the RSS interface!', 'matrix-bypass', '2024-04-27 08:15:02'),
+('Try to override the HTTP sensor, maybe it will input the haptic array!', 'alarm-transmit', '2024-04-28 14:54:54'),
+('We need to calculate the neural UDP sensor!', 'feed-navigate', '2024-04-28 17:15:32'),
+('We need to index the bluetooth USB alarm!', 'panel-synthesize', '2024-04-28 22:37:57'),
+('We need to calculate the online COM application!', 'bus-reboot', '2024-04-29 08:07:54'),
+('parsing the bandwidth won''t do anything, we need to index the open-source THX protocol!', 'program-generate', '2024-04-29 22:54:26'),
+('We need to parse the open-source XML monitor!', 'driver-synthesize', '2024-04-29 23:12:22'),
+('The GB circuit is down, quantify the online feed so we can reboot the VGA application!', 'monitor-connect', '2024-04-30 14:14:22'),
+('You can''t calculate the driver without indexing the haptic PNG bandwidth!', 'driver-navigate', '2024-05-01 01:41:14'),
+('You can''t calculate the transmitter without overriding the solid state JBOD capacitor!', 'feed-compress', '2024-05-01 02:22:52'),
+('Use the 1080p ASCII port, then you can reboot the online microchip!', 'firewall-copy', '2024-05-01 03:17:23'),
+('The HDD port is down, compress the neural sensor so we can navigate the RAM alarm!', 'application-quantify', '2024-05-01 06:12:37'),
+('You can''t program the feed without synthesizing the mobile OCR protocol!', 'driver-input', '2024-05-01 12:46:42'),
+('indexing the firewall won''t do anything, we need to back up the online AGP matrix!', 'bandwidth-input', '2024-05-01 14:24:58'),
+('You can''t bypass the bandwidth without programming the optical SSL application!', 'hard-drive-synthesize', '2024-05-01 18:45:48'),
+('Try to program the IP program, maybe it will parse the neural matrix!', 'matrix-reboot', '2024-05-02 07:27:02'),
+('The DRAM panel is down, input the multi-byte array so we can index the USB program!', 'bandwidth-hack', '2024-05-02 15:30:02'),
+('If we reboot the array, we can get to the CSS circuit through the mobile DNS array!', 'panel-reboot', '2024-05-02 21:49:48'),
+('compressing the panel won''t do anything, we need to compress the virtual HEX alarm!', 'alarm-parse', '2024-05-03 10:11:48'),
+('If we parse the capacitor, we can get to the THX monitor through the haptic FTP protocol!', 'feed-override', '2024-05-04 08:25:40'),
+('We need to back up the multi-byte XSS feed!', 'capacitor-transmit', '2024-05-04 08:56:50'),
+('Use the wireless OCR sensor, then you can bypass the multi-byte panel!', 'port-connect', '2024-05-04 13:47:23'),
+('parsing the monitor won''t do anything, we need to transmit the bluetooth USB matrix!', 'panel-copy', '2024-05-05 05:01:36'),
+('If we program the program, we can get to the SMTP circuit through the digital PNG application!', 'feed-generate', '2024-05-06 05:17:26'),
+('If we calculate the monitor, we can get to the SSL driver through the bluetooth DRAM hard drive!', 'driver-hack', '2024-05-06 06:07:10'),
+('Use the wireless USB bus, then you can back up the haptic interface!', 'port-copy', '2024-05-06 06:46:51'),
+('programming the system won''t do anything, we need to compress the multi-byte JSON system!', 'matrix-transmit', '2024-05-06 07:17:05'),
+('bypassing the circuit won''t do anything, we need to compress the neural COM protocol!', 'application-calculate', '2024-05-06 07:58:15'),
+('The AGP panel is down, transmit the 1080p microchip so we can copy the SSD pixel!', 'program-connect', '2024-05-06 16:06:10'),
+('If we index the bus, we can get to the GB bus through the haptic TLS driver!', 'system-index', '2024-05-06 19:55:56'),
+('You can''t quantify the driver without quantifying the haptic XSS microchip!', 'application-reboot', '2024-05-08 04:44:21'),
+('Use the virtual PNG pixel, then you can synthesize the neural card!', 'capacitor-hack', '2024-05-08 05:41:58'),
+('The AI application is down, calculate the haptic transmitter so we can input the PNG system!', 'capacitor-bypass', '2024-05-08 06:25:30'),
+('The AGP driver is down, calculate the virtual microchip so we can quantify the ASCII system!', 'array-back-up', '2024-05-08 07:12:21'),
+('I''ll reboot the wireless CLI capacitor, that should application the GB matrix!', 'application-navigate', '2024-05-08 07:12:38'),
+('Try to quantify the DRAM application, maybe it will program the multi-byte transmitter!', 'bus-override', '2024-05-08 08:46:40'),
+('Try to reboot the AI sensor, maybe it will compress the redundant circuit!', 'interface-synthesize', '2024-05-08 11:42:07'),
+('I''ll parse the auxiliary TLS capacitor, that should capacitor the EXE hard drive!', 'panel-navigate', '2024-05-08 14:19:40'),
+('copying the transmitter won''t do anything, we need to generate the optical UTF8 feed!', 'circuit-transmit', '2024-05-08 23:20:22'),
+('If we index the port, we can get to the XML hard drive through the digital EXE system!', 'alarm-copy', '2024-05-09 03:06:59'),
+('Use the wireless SMS firewall, then you can synthesize the cross-platform hard drive!', 'hard-drive-quantify', '2024-05-09 11:21:13'),
+('generating the panel won''t do anything, we need to generate the neural ADP capacitor!', 'transmitter-reboot', '2024-05-09 17:21:49'),
+('If we calculate the program, we can get to the UDP firewall through the multi-byte PCI monitor!', 'program-compress', '2024-05-09 21:24:49'),
+('If we calculate the interface, we can get to the DNS driver through the back-end HTTP interface!', 'application-input', '2024-05-10 10:07:41'),
+('You can''t input the card without synthesizing the cross-platform SSL monitor!', 'transmitter-quantify', '2024-05-10 11:22:21'),
+('I''ll input the neural FTP program, that should array the XSS capacitor!', 'matrix-override', '2024-05-10 13:40:31'),
+('Use the optical JBOD port, then you can parse the optical transmitter!', 'bus-hack', '2024-05-10 16:27:38'),
+('You can''t reboot the circuit without backing up the virtual DNS card!', 'firewall-back-up', '2024-05-11 10:08:28'),
+('The ASCII program is down, input the cross-platform port so we can bypass the JSON alarm!', 'sensor-synthesize', '2024-05-11 10:41:20'),
+('If we hack the driver, we can get to the IP protocol through the digital AI matrix!', 'port-parse', '2024-05-11 17:30:12'),
+('You can''t transmit the bus without parsing the mobile JSON system!', 'monitor-input', '2024-05-12 03:34:05'),
+('If we bypass the microchip, we can get to the TCP sensor through the 1080p TLS interface!', 'card-override', '2024-05-12 04:32:46'),
+('We need to parse the digital DNS driver!', 'sensor-bypass', '2024-05-12 11:38:54'),
+('I''ll quantify the primary DNS program, that should application the ADP bandwidth!', 'application-parse', '2024-05-13 00:02:40'),
+('We need to synthesize the online SQL card!', 'bus-override', '2024-05-13 01:13:46'),
+('Use the open-source TCP pixel, then you can reboot the mobile microchip!', 'program-back-up', '2024-05-13 16:21:19'),
+('You can''t back up the hard drive without navigating the redundant TCP feed!', 'matrix-hack', '2024-05-14 14:19:52'),
+('indexing the hard drive won''t do anything, we need to quantify the mobile ADP matrix!', 'transmitter-quantify', '2024-05-14 15:21:31'),
+('You can''t index the application without transmitting the virtual IB alarm!', 'application-connect', '2024-05-14 17:08:58'),
+('If we generate the driver, we can get to the XSS system through the open-source SMTP application!', 'system-index', '2024-05-14 18:45:10'),
+('Try to hack the DNS array, maybe it will connect the multi-byte matrix!', 'system-hack', '2024-05-14 20:52:02'),
+('backing up the matrix won''t do anything, we need to quantify the haptic SSD monitor!', 'alarm-hack', '2024-05-15 01:37:56'),
+('The RAM application is down, copy the mobile sensor so we can override the VGA firewall!', 'port-bypass', '2024-05-15 05:25:56'),
+('I''ll program the primary TCP feed, that should firewall the HDD circuit!', 'circuit-transmit', '2024-05-15 19:06:16'),
+('I''ll parse the bluetooth AGP firewall, that should system the SQL driver!', 'program-compress', '2024-05-16 05:04:59'),
+('Try to input the PNG matrix, maybe it will parse the haptic array!', 'card-hack', '2024-05-16 09:12:36'),
+('The CLI program is down, quantify the neural port so we can connect the ASCII bandwidth!', 'system-reboot', '2024-05-19 15:43:05'),
+('Try to hack the EXE pixel, maybe it will reboot the wireless capacitor!', 'circuit-compress', '2024-05-20 02:12:32'),
+('We need to calculate the multi-byte ASCII driver!', 'system-generate', '2024-05-20 07:12:03'),
+('calculating the matrix won''t do anything, we need to copy the wireless DNS matrix!', 'interface-back-up', '2024-05-20 13:13:50'),
+('The DNS panel is down, back up the cross-platform port so we can override the ASCII array!', 'sensor-reboot', '2024-05-20 15:22:46'),
+('Try to parse the VGA matrix, maybe it will synthesize the redundant program!', 'pixel-parse', '2024-05-20 15:44:35'),
+('We need to connect the digital OCR capacitor!', 'application-back-up', '2024-05-20 17:07:27'),
+('The USB interface is down, transmit the 1080p hard drive so we can hack the CSS pixel!', 'microchip-transmit', '2024-05-21 17:01:55'),
+('Try to hack the OCR matrix, maybe it will parse the solid state feed!', 'feed-override', '2024-05-23 00:34:29'),
+('transmitting the monitor won''t do anything, we need to override the open-source TCP matrix!', 'array-override', '2024-05-23 06:44:18'),
+('Try to override the CLI application, maybe it will calculate the primary array!', 'protocol-override', '2024-05-23 08:52:31'),
+('Use the open-source SAS hard drive, then you can reboot the solid state protocol!', 'circuit-navigate', '2024-05-23 21:06:46'),
+('The AGP pixel is down, quantify the online driver so we can transmit the DNS microchip!', 'pixel-copy', '2024-05-24 00:08:45'),
+('You can''t quantify the alarm without backing up the primary DNS system!', 'driver-generate', '2024-05-24 06:59:11'),
+('calculating the feed won''t do anything, we need to input the neural GB monitor!', 'circuit-hack', '2024-05-24 13:22:02'),
+('The SMTP circuit is down, quantify the neural monitor so we can copy the API program!', 'alarm-program', '2024-05-24 21:25:52'),
+('The CLI circuit is down, generate the solid state application so we can quantify the SDD transmitter!', 'system-input', '2024-05-25 03:19:24'),
+('The SSD protocol is down, program the redundant application so we can generate the DNS card!', 'array-input', '2024-05-25 15:07:45'),
+('I''ll copy the solid state HDD firewall, that should hard drive the UDP feed!', 'protocol-calculate', '2024-05-25 15:09:14'),
+('Use the mobile THX hard drive, then you can connect the haptic driver!', 'microchip-parse', '2024-05-26 10:13:01'),
+('hacking the transmitter won''t do anything, we need to copy the virtual IP bus!', 'driver-copy', '2024-05-26 22:14:58'),
+('Use the online USB capacitor, then you can transmit the redundant monitor!', 'panel-input', '2024-05-27 03:12:19'),
+('If we reboot the monitor, we can get to the IB application through the multi-byte USB protocol!', 'bandwidth-calculate', '2024-05-28 02:21:28'),
+('The CLI card is down, connect the cross-platform system so we can compress the SQL interface!', 'system-connect', '2024-05-28 17:25:30'),
+('The RSS interface is down, reboot the virtual driver so we can hack the AGP card!', 'monitor-connect', '2024-05-28 20:50:19'),
+('Use the auxiliary DNS driver, then you can override the primary bandwidth!', 'matrix-connect', '2024-05-29 04:34:26'),
+('You can''t reboot the circuit without overriding the haptic PCI matrix!', 'card-override', '2024-05-29 05:44:29'),
+('The HDD bus is down, bypass the wireless application so we can parse the CSS circuit!', 'firewall-override', '2024-05-29 09:30:17'),
+('Try to copy the HTTP panel, maybe it will synthesize the back-end alarm!', 'matrix-override', '2024-05-30 23:33:30'),
+('Try to calculate the GB transmitter, maybe it will override the 1080p driver!', 'matrix-hack', '2024-06-01 04:34:55'),
+('If we parse the protocol, we can get to the OCR bandwidth through the primary XSS microchip!', 'system-input', '2024-06-01 04:41:08'),
+('The IP circuit is down, generate the auxiliary port so we can index the UDP interface!', 'matrix-navigate', '2024-06-02 13:31:07'),
+('Use the open-source API panel, then you can copy the primary port!', 'panel-hack', '2024-06-02 23:08:08'),
+('If we override the microchip, we can get to the SQL bandwidth through the solid state SQL monitor!', 'sensor-hack', '2024-06-03 02:37:03'),
+('Try to index the AI panel, maybe it will navigate the haptic protocol!', 'capacitor-synthesize', '2024-06-03 14:15:58'),
+('Try to input the RAM interface, maybe it will calculate the 1080p transmitter!', 'application-navigate', '2024-06-03 18:06:00'),
+('I''ll override the multi-byte UDP system, that should driver the TLS interface!', 'panel-bypass', '2024-06-03 21:32:55'),
+('The RSS transmitter is down, navigate the mobile transmitter so we can generate the UTF8 hard drive!', 'monitor-bypass', '2024-06-03 22:16:06'),
+('If we calculate the capacitor, we can get to the DNS microchip through the digital XSS transmitter!', 'system-copy', '2024-06-04 05:56:13'),
+('Use the multi-byte JBOD panel, then you can reboot the bluetooth capacitor!', 'pixel-input', '2024-06-05 00:32:41'),
+('connecting the sensor won''t do anything, we need to synthesize the bluetooth TLS bus!', 'bus-connect', '2024-06-06 03:36:11'),
+('Use the neural HTTP sensor, then you can transmit the redundant panel!', 'hard-drive-generate', '2024-06-06 05:18:38'),
+('Use the solid state PNG protocol, then you can override the 1080p sensor!', 'sensor-synthesize', '2024-06-06 06:16:53'),
+('Use the digital TLS monitor, then you can index the virtual circuit!', 'hard-drive-copy', '2024-06-06 17:44:07'),
+('Use the optical HEX port, then you can navigate the back-end card!', 'monitor-connect', '2024-06-06 20:40:05'),
+('I''ll synthesize the digital ASCII microchip, that should program the RAM protocol!', 'matrix-synthesize', '2024-06-08 15:07:59'),
+('Use the bluetooth UTF8 feed, then you can parse the bluetooth capacitor!', 'interface-quantify', '2024-06-09 02:56:48'),
+('We need to compress the optical SSL bandwidth!', 'microchip-index', '2024-06-09 15:54:58'),
+('You can''t quantify the capacitor without quantifying the online SSD interface!', 'capacitor-parse', '2024-06-10 07:43:59'),
+('You can''t parse the alarm without hacking the primary CSS alarm!', 'bus-input', '2024-06-10 16:38:46'),
+('Use the solid state GB interface, then you can input the digital monitor!', 'bandwidth-copy', '2024-06-10 23:59:41'),
+('If we input the array, we can get to the IB hard drive through the neural CSS pixel!', 'microchip-connect', '2024-06-11 10:53:41'),
+('quantifying the system won''t do anything, we need to connect the cross-platform SMS driver!', 'system-copy', '2024-06-12 04:15:54'),
+('I''ll compress the mobile SCSI alarm, that should panel the SQL application!', 'circuit-input', '2024-06-12 14:32:03'),
+('The CLI driver is down, compress the solid state feed so we can calculate the JBOD array!', 'bandwidth-back-up', '2024-06-12 20:45:46'),
+('Use the cross-platform USB circuit, then you can connect the multi-byte transmitter!', 'bandwidth-index', '2024-06-13 20:30:58'),
+('I''ll synthesize the haptic SMTP hard drive, that should pixel the ADP protocol!', 'program-navigate', '2024-06-13 21:08:03'),
+('The USB card is down, compress the haptic hard drive so we can reboot the VGA firewall!', 'protocol-parse', '2024-06-14 13:17:11'),
+('You can''t back up the bandwidth without programming the bluetooth JBOD feed!', 'sensor-bypass', '2024-06-14 20:19:52'),
+('The PNG hard drive is down, back up the solid state microchip so we can hack the TCP sensor!', 'array-back-up', '2024-06-14 20:22:51'),
+('The UDP circuit is down, synthesize the virtual bandwidth so we can back up the HDD bandwidth!', 'system-generate', '2024-06-15 05:32:23'),
+('We need to transmit the redundant SCSI matrix!', 'array-synthesize', '2024-06-17 10:20:36'),
+('hacking the system won''t do anything, we need to parse the solid state XSS hard drive!', 'sensor-index', '2024-06-18 03:48:40'),
+('I''ll copy the auxiliary GB array, that should system the PNG panel!', 'feed-calculate', '2024-06-18 08:03:36'),
+('Use the online JSON application, then you can navigate the solid state bandwidth!', 'panel-generate', '2024-06-19 04:22:36'),
+('If we back up the bus, we can get to the SMTP sensor through the haptic AI monitor!', 'transmitter-index', '2024-06-19 17:52:31'),
+('The PNG bandwidth is down, compress the cross-platform sensor so we can program the SSL interface!', 'capacitor-override', '2024-06-20 10:22:57'),
+('I''ll navigate the multi-byte HTTP matrix, that should driver the IB microchip!', 'panel-bypass', '2024-06-21 07:43:01'),
+('If we input the hard drive, we can get to the API bus through the back-end UDP driver!', 'transmitter-index', '2024-06-21 12:32:20'),
+('Try to bypass the HTTP pixel, maybe it will index the digital feed!', 'matrix-compress', '2024-06-21 19:26:29'),
+('navigating the driver won''t do anything, we need to index the virtual UDP protocol!', 'matrix-bypass', '2024-06-22 00:16:21'),
+('Use the haptic FTP protocol, then you can bypass the solid state matrix!', 'monitor-transmit', '2024-06-22 15:29:10'),
+('Try to generate the AGP interface, maybe it will copy the haptic port!', 'transmitter-parse', '2024-06-25 15:10:50'),
+('The UDP driver is down, program the optical sensor so we can calculate the EXE alarm!', 'interface-hack', '2024-06-26 04:18:36'),
+('indexing the bandwidth won''t do anything, we need to navigate the neural SDD protocol!', 'firewall-calculate', '2024-06-26 05:59:55'),
+('hacking the application won''t do anything, we need to synthesize the virtual ASCII capacitor!', 'transmitter-calculate', '2024-06-26 14:09:15'),
+('The TCP circuit is down, connect the neural program so we can compress the SSD card!', 'capacitor-hack', '2024-06-26 20:20:52'),
+('Use the cross-platform GB array, then you can hack the redundant monitor!', 'hard-drive-index', '2024-06-26 23:28:08'),
+('Use the haptic SMS system, then you can navigate the back-end panel!', 'application-navigate', '2024-06-26 23:40:17'),
+('We need to back up the back-end SMS sensor!', 'application-input', '2024-06-27 13:07:28'),
+('Try to parse the SMS system, maybe it will connect the optical alarm!', 'monitor-compress', '2024-06-27 13:38:19'),
+('Use the bluetooth JBOD array, then you can calculate the digital port!', 'firewall-program', '2024-06-27 21:26:29'),
+('If we transmit the circuit, we can get to the PCI feed through the neural DRAM protocol!', 'card-index', '2024-06-28 13:59:21'),
+('If we program the array, we can get to the UTF8 monitor through the online PCI circuit!', 'firewall-parse', '2024-06-29 04:20:47'),
+('I''ll bypass the optical OCR application, that should capacitor the TLS protocol!', 'system-back-up', '2024-06-29 19:16:09'),
+('Use the bluetooth EXE sensor, then you can reboot the digital bandwidth!', 'transmitter-program', '2024-06-29 20:55:56'),
+('Use the digital SCSI hard drive, then you can hack the online hard drive!', 'hard-drive-override', '2024-06-30 18:00:01'),
+('We need to quantify the primary SAS program!', 'feed-parse', '2024-07-01 06:05:20'),
+('The JBOD array is down, connect the wireless application so we can connect the THX program!', 'firewall-compress', '2024-07-02 18:52:22'),
+('hacking the feed won''t do anything, we need to synthesize the redundant AGP program!', 'hard-drive-input', '2024-07-03 20:18:08'),
+('Use the haptic XML alarm, then you can reboot the optical firewall!', 'array-generate', '2024-07-03 23:30:41'),
+('synthesizing the application won''t do anything, we need to hack the virtual HTTP sensor!', 'pixel-program', '2024-07-04 08:37:35'),
+('The SSD system is down, transmit the 1080p panel so we can calculate the UDP matrix!', 'microchip-override', '2024-07-05 16:30:00'),
+('You can''t synthesize the pixel without overriding the open-source SAS capacitor!', 'microchip-bypass', '2024-07-05 22:15:35'),
+('If we synthesize the interface, we can get to the CSS program through the back-end AGP protocol!', 'panel-navigate', '2024-07-06 21:01:55'),
+('We need to override the primary JBOD sensor!', 'protocol-synthesize', '2024-07-07 03:04:06'),
+('We need to compress the back-end IB firewall!', 'microchip-connect', '2024-07-07 03:25:05'),
+('programming the matrix won''t do anything, we need to navigate the bluetooth PNG protocol!', 'array-input', '2024-07-07 05:57:56'),
+('We need to index the mobile SSD program!', 'array-back-up', '2024-07-07 20:25:34'),
+('Use the multi-byte IP card, then you can generate the bluetooth protocol!', 'program-override', '2024-07-07 23:18:24'),
+('I''ll program the redundant SCSI system, that should capacitor the TLS sensor!', 'alarm-generate', '2024-07-08 01:50:52'),
+('I''ll copy the back-end SQL circuit, that should feed the SCSI feed!', 'card-synthesize', '2024-07-08 16:19:25'),
+('We need to calculate the auxiliary SAS microchip!', 'monitor-program', '2024-07-09 06:10:19'),
+('indexing the transmitter won''t do anything, we need to bypass the back-end API protocol!', 'capacitor-calculate', '2024-07-09 11:47:39'),
+('Try to generate the AGP sensor, maybe it will index the wireless program!', 'firewall-navigate', '2024-07-11 19:59:00'),
+('Use the haptic AI matrix, then you can index the virtual bandwidth!', 'sensor-parse', '2024-07-12 17:34:08'),
+('The OCR firewall is down, quantify the digital protocol so we can generate the SMS transmitter!', 'panel-transmit', '2024-07-12 22:32:08'),
+('synthesizing the driver won''t do anything, we need to compress the mobile CLI card!', 'port-compress', '2024-07-13 02:20:39'),
+('If we copy the microchip, we can get to the XSS application through the multi-byte RSS program!', 'bandwidth-reboot', '2024-07-13 07:43:01'),
+('indexing the matrix won''t do anything, we need to index the auxiliary IB matrix!', 'port-index', '2024-07-13 15:08:14'),
+('The COM bandwidth is down, quantify the bluetooth bandwidth so we can reboot the USB sensor!', 'program-quantify', '2024-07-13 16:00:00'),
+('You can''t override the alarm without generating the redundant GB program!', 'application-hack', '2024-07-13 19:07:13'),
+('I''ll connect the solid state SSL feed, that should driver the SAS bus!', 'monitor-calculate', '2024-07-13 22:42:18'),
+('You can''t calculate the card without connecting the redundant ADP panel!', 'hard-drive-index', '2024-07-15 05:31:32'),
+('The API protocol is down, navigate the primary system so we can copy the DNS circuit!', 'matrix-quantify', '2024-07-15 08:11:50'),
+('We need to bypass the haptic PCI firewall!', 'interface-transmit', '2024-07-15 08:56:09'),
+('I''ll copy the online TLS driver, that should alarm the SSL monitor!', 'driver-input', '2024-07-15 18:24:03'),
+('We need to input the multi-byte USB transmitter!', 'capacitor-parse', '2024-07-15 19:08:53'),
+('I''ll quantify the auxiliary RAM monitor, that should matrix the SMS sensor!', 'firewall-quantify', '2024-07-16 03:31:29'),
+('If we synthesize the matrix, we can get to the TCP capacitor through the online API pixel!', 'system-input', '2024-07-16 05:45:45'),
+('We need to copy the mobile XML matrix!', 'feed-index', '2024-07-16 06:14:54'),
+('You can''t back up the transmitter without navigating the optical THX alarm!', 'monitor-input', '2024-07-16 10:50:22'),
+('You can''t generate the application without hacking the open-source SAS matrix!', 'capacitor-hack', '2024-07-16 12:32:42'),
+('Use the online EXE protocol, then you can parse the multi-byte bus!', 'card-program', '2024-07-16 14:15:08'),
+('connecting the capacitor won''t do anything, we need to compress the virtual PCI transmitter!', 'monitor-program', '2024-07-16 18:25:35'),
+('We need to connect the solid state SAS firewall!', 'driver-connect', '2024-07-16 22:29:18'),
+('The SAS panel is down, parse the neural hard drive so we can transmit the JBOD circuit!', 'array-transmit', '2024-07-17 00:18:59'),
+('programming the program won''t do anything, we need to calculate the haptic HTTP pixel!', 'bandwidth-copy', '2024-07-17 01:08:24'),
+('Try to index the SMTP capacitor, maybe it will quantify the multi-byte card!', 'card-compress', '2024-07-17 06:06:24'),
+('If we program the monitor, we can get to the RAM alarm through the auxiliary API microchip!', 'transmitter-parse', '2024-07-17 06:09:00'),
+('I''ll synthesize the wireless JBOD sensor, that should matrix the GB firewall!', 'transmitter-hack', '2024-07-17 07:34:16'),
+('Try to parse the OCR feed, maybe it will quantify the 1080p transmitter!', 'sensor-calculate', '2024-07-17 15:18:39'),
+('The CSS protocol is down, copy the optical circuit so we can compress the OCR protocol!', 'system-parse', '2024-07-17 16:47:50'),
+('overriding the capacitor won''t do anything, we need to synthesize the 1080p PCI protocol!', 'firewall-hack', '2024-07-18 11:26:49'),
+('navigating the pixel won''t do anything, we need to program the neural XSS matrix!', 'firewall-transmit', '2024-07-18 11:28:14'),
+('Use the optical ASCII feed, then you can hack the digital pixel!', 'bus-calculate', '2024-07-18 20:09:54'),
+('We need to parse the digital PCI program!', 'application-transmit', '2024-07-19 15:12:56'),
+('Try to navigate the IP capacitor, maybe it will generate the redundant card!', 'application-input', '2024-07-20 06:30:37'),
+('The PCI sensor is down, transmit the auxiliary capacitor so we can navigate the ADP matrix!', 'driver-connect', '2024-07-21 08:05:19'),
+('If we transmit the feed, we can get to the FTP array through the open-source FTP bus!', 'feed-calculate', '2024-07-21 09:29:16'),
+('Try to compress the XSS capacitor, maybe it will back up the open-source panel!', 'array-compress', '2024-07-21 17:35:23'),
+('We need to parse the open-source THX pixel!', 'interface-parse', '2024-07-21 20:29:31'),
+('We need to bypass the virtual HEX alarm!', 'interface-index', '2024-07-22 14:47:33'),
+('The SQL capacitor is down, input the digital array so we can synthesize the FTP feed!', 'bandwidth-back-up', '2024-07-22 18:06:05'),
+('calculating the alarm won''t do anything, we need to copy the auxiliary JBOD hard drive!', 'matrix-quantify', '2024-07-22 19:23:25'),
+('I''ll synthesize the open-source SQL application, that should capacitor the CSS port!', 'interface-calculate', '2024-07-22 19:36:34'),
+('Try to program the TCP sensor, maybe it will bypass the back-end matrix!', 'protocol-back-up', '2024-07-22 20:11:09'),
+('If we parse the protocol, we can get to the AGP application through the online SSD capacitor!', 'firewall-compress', '2024-07-23 05:42:02'),
+('I''ll synthesize the neural VGA system, that should interface the SSD hard drive!', 'bandwidth-generate', '2024-07-24 00:53:14'),
+('Try to transmit the CSS program, maybe it will copy the virtual capacitor!', 'capacitor-calculate', '2024-07-24 02:52:57'),
+('Use the primary IP capacitor, then you can synthesize the online array!', 'interface-index', '2024-07-24 08:05:32'),
+('I''ll synthesize the open-source VGA capacitor, that should capacitor the EXE driver!', 'transmitter-input', '2024-07-24 19:37:01'),
+('Use the mobile XML card, then you can navigate the back-end hard drive!', 'circuit-synthesize', '2024-07-25 02:35:22'),
+('The DNS circuit is down, program the bluetooth array so we can quantify the TCP system!', 'circuit-generate', '2024-07-25 03:16:30'),
+('You can''t connect the alarm without quantifying the online ASCII monitor!', 'bandwidth-calculate', '2024-07-25 04:36:19'),
+('You can''t back up the port without synthesizing the back-end DNS circuit!', 'transmitter-parse', '2024-07-25 15:30:42'),
+('synthesizing the bandwidth won''t do anything, we need to parse the wireless HTTP system!', 'capacitor-bypass', '2024-07-25 21:46:06'),
+('generating the application won''t do anything, we need to calculate the wireless PNG system!', 'system-compress', '2024-07-26 13:30:55'),
+('If we synthesize the microchip, we can get to the XML firewall through the 1080p FTP circuit!', 'protocol-program', '2024-07-26 22:58:52'),
+('You can''t navigate the bandwidth without parsing the primary EXE program!', 'bandwidth-connect', '2024-07-27 00:08:36'),
+('calculating the capacitor won''t do anything, we need to hack the haptic RAM transmitter!', 'system-synthesize', '2024-07-27 03:02:41'),
+('Try to compress the VGA port, maybe it will program the haptic interface!', 'hard-drive-parse', '2024-07-27 23:23:51'),
+('You can''t index the capacitor without quantifying the haptic SMS alarm!', 'matrix-parse', '2024-07-28 04:02:56'),
+('We need to program the online VGA pixel!', 'pixel-program', '2024-07-28 05:32:06'),
+('The CSS feed is down, program the auxiliary sensor so we can copy the JBOD program!', 'bandwidth-transmit', '2024-07-28 22:44:28'),
+('The API card is down, input the multi-byte alarm so we can program the PCI application!', 'alarm-parse', '2024-07-29 06:26:04'),
+('I''ll hack the multi-byte ASCII application, that should hard drive the PNG microchip!', 'bus-navigate', '2024-07-29 12:48:43'),
+('You can''t hack the program without connecting the multi-byte AGP transmitter!', 'protocol-program', '2024-07-30 10:49:27'),
+('We need to hack the virtual SSL protocol!', 'monitor-hack', '2024-07-30 15:37:48'),
+('You can''t quantify the protocol without hacking the open-source SSL firewall!', 'panel-quantify', '2024-07-30 22:53:07'),
+('I''ll generate the auxiliary DNS system, that should alarm the RAM hard drive!', 'alarm-index', '2024-07-31 06:25:55'),
+('Use the bluetooth OCR driver, then you can bypass the primary transmitter!', 'firewall-copy', '2024-07-31 09:54:55'),
+('You can''t reboot the matrix without backing up the 1080p GB bandwidth!', 'sensor-parse', '2024-07-31 21:48:45'),
+('Use the haptic UDP hard drive, then you can calculate the open-source sensor!', 'bandwidth-index', '2024-08-01 01:16:28'),
+('The VGA microchip is down, program the online system so we can copy the SCSI firewall!', 'driver-hack', '2024-08-01 03:16:07'),
+('If we navigate the bandwidth, we can get to the FTP protocol through the haptic AI port!', 'hard-drive-compress', '2024-08-01 07:38:53'),
+('Use the 1080p COM transmitter, then you can transmit the redundant transmitter!', 'driver-navigate', '2024-08-01 20:27:41'),
+('The ADP panel is down, compress the auxiliary monitor so we can navigate the API capacitor!', 'sensor-input', '2024-08-01 21:31:04'),
+('navigating the pixel won''t do anything, we need to hack the solid state SMTP sensor!', 'sensor-reboot', '2024-08-02 02:08:59'),
+('If we reboot the interface, we can get to the XSS hard drive through the optical COM feed!', 'pixel-parse', '2024-08-02 09:45:11'),
+('We need to calculate the auxiliary IB firewall!', 'system-bypass', '2024-08-03 01:44:18'),
+('I''ll bypass the optical RSS firewall, that should driver the SSD monitor!', 'circuit-bypass', '2024-08-03 16:58:04'),
+('We need to parse the redundant API interface!', 'sensor-program', '2024-08-04 06:04:27'),
+('I''ll index the bluetooth SMS port, that should matrix the JBOD array!', 'protocol-override', '2024-08-04 08:28:27'),
+('We need to bypass the haptic SDD firewall!', 'array-hack', '2024-08-06 09:34:50'),
+('We need to copy the back-end API program!', 'sensor-generate', '2024-08-06 16:27:11'),
+('Use the neural VGA matrix, then you can copy the bluetooth pixel!', 'array-back-up', '2024-08-06 18:07:24'),
+('You can''t transmit the protocol without copying the multi-byte SQL protocol!', 'port-quantify', '2024-08-07 04:24:55'),
+('I''ll synthesize the solid state COM system, that should alarm the EXE pixel!', 'pixel-quantify', '2024-08-07 04:47:25'),
+('We need to navigate the digital HEX bus!', 'alarm-transmit', '2024-08-07 20:35:52'),
+('If we connect the monitor, we can get to the USB firewall through the cross-platform USB system!', 'hard-drive-hack', '2024-08-08 18:49:34'),
+('You can''t transmit the microchip without generating the primary HTTP protocol!', 'alarm-compress', '2024-08-08 21:43:06'),
+('If we connect the bandwidth, we can get to the SSD application through the open-source OCR matrix!', 'monitor-transmit', '2024-08-08 21:52:31'),
+('You can''t back up the transmitter without overriding the digital RAM capacitor!', 'hard-drive-copy', '2024-08-09 13:11:19'),
+('I''ll parse the solid state COM hard drive, that should firewall the HTTP interface!', 'matrix-calculate', '2024-08-09 22:42:12'),
+('I''ll back up the multi-byte PCI bus, that should capacitor the UDP matrix!', 'application-navigate', '2024-08-09 22:44:21'),
+('Try to transmit the HDD application, maybe it will input the bluetooth circuit!', 'capacitor-back-up', '2024-08-10 00:56:11'),
+('We need to navigate the primary ADP program!', 'panel-index', '2024-08-10 05:17:26'),
+('Use the redundant UTF8 bandwidth, then you can compress the digital program!', 'capacitor-program', '2024-08-11 00:08:40'),
+('The CLI port is down, quantify the redundant hard drive so we can hack the HTTP panel!', 'matrix-navigate', '2024-08-11 17:29:44'),
+('Use the cross-platform XSS alarm, then you can calculate the wireless bandwidth!', 'port-generate', '2024-08-11 20:59:23'),
+('Try to compress the THX pixel, maybe it will generate the auxiliary firewall!', 'alarm-compress', '2024-08-12 06:39:00'),
+('If we hack the matrix, we can get to the FTP capacitor through the solid state FTP bandwidth!', 'driver-parse', '2024-08-13 02:03:37'),
+('bypassing the card won''t do anything, we need to program the optical RAM alarm!', 'capacitor-override', '2024-08-13 02:48:11'),
+('I''ll calculate the solid state SMTP matrix, that should driver the HTTP card!', 'driver-transmit', '2024-08-13 09:40:36'),
+('The HEX sensor is down, synthesize the virtual card so we can override the UDP microchip!', 'feed-copy', '2024-08-13 16:05:32'),
+('We need to reboot the mobile SQL hard drive!', 'array-quantify', '2024-08-13 17:56:42'),
+('The COM panel is down, navigate the cross-platform interface so we can navigate the TLS bandwidth!', 'circuit-index', '2024-08-13 21:56:46'),
+('I''ll parse the cross-platform IP capacitor, that should firewall the XML matrix!', 'hard-drive-navigate', '2024-08-14 02:58:21'),
+('Use the bluetooth IP program, then you can connect the neural bandwidth!', 'protocol-connect', '2024-08-14 04:04:10'),
+('You can''t override the protocol without hacking the digital RAM capacitor!', 'monitor-compress', '2024-08-14 17:55:16'),
+('You can''t calculate the panel without parsing the neural AI capacitor!', 'transmitter-parse', '2024-08-14 18:20:43'),
+('I''ll parse the multi-byte XSS card, that should protocol the CSS driver!', 'system-back-up', '2024-08-15 05:29:59'),
+('If we transmit the application, we can get to the CSS protocol through the open-source SMTP driver!', 'array-connect', '2024-08-15 10:11:30'),
+('You can''t hack the system without synthesizing the primary USB application!', 'sensor-quantify', '2024-08-15 13:43:27'),
+('Try to reboot the SSL program, maybe it will connect the redundant port!', 'bandwidth-index', '2024-08-15 23:18:41'),
+('Try to quantify the CSS circuit, maybe it will index the wireless firewall!', 'monitor-generate', '2024-08-16 03:49:42'),
+('If we connect the card, we can get to the UTF8 feed through the redundant TCP bandwidth!', 'monitor-reboot', '2024-08-16 07:21:35'),
+('The EXE sensor is down, hack the digital driver so we can input the FTP microchip!', 'application-generate', '2024-08-16 23:51:42'),
+('We need to quantify the cross-platform GB system!', 'pixel-bypass', '2024-08-17 03:23:37'),
+('Use the open-source SSL firewall, then you can navigate the redundant transmitter!', 'array-back-up', '2024-08-17 17:02:08'),
+('We need to generate the neural ADP bandwidth!', 'capacitor-copy', '2024-08-18 02:30:57'),
+('Use the optical XSS port, then you can bypass the open-source transmitter!', 'card-override', '2024-08-18 08:53:32'),
+('Use the neural USB hard drive, then you can program the virtual system!', 'matrix-generate', '2024-08-18 18:31:58'),
+('The USB port is down, input the haptic microchip so we can bypass the IP pixel!', 'bandwidth-transmit', '2024-08-18 23:55:18'),
+('I''ll connect the multi-byte XML protocol, that should program the RAM capacitor!', 'array-generate', '2024-08-19 07:21:34'),
+('We need to input the auxiliary HTTP interface!', 'matrix-parse', '2024-08-19 11:58:25'),
+('We need to calculate the optical API pixel!', 'firewall-parse', '2024-08-19 14:59:22'),
+('The HEX protocol is down, hack the optical sensor so we can generate the HDD pixel!', 'monitor-transmit', '2024-08-19 15:14:02'),
+('synthesizing the matrix won''t do anything, we need to compress the solid state SQL program!', 'pixel-transmit', '2024-08-19 18:13:32'),
+('We need to generate the primary HDD circuit!', 'application-connect', '2024-08-20 11:42:59'),
+('We need to index the open-source DRAM capacitor!', 'hard-drive-synthesize', '2024-08-20 13:34:30'),
+('You can''t bypass the port without connecting the redundant FTP feed!', 'system-hack', '2024-08-21 09:18:46'),
+('If we override the sensor, we can get to the CSS transmitter through the wireless JSON transmitter!', 'driver-quantify', '2024-08-21 11:08:07'),
+('The AI pixel is down, calculate the open-source transmitter so we can compress the IB capacitor!', 'interface-reboot', '2024-08-21 15:27:14'),
+('synthesizing the capacitor won''t do anything, we need to connect the online UDP program!', 'panel-calculate', '2024-08-22 23:05:37'),
+('If we override the bandwidth, we can get to the HTTP panel through the wireless USB system!', 'alarm-parse', '2024-08-23 01:18:37'),
+('compressing the matrix won''t do anything, we need to bypass the online SSL monitor!', 'monitor-hack', '2024-08-23 08:00:01'),
+('indexing the alarm won''t do anything, we need to synthesize the multi-byte PCI sensor!', 'pixel-override', '2024-08-24 02:05:10'),
+('I''ll back up the mobile FTP matrix, that should transmitter the CLI protocol!', 'bandwidth-index', '2024-08-24 16:16:03'),
+('The TCP port is down, navigate the digital array so we can synthesize the HTTP bandwidth!', 'port-navigate', '2024-08-25 04:29:23'),
+('The JBOD capacitor is down, override the auxiliary application so we can input the ADP card!', 'capacitor-quantify', '2024-08-25 15:17:25'),
+('If we bypass the circuit, we can get to the SCSI port through the auxiliary RSS alarm!', 'alarm-synthesize', '2024-08-25 23:11:43'),
+('The RAM microchip is down, copy the open-source application so we can quantify the JBOD transmitter!', 'driver-hack', '2024-08-25 23:48:26'),
+('We need to quantify the redundant COM circuit!', 'array-transmit', '2024-08-25 23:52:13'),
+('You can''t index the program without quantifying the bluetooth AGP feed!', 'port-connect', '2024-08-26 10:13:48'),
+('Use the optical HDD pixel, then you can transmit the bluetooth pixel!', 'bus-synthesize', '2024-08-26 13:38:22'),
+('We need to connect the multi-byte AGP firewall!', 'bus-synthesize', '2024-08-27 04:13:17'),
+('You can''t index the monitor without bypassing the virtual HDD application!', 'hard-drive-hack', '2024-08-27 15:01:47'),
+('Use the cross-platform TLS alarm, then you can hack the solid state program!', 'bandwidth-index', '2024-08-27 15:35:48'),
+('Try to copy the SSD feed, maybe it will hack the neural card!', 'microchip-back-up', '2024-08-27 15:40:22'),
+('Use the redundant OCR matrix, then you can generate the haptic protocol!', 'pixel-program', '2024-08-27 20:56:28'),
+('generating the system won''t do anything, we need to index the primary SMS interface!', 'port-connect', '2024-08-28 00:57:57'),
+('Try to reboot the PCI system, maybe it will navigate the haptic driver!', 'protocol-program', '2024-08-28 02:13:31'),
+('copying the port won''t do anything, we need to synthesize the primary XSS port!', 'pixel-program', '2024-08-28 04:57:26'),
+('You can''t program the panel without hacking the 1080p SQL panel!', 'interface-synthesize', '2024-08-28 10:51:24'),
+('Try to compress the ADP bandwidth, maybe it will synthesize the auxiliary hard drive!', 'driver-connect', '2024-08-28 21:49:31'),
+('Try to quantify the THX array, maybe it will copy the solid state microchip!', 'bus-back-up', '2024-08-29 06:40:54'),
+('Use the online HTTP monitor, then you can bypass the digital bandwidth!', 'port-override', '2024-08-29 16:27:50'),
+('If we synthesize the monitor, we can get to the CSS capacitor through the back-end JSON microchip!', 'sensor-bypass', '2024-08-29 21:05:11'),
+('We need to input the auxiliary UDP application!', 'alarm-generate', '2024-08-30 02:24:29'),
+('connecting the matrix won''t do anything, we need to calculate the haptic AI pixel!', 'panel-hack', '2024-08-30 07:13:44'),
+('Use the wireless TCP firewall, then you can back up the 1080p alarm!', 'transmitter-override', '2024-08-30 15:37:22'),
+('I''ll calculate the haptic API firewall, that should bus the HDD alarm!', 'pixel-hack', '2024-08-30 16:21:39'),
+('The AGP capacitor is down, transmit the primary feed so we can synthesize the CSS alarm!', 'protocol-override', '2024-08-30 20:33:16'),
+('copying the transmitter won''t do anything, we need to back up the back-end HDD application!', 'pixel-parse', '2024-08-30 20:35:04'),
+('If we input the capacitor, we can get to the PNG application through the digital GB sensor!', 'firewall-quantify', '2024-08-30 23:23:00'),
+('I''ll compress the virtual SMTP interface, that should alarm the JBOD sensor!', 'protocol-calculate', '2024-08-31 03:29:05'),
+('I''ll transmit the digital VGA transmitter, that should matrix the USB monitor!', 'panel-reboot', '2024-08-31 09:17:50'),
+('Use the auxiliary CLI microchip, then you can compress the 1080p capacitor!', 'card-copy', '2024-08-31 09:47:45'),
+('You can''t index the monitor without programming the cross-platform DRAM firewall!', 'array-bypass', '2024-08-31 13:45:57'),
+('calculating the feed won''t do anything, we need to reboot the mobile PNG hard drive!', 'driver-synthesize', '2024-08-31 18:09:13'),
+('I''ll connect the neural PNG card, that should card the JSON microchip!', 'panel-connect', '2024-09-01 12:59:10'),
+('The SQL matrix is down, compress the solid state pixel so we can input the AGP panel!', 'sensor-input', '2024-09-01 16:56:00'),
+('I''ll quantify the primary TLS alarm, that should bandwidth the DRAM circuit!', 'monitor-parse', '2024-09-02 12:45:34'),
+('I''ll navigate the bluetooth COM circuit, that should transmitter the TCP driver!', 'interface-navigate', '2024-09-02 15:28:29'),
+('If we connect the alarm, we can get to the RSS array through the auxiliary PNG pixel!', 'transmitter-quantify', '2024-09-02 16:15:02'),
+('You can''t copy the driver without programming the wireless XSS panel!', 'matrix-hack', '2024-09-03 01:24:32'),
+('If we synthesize the capacitor, we can get to the EXE bus through the solid state SDD sensor!', 'capacitor-back-up', '2024-09-03 02:49:47'),
+('We need to connect the primary FTP circuit!', 'sensor-input', '2024-09-03 04:12:52'),
+('The CLI feed is down, override the back-end capacitor so we can calculate the DNS alarm!', 'alarm-parse', '2024-09-05 06:31:58'),
+('Try to input the SMTP driver, maybe it will navigate the primary feed!', 'circuit-input', '2024-09-05 09:56:29'),
+('I''ll generate the mobile SQL sensor, that should interface the FTP transmitter!', 'hard-drive-calculate', '2024-09-05 19:23:54'),
+('The USB card is down, connect the auxiliary port so we can connect the UDP feed!', 'array-navigate', '2024-09-06 01:44:35'),
+('Try to bypass the XSS driver, maybe it will navigate the virtual capacitor!', 'alarm-calculate', '2024-09-06 14:46:15'),
+('If we copy the transmitter, we can get to the IB feed through the haptic JBOD port!', 'protocol-input', '2024-09-07 11:05:31'),
+('Use the auxiliary XML array, then you can bypass the virtual feed!', 'alarm-hack', '2024-09-07 16:44:40'),
+('We need to input the primary TCP alarm!', 'transmitter-index', '2024-09-07 18:40:24'),
+('We need to index the online HTTP system!', 'array-quantify', '2024-09-08 05:43:51'),
+('I''ll generate the mobile EXE feed, that should panel the CSS capacitor!', 'panel-quantify', '2024-09-08 15:52:30'),
+('We need to bypass the virtual HDD application!', 'panel-navigate', '2024-09-09 11:26:20'),
+('Try to hack the ADP array, maybe it will navigate the multi-byte card!', 'alarm-transmit', '2024-09-09 16:49:22'),
+('Try to bypass the HEX monitor, maybe it will index the redundant pixel!', 'application-copy', '2024-09-10 23:31:30'),
+('Use the auxiliary SQL system, then you can override the digital pixel!', 'matrix-index', '2024-09-11 02:34:34'),
+('You can''t quantify the feed without copying the auxiliary AGP transmitter!', 'firewall-generate', '2024-09-11 02:51:43'),
+('I''ll compress the open-source TCP system, that should driver the RSS alarm!', 'monitor-parse', '2024-09-11 04:54:34'),
+('The DNS panel is down, back up the bluetooth transmitter so we can navigate the API feed!', 'application-index', '2024-09-11 19:15:24'),
+('We need to synthesize the redundant API microchip!', 'card-transmit', '2024-09-12 01:31:22'),
+('Try to calculate the THX protocol, maybe it will generate the haptic driver!', 'alarm-copy', '2024-09-12 18:43:55'),
+('Try to compress the RAM sensor, maybe it will program the haptic bus!', 'card-parse', '2024-09-12 19:42:29'),
+('quantifying the protocol won''t do anything, we need to bypass the cross-platform AI panel!', 'port-bypass', '2024-09-12 19:57:55'),
+('indexing the firewall won''t do anything, we need to navigate the virtual HEX feed!', 'hard-drive-generate', '2024-09-13 01:27:37'),
+('I''ll back up the auxiliary SSD matrix, that should monitor the TCP system!', 'port-hack', '2024-09-13 01:32:07'),
+('navigating the feed won''t do anything, we need to override the back-end CSS protocol!', 'port-hack', '2024-09-13 12:03:20'),
+('I''ll navigate the 1080p OCR driver, that should sensor the PNG feed!', 'feed-input', '2024-09-13 13:49:35'),
+('overriding the system won''t do anything, we need to back up the bluetooth FTP program!', 'sensor-quantify', '2024-09-14 05:21:31'),
+('We need to index the wireless SDD firewall!', 'program-quantify', '2024-09-14 05:38:00'),
+('The API microchip is down, parse the digital alarm so we can quantify the PCI circuit!', 'bandwidth-compress', '2024-09-14 07:16:08'),
+('I''ll input the virtual TCP bandwidth, that should program the SAS microchip!', 'hard-drive-hack', '2024-09-14 10:09:37'),
+('Use the haptic PNG transmitter, then you can compress the online panel!', 'transmitter-generate', '2024-09-14 11:44:53'),
+('If we transmit the system, we can get to the SMS interface through the online AGP system!', 'matrix-back-up', '2024-09-14 15:55:33'),
+('calculating the protocol won''t do anything, we need to navigate the solid state TCP transmitter!', 'panel-override', '2024-09-14 21:53:43'),
+('Use the wireless UDP pixel, then you can connect the wireless pixel!', 'monitor-back-up', '2024-09-15 17:22:55'),
+('We need to input the auxiliary SAS pixel!', 'circuit-reboot', '2024-09-16 13:44:07'),
+('I''ll index the auxiliary UDP transmitter, that should bandwidth the ADP bus!', 'system-quantify', '2024-09-17 09:47:09'),
+('quantifying the feed won''t do anything, we need to compress the back-end GB interface!', 'array-connect', '2024-09-18 01:53:40'),
+('compressing the bandwidth won''t do anything, we need to hack the back-end IP bus!', 'array-generate', '2024-09-18 05:32:23'),
+('Use the back-end AI alarm, then you can navigate the wireless monitor!', 'firewall-transmit', '2024-09-18 11:27:43'),
+('Use the auxiliary SMTP pixel, then you can quantify the digital hard drive!', 'port-generate', '2024-09-18 12:22:40'),
+('copying the alarm won''t do anything, we need to hack the multi-byte SMTP monitor!', 'bandwidth-synthesize', '2024-09-18 12:36:49'),
+('You can''t synthesize the card without generating the cross-platform ADP driver!', 'protocol-compress', '2024-09-18 18:29:46'),
+('I''ll program the solid state DNS application, that should card the EXE port!', 'port-copy', '2024-09-18 22:54:10'),
+('Try to connect the THX bus, maybe it will back up the haptic protocol!', 'capacitor-reboot', '2024-09-18 23:24:54'),
+('We need to copy the cross-platform RAM card!', 'interface-navigate', '2024-09-19 03:56:46'),
+('I''ll input the multi-byte COM panel, that should alarm the JSON port!', 'firewall-connect', '2024-09-19 10:24:46'),
+('We need to quantify the digital SQL bandwidth!', 'microchip-synthesize', '2024-09-19 21:53:51'),
+('The DRAM hard drive is down, quantify the cross-platform interface so we can compress the SCSI transmitter!', 'port-parse', '2024-09-20 03:26:07'),
+('I''ll input the open-source API program, that should array the SMTP port!', 'monitor-override', '2024-09-20 21:25:05'),
+('I''ll copy the bluetooth USB feed, that should feed the DNS port!', 'bandwidth-hack', '2024-09-21 04:06:44'),
+('We need to input the haptic PNG pixel!', 'interface-hack', '2024-09-21 10:24:42'),
+('Use the digital JBOD application, then you can input the haptic bus!', 'transmitter-override', '2024-09-21 10:28:44'),
+('If we back up the firewall, we can get to the CSS alarm through the bluetooth UTF8 program!', 'pixel-calculate', '2024-09-22 06:15:48'),
+('overriding the circuit won''t do anything, we need to reboot the primary ADP transmitter!', 'application-program', '2024-09-22 11:24:14'),
+('bypassing the alarm won''t do anything, we need to transmit the 1080p VGA capacitor!', 'program-connect', '2024-09-22 14:04:11'),
+('synthesizing the hard drive won''t do anything, we need to copy the bluetooth THX alarm!', 'protocol-program', '2024-09-22 17:33:47'),
+('If we program the protocol, we can get to the AI system through the redundant TLS system!', 'capacitor-generate', '2024-09-22 20:02:44'),
+('You can''t hack the system without calculating the digital XML array!', 'feed-input', '2024-09-24 05:51:07'),
+('The RSS program is down, input the back-end microchip so we can reboot the RSS driver!', 'panel-connect', '2024-09-24 19:49:17'),
+('hacking the firewall won''t do anything, we need to connect the virtual XSS bus!', 'microchip-synthesize', '2024-09-24 22:27:39'),
+('transmitting the pixel won''t do anything, we need to copy the bluetooth FTP card!', 'matrix-parse', '2024-09-25 08:38:24'),
+('programming the system won''t do anything, we need to reboot the virtual ASCII firewall!', 'microchip-program', '2024-09-25 16:33:53'),
+('The VGA interface is down, generate the back-end protocol so we can back up the AGP card!', 'system-override', '2024-09-26 03:17:10'),
+('The SSD firewall is down, reboot the haptic bandwidth so we can connect the JBOD driver!', 'port-hack', '2024-09-26 03:34:58'),
+('If we calculate the circuit, we can get to the API bandwidth through the cross-platform IP capacitor!', 'sensor-index', '2024-09-26 06:56:39'),
+('Use the neural OCR hard drive, then you can index the primary program!', 'alarm-hack', '2024-09-27 06:08:54'),
+('You can''t input the capacitor without transmitting the auxiliary IP bandwidth!', 'program-generate', '2024-09-27 14:47:24'),
+('The SQL feed is down, input the optical pixel so we can navigate the CLI alarm!', 'array-input', '2024-09-27 18:16:00'),
+('Try to navigate the TCP protocol, maybe it will input the multi-byte microchip!', 'panel-synthesize', '2024-09-28 03:05:26'),
+('Use the multi-byte JSON port, then you can parse the auxiliary bandwidth!', 'matrix-bypass', '2024-09-28 12:19:14'),
+('The SSD matrix is down, connect the wireless pixel so we can calculate the JBOD panel!', 'transmitter-calculate', '2024-09-29 13:08:46'),
+('The ADP protocol is down, bypass the 1080p matrix so we can index the TLS system!', 'panel-index', '2024-09-29 17:25:25'),
+('We need to synthesize the optical IB driver!', 'port-transmit', '2024-09-30 02:03:49'),
+('I''ll synthesize the digital UTF8 bandwidth, that should array the ASCII program!', 'port-reboot', '2024-10-01 10:19:35'),
+('You can''t index the feed without programming the auxiliary EXE microchip!', 'capacitor-index', '2024-10-01 11:16:32'),
+('You can''t bypass the alarm without programming the digital VGA bandwidth!', 'microchip-generate', '2024-10-01 14:30:43'),
+('I''ll hack the mobile UDP application, that should application the EXE sensor!', 'circuit-calculate', '2024-10-02 08:07:52'),
+('parsing the port won''t do anything, we need to override the wireless IP bus!', 'hard-drive-bypass', '2024-10-02 22:11:13'),
+('I''ll navigate the wireless SAS microchip, that should alarm the GB bandwidth!', 'card-override', '2024-10-03 11:47:45'),
+('The PNG capacitor is down, index the back-end protocol so we can index the UDP circuit!', 'matrix-bypass', '2024-10-03 19:50:44'),
+('We need to bypass the neural ADP microchip!', 'interface-navigate', '2024-10-03 20:46:02'),
+('Try to program the FTP driver, maybe it will connect the bluetooth bandwidth!', 'panel-navigate', '2024-10-04 01:13:55'),
+('copying the alarm won''t do anything, we need to quantify the optical SCSI circuit!', 'program-calculate', '2024-10-04 09:03:38'),
+('If we generate the bandwidth, we can get to the PNG sensor through the auxiliary SSL monitor!', 'bus-synthesize', '2024-10-04 11:58:35'),
+('We need to copy the mobile RAM bus!', 'bandwidth-compress', '2024-10-04 12:25:41'),
+('We need to connect the redundant OCR circuit!', 'protocol-hack', '2024-10-04 13:16:08'),
+('I''ll connect the 1080p GB transmitter, that should monitor the GB application!', 'alarm-navigate', '2024-10-04 20:25:39'),
+('You can''t calculate the pixel without synthesizing the auxiliary ADP matrix!', 'monitor-copy', '2024-10-05 10:30:32'),
+('Try to index the CLI application, maybe it will hack the primary sensor!', 'system-quantify', '2024-10-05 23:05:01'),
+('We need to program the 1080p HEX application!', 'bus-program', '2024-10-06 00:37:03'),
+('I''ll hack the haptic ASCII microchip, that should alarm the HTTP hard drive!', 'monitor-navigate', '2024-10-06 04:53:22'),
+('The EXE transmitter is down, compress the solid state program so we can back up the RSS circuit!', 'matrix-index', '2024-10-06 20:57:52'),
+('We need to synthesize the open-source EXE array!', 'hard-drive-copy', '2024-10-07 02:08:47'),
+('I''ll program the cross-platform RSS driver, that should circuit the ASCII port!', 'application-parse', '2024-10-07 13:24:53'),
+('The EXE protocol is down, parse the digital sensor so we can calculate the SCSI alarm!', 'panel-compress', '2024-10-07 15:23:31'),
+('You can''t reboot the program without bypassing the cross-platform SDD hard drive!', 'firewall-synthesize', '2024-10-07 20:19:01'),
+('backing up the driver won''t do anything, we need to copy the online PCI port!', 'array-compress', '2024-10-07 23:56:58'),
+('I''ll hack the online SDD bandwidth, that should feed the THX protocol!', 'application-input', '2024-10-08 15:24:39'),
+('Try to copy the IP circuit, maybe it will reboot the online program!', 'firewall-program', '2024-10-08 18:28:13'),
+('bypassing the program won''t do anything, we need to compress the wireless IB microchip!', 'monitor-back-up', '2024-10-08 20:36:51'),
+('We need to input the 1080p SAS program!', 'card-connect', '2024-10-09 02:59:43'),
+('If we reboot the driver, we can get to the HDD bandwidth through the mobile USB port!', 'alarm-hack', '2024-10-09 08:02:50'),
+('The ASCII monitor is down, copy the mobile circuit so we can transmit the HDD card!', 'bus-quantify', '2024-10-10 03:10:25'),
+('You can''t transmit the microchip without hacking the bluetooth TLS circuit!', 'array-parse', '2024-10-10 21:55:14'),
+('We need to bypass the mobile TLS firewall!', 'firewall-bypass', '2024-10-11 00:17:04'),
+('Use the back-end JBOD port, then you can calculate the multi-byte feed!', 'hard-drive-reboot', '2024-10-11 11:28:18'),
+('If we generate the interface, we can get to the AI alarm through the back-end OCR transmitter!', 'interface-override', '2024-10-11 16:08:33'),
+('Use the open-source HEX driver, then you can compress the optical microchip!', 'monitor-compress', '2024-10-12 02:58:13'),
+('If we back up the system, we can get to the RSS hard drive through the mobile RAM array!', 'transmitter-transmit', '2024-10-12 05:43:31'),
+('Use the online SSD firewall, then you can hack the multi-byte protocol!', 'feed-program', '2024-10-12 20:06:00'),
+('We need to override the cross-platform CSS application!', 'hard-drive-reboot', '2024-10-13 06:15:24'),
+('The DNS alarm is down, copy the open-source program so we can synthesize the GB bandwidth!', 'matrix-generate', '2024-10-13 07:59:14'),
+('The TLS panel is down, override the open-source port so we can synthesize the API interface!', 'hard-drive-override', '2024-10-13 18:46:54'),
+('Use the wireless TLS matrix, then you can bypass the auxiliary hard drive!', 'application-transmit', '2024-10-14 16:31:21'),
+('If we transmit the transmitter, we can get to the OCR alarm through the redundant EXE bandwidth!', 'system-reboot', '2024-10-14 19:16:04'),
+('The HDD microchip is down, navigate the wireless circuit so we can calculate the ASCII system!', 'matrix-input', '2024-10-14 23:43:58'),
+('You can''t connect the protocol without bypassing the digital TCP system!', 'circuit-bypass', '2024-10-15 01:14:59'),
+('You can''t copy the firewall without overriding the wireless JSON firewall!', 'firewall-hack', '2024-10-15 13:03:34'),
+('You can''t input the program without generating the cross-platform FTP monitor!', 'application-program', '2024-10-15 19:39:30'),
+('Try to reboot the UDP monitor, maybe it will reboot the 1080p system!', 'microchip-connect', '2024-10-15 22:38:22'),
+('I''ll override the neural HEX feed, that should microchip the CSS system!', 'circuit-synthesize', '2024-10-16 12:28:19'),
+('If we calculate the transmitter, we can get to the RSS port through the cross-platform RAM card!', 'alarm-index', '2024-10-17 07:57:39'),
+('compressing the system won''t do anything, we need to bypass the wireless DRAM card!', 'interface-reboot', '2024-10-17 22:11:14'),
+('You can''t override the bus without hacking the cross-platform CSS panel!', 'feed-compress', '2024-10-18 18:13:02'),
+('Use the virtual THX program, then you can reboot the wireless transmitter!', 'interface-compress', '2024-10-18 18:24:34'),
+('Use the 1080p DRAM system, then you can back up the haptic microchip!', 'application-calculate', '2024-10-18 18:55:41'),
+('Use the haptic SSD monitor, then you can connect the haptic bandwidth!', 'driver-connect', '2024-10-18 19:09:48'),
+('If we parse the program, we can get to the ADP capacitor through the neural ADP protocol!', 'protocol-reboot', '2024-10-18 19:27:00'),
+('If we compress the application, we can get to the UDP alarm through the solid state VGA firewall!', 'driver-compress', '2024-10-18 20:06:36'),
+('We need to navigate the primary RAM sensor!', 'bus-back-up', '2024-10-18 20:47:29'),
+('If we program the bus, we can get to the RAM microchip through the primary SDD alarm!', 'circuit-synthesize', '2024-10-19 20:20:30'),
+('The HEX firewall is down, transmit the neural system so we can back up the SSL pixel!', 'matrix-connect', '2024-10-19 21:28:51'),
+('If we compress the card, we can get to the SMS matrix through the neural SQL circuit!', 'port-override', '2024-10-20 03:52:59'),
+('Use the primary SSL protocol, then you can connect the back-end system!', 'port-generate', '2024-10-20 10:47:21'),
+('The CSS interface is down, program the solid state interface so we can connect the ASCII interface!', 'feed-index', '2024-10-20 17:40:51'),
+('We need to index the multi-byte CLI driver!', 'sensor-parse', '2024-10-21 07:51:33'),
+('I''ll override the primary JSON circuit, that should firewall the DNS sensor!', 'monitor-transmit', '2024-10-21 19:26:08'),
+('navigating the driver won''t do anything, we need to navigate the mobile RAM interface!', 'array-back-up', '2024-10-22 13:02:27'),
+('Use the digital EXE bus, then you can back up the mobile system!', 'alarm-bypass', '2024-10-22 13:26:16'),
+('If we quantify the port, we can get to the COM array through the online TCP interface!', 'interface-input', '2024-10-23 04:05:54'),
+('I''ll generate the haptic RAM hard drive, that should matrix the AI array!', 'array-generate', '2024-10-23 10:26:43'),
+('We need to parse the online VGA bandwidth!', 'program-navigate', '2024-10-23 12:15:44'),
+('I''ll reboot the optical DNS system, that should monitor the PCI pixel!', 'port-connect', '2024-10-23 13:46:52'),
+('Use the auxiliary XSS bus, then you can connect the neural sensor!', 'matrix-program', '2024-10-24 06:41:32'),
+('The DNS sensor is down, override the wireless microchip so we can calculate the IP protocol!', 'alarm-input', '2024-10-25 02:06:55'),
+('compressing the system won''t do anything, we need to back up the online API hard drive!', 'transmitter-copy', '2024-10-25 23:07:23'),
+('We need to reboot the cross-platform ASCII system!', 'feed-quantify', '2024-10-26 04:10:14'),
+('Use the open-source CLI transmitter, then you can compress the digital circuit!', 'pixel-back-up', '2024-10-26 11:07:36'),
+('If we synthesize the bandwidth, we can get to the DNS system through the virtual XML driver!', 'matrix-input', '2024-10-26 14:21:07'),
+('We need to copy the redundant SQL card!', 'circuit-synthesize', '2024-10-26 19:19:46'),
+('Use the neural VGA microchip, then you can copy the virtual program!', 'alarm-input', '2024-10-27 04:15:32'),
+('The OCR feed is down, quantify the 1080p protocol so we can back up the FTP alarm!', 'driver-navigate', '2024-10-27 14:43:39'),
+('You can''t back up the interface without connecting the virtual CLI bandwidth!', 'interface-navigate', '2024-10-28 03:44:40'),
+('You can''t override the microchip without compressing the solid state SQL port!', 'alarm-connect', '2024-10-29 01:12:05'),
+('You can''t back up the panel without generating the online JBOD card!', 'bandwidth-input', '2024-10-29 05:08:47'),
+('If we quantify the program, we can get to the SMS sensor through the virtual HTTP microchip!', 'circuit-navigate', '2024-10-29 08:38:54'),
+('Use the neural HEX port, then you can connect the multi-byte panel!', 'capacitor-quantify', '2024-10-29 15:35:30'),
+('Use the multi-byte SAS feed, then you can index the auxiliary interface!', 'driver-copy', '2024-10-29 22:08:08'),
+('I''ll index the redundant SDD alarm, that should feed the ASCII port!', 'protocol-hack', '2024-10-30 00:06:03'),
+('We need to navigate the wireless IP monitor!', 'port-calculate', '2024-10-30 05:43:11'),
+('The XSS driver is down, program the solid state microchip so we can parse the HEX array!', 'matrix-generate', '2024-10-30 11:51:05'),
+('Try to compress the JBOD bus, maybe it will bypass the 1080p monitor!', 'firewall-connect', '2024-10-30 18:33:47'),
+('If we transmit the sensor, we can get to the AGP feed through the cross-platform API bandwidth!', 'protocol-synthesize', '2024-10-31 06:46:45'),
+('You can''t generate the microchip without quantifying the open-source FTP card!', 'card-connect', '2024-10-31 10:37:30'),
+('Use the wireless HEX protocol, then you can back up the digital matrix!', 'application-index', '2024-11-01 01:58:20'),
+('I''ll navigate the multi-byte API card, that should monitor the FTP alarm!', 'array-calculate', '2024-11-01 02:44:54'),
+('You can''t program the bus without compressing the primary SQL capacitor!', 'sensor-parse', '2024-11-01 09:51:57'),
+('Use the optical AI application, then you can quantify the virtual sensor!', 'interface-reboot', '2024-11-01 18:07:45'),
+('If we back up the capacitor, we can get to the TCP protocol through the primary IB transmitter!', 'hard-drive-navigate', '2024-11-02 07:49:23'),
+('Try to transmit the HEX sensor, maybe it will quantify the cross-platform interface!', 'bus-compress', '2024-11-03 06:04:14'),
+('Use the optical ADP capacitor, then you can transmit the bluetooth microchip!', 'alarm-program', '2024-11-04 08:57:58'),
+('The SMS capacitor is down, bypass the solid state program so we can connect the TLS array!', 'card-index', '2024-11-04 09:38:50'),
+('transmitting the program won''t do anything, we need to navigate the cross-platform SMTP firewall!', 'firewall-calculate', '2024-11-04 10:03:41'),
+('We need to quantify the redundant TLS system!', 'circuit-bypass', '2024-11-04 14:57:24'),
+('synthesizing the monitor won''t do anything, we need to back up the optical AGP monitor!', 'bandwidth-quantify', '2024-11-06 17:28:55'),
+('compressing the hard drive won''t do anything, we need to bypass the mobile AGP program!', 'matrix-synthesize', '2024-11-06 19:01:31'),
+('I''ll parse the redundant SSD feed, that should capacitor the HDD card!', 'application-back-up', '2024-11-07 13:19:15'),
+('I''ll parse the back-end UTF8 feed, that should hard drive the AI matrix!', 'monitor-generate', '2024-11-07 15:03:03'),
+('I''ll reboot the redundant HTTP capacitor, that should sensor the SSD matrix!', 'alarm-program', '2024-11-07 15:08:47'),
+('If we generate the feed, we can get to the USB microchip through the virtual TLS interface!', 'firewall-input', '2024-11-08 01:55:51'),
+('You can''t calculate the circuit without navigating the auxiliary SSD array!', 'array-program', '2024-11-09 01:48:10'),
+('Try to parse the JSON bus, maybe it will generate the cross-platform card!', 'bus-override', '2024-11-09 06:33:29'),
+('bypassing the hard drive won''t do anything, we need to connect the virtual RSS bus!', 'protocol-program', '2024-11-09 17:22:31'),
+('If we override the capacitor, we can get to the ADP card through the primary RAM system!', 'panel-override', '2024-11-10 04:15:34'),
+('The RAM card is down, calculate the cross-platform monitor so we can synthesize the AI feed!', 'monitor-calculate', '2024-11-10 10:05:42'),
+('Use the optical RSS array, then you can bypass the online application!', 'program-index', '2024-11-11 04:21:56'),
+('The HEX panel is down, program the wireless microchip so we can hack the XSS feed!', 'pixel-synthesize', '2024-11-11 11:39:05'),
+('You can''t quantify the matrix without indexing the digital SSL hard drive!', 'bandwidth-transmit', '2024-11-13 04:39:45'),
+('If we bypass the capacitor, we can get to the DNS capacitor through the 1080p SSD bandwidth!', 'hard-drive-input', '2024-11-13 06:50:50'),
+('You can''t transmit the panel without synthesizing the open-source SCSI card!', 'monitor-index', '2024-11-13 09:48:42'),
+('I''ll transmit the primary DRAM interface, that should matrix the USB card!', 'hard-drive-copy', '2024-11-13 12:38:26'),
+('If we navigate the hard drive, we can get to the DRAM interface through the auxiliary SMTP feed!', 'firewall-input', '2024-11-13 23:56:44'),
+('copying the port won''t do anything, we need to synthesize the mobile SSL transmitter!', 'matrix-compress', '2024-11-14 02:16:20'),
+('The RAM feed is down, back up the wireless matrix so we can reboot the ASCII interface!', 'system-bypass', '2024-11-14 16:36:12'),
+('The SSL array is down, hack the haptic driver so we can back up the COM application!', 'microchip-calculate', '2024-11-14 17:57:35'),
+('Try to quantify the JSON hard drive, maybe it will compress the solid state interface!', 'microchip-bypass', '2024-11-15 01:33:23'),
+('overriding the protocol won''t do anything, we need to hack the bluetooth EXE pixel!', 'bus-reboot', '2024-11-15 12:29:01'),
+('Try to override the XML pixel, maybe it will program the solid state transmitter!', 'system-hack', '2024-11-15 12:55:12'),
+('Use the solid state SDD port, then you can override the bluetooth application!', 'program-hack', '2024-11-16 03:09:16'),
+('Try to copy the CSS hard drive, maybe it will transmit the haptic port!', 'system-program', '2024-11-16 03:25:00'),
+('compressing the bus won''t do anything, we need to compress the open-source HTTP hard drive!', 'microchip-synthesize', '2024-11-16 04:39:48'),
+('Try to bypass the AGP array, maybe it will index the 1080p capacitor!', 'capacitor-transmit', '2024-11-16 11:17:59'),
+('The PCI capacitor is down, transmit the haptic card so we can copy the RAM protocol!', 'protocol-compress', '2024-11-16 13:00:28'),
+('Use the 1080p COM system, then you can calculate the optical alarm!', 'protocol-compress', '2024-11-17 09:00:26'),
+('I''ll override the redundant API microchip, that should transmitter the THX application!', 'microchip-input', '2024-11-18 01:53:58'),
+('We need to hack the optical HDD interface!', 'feed-navigate', '2024-11-19 17:07:17'),
+('The HTTP circuit is down, index the redundant protocol so we can index the EXE alarm!', 'card-calculate', '2024-11-19 17:11:04'),
+('I''ll transmit the 1080p API system, that should microchip the GB bandwidth!', 'circuit-index', '2024-11-20 14:44:32'),
+('Use the auxiliary PNG circuit, then you can calculate the 1080p protocol!', 'panel-bypass', '2024-11-20 17:05:53'),
+('synthesizing the protocol won''t do anything, we need to hack the primary TCP panel!', 'card-generate', '2024-11-20 19:42:55'),
+('You can''t index the application without overriding the optical UDP bus!', 'feed-hack', '2024-11-21 01:57:10'),
+('The OCR sensor is down, reboot the multi-byte panel so we can generate the ASCII alarm!', 'driver-override', '2024-11-21 14:07:02'),
+('If we hack the transmitter, we can get to the ADP card through the multi-byte RAM card!', 'panel-parse', '2024-11-21 14:37:39'),
+('If we hack the application, we can get to the SQL sensor through the open-source JSON program!', 'system-generate', '2024-11-21 17:13:17'),
+('programming the capacitor won''t do anything, we need to reboot the optical AI interface!', 'firewall-synthesize', '2024-11-21 23:12:05'),
+('We need to compress the virtual HEX bus!', 'firewall-generate', '2024-11-22 03:16:28'),
+('I''ll back up the wireless RSS bandwidth, that should microchip the UDP port!', 'driver-synthesize', '2024-11-22 11:05:35'),
+('You can''t compress the interface without quantifying the virtual HEX interface!', 'array-compress', '2024-11-22 23:44:00'),
+('You can''t synthesize the monitor without parsing the 1080p RSS feed!', 'bandwidth-index', '2024-11-24 02:38:14'),
+('I''ll parse the virtual AI interface, that should microchip the OCR firewall!', 'capacitor-hack', '2024-11-25 11:00:42'),
+('Use the primary PNG firewall, then you can transmit the mobile protocol!', 'system-reboot', '2024-11-26 04:19:38'),
+('We need to synthesize the optical IP circuit!', 'port-input', '2024-11-26 16:55:49'),
+('compressing the card won''t do anything, we need to program the auxiliary SQL interface!', 'protocol-program', '2024-11-26 18:17:43'),
+('You can''t hack the alarm without navigating the solid state XML system!', 'firewall-bypass', '2024-11-27 14:44:38'),
+('You can''t bypass the microchip without parsing the cross-platform RAM card!', 'port-calculate', '2024-11-27 17:22:03'),
+('If we override the feed, we can get to the IP program through the optical USB protocol!', 'monitor-program', '2024-11-27 18:42:14'),
+('If we navigate the circuit, we can get to the HDD transmitter through the back-end PCI firewall!', 'port-reboot', '2024-11-28 13:27:13'),
+('I''ll reboot the 1080p IP application, that should alarm the HTTP hard drive!', 'circuit-synthesize', '2024-11-28 19:54:14'),
+('If we override the hard drive, we can get to the EXE program through the multi-byte JBOD array!', 'interface-bypass', '2024-11-29 11:21:34'),
+('The TCP bus is down, bypass the primary alarm so we can input the IP pixel!', 'application-calculate', '2024-11-30 14:47:57'),
+('overriding the bus won''t do anything, we need to navigate the neural ASCII feed!', 'sensor-index', '2024-12-01 05:01:39'),
+('The TLS bandwidth is down, bypass the mobile protocol so we can compress the CLI bus!', 'program-navigate', '2024-12-01 07:14:32'),
+('We need to connect the bluetooth SMTP panel!', 'driver-bypass', '2024-12-01 13:21:17'),
+('Try to parse the JSON bandwidth, maybe it will hack the back-end transmitter!', 'hard-drive-override', '2024-12-02 06:56:54'),
+('The SAS port is down, quantify the cross-platform matrix so we can compress the DRAM card!', 'alarm-parse', '2024-12-02 10:42:46'),
+('I''ll back up the 1080p SAS matrix, that should pixel the HDD program!', 'interface-generate', '2024-12-03 05:24:48'),
+('Try to transmit the UTF8 circuit, maybe it will reboot the digital monitor!', 'bandwidth-synthesize', '2024-12-03 06:56:02'),
+('We need to calculate the optical EXE application!', 'system-generate', '2024-12-03 07:30:14'),
+('Try to connect the DNS sensor, maybe it will input the back-end bus!', 'feed-quantify', '2024-12-03 13:10:00'),
+('The TLS hard drive is down, quantify the cross-platform bandwidth so we can synthesize the USB pixel!', 'driver-reboot', '2024-12-03 19:47:31'),
+('The THX alarm is down, calculate the bluetooth circuit so we can override the OCR firewall!', 'transmitter-calculate', '2024-12-04 00:44:12'),
+('Try to index the PNG sensor, maybe it will program the open-source card!', 'protocol-input', '2024-12-04 01:59:19'),
+('navigating the interface won''t do anything, we need to reboot the open-source SAS sensor!', 'alarm-hack', '2024-12-04 14:48:54'),
+('The DNS capacitor is down, generate the multi-byte alarm so we can copy the SCSI firewall!', 'protocol-copy', '2024-12-04 19:44:19'),
+('Use the haptic SSD pixel, then you can copy the redundant program!', 'system-navigate', '2024-12-05 01:06:42'),
+('quantifying the port won''t do anything, we need to bypass the digital UDP capacitor!', 'driver-parse', '2024-12-05 05:51:18'),
+('The FTP interface is down, index the open-source matrix so we can reboot the SSD application!', 'card-quantify', '2024-12-05 06:37:25'),
+('If we reboot the interface, we can get to the SMTP application through the open-source OCR protocol!', 'card-transmit', '2024-12-05 13:46:04'),
+('Try to override the THX system, maybe it will calculate the wireless driver!', 'application-copy', '2024-12-05 16:26:47'),
+('The OCR circuit is down, bypass the multi-byte application so we can bypass the HTTP port!', 'firewall-hack', '2024-12-06 21:09:30'),
+('transmitting the firewall won''t do anything, we need to back up the solid state OCR program!', 'array-synthesize', '2024-12-07 12:06:48'),
+('I''ll back up the neural SSL hard drive, that should program the SSL panel!', 'card-program', '2024-12-07 12:30:19'),
+('You can''t generate the bandwidth without calculating the haptic USB application!', 'circuit-bypass', '2024-12-07 14:03:48'),
+('Try to index the SSD matrix, maybe it will synthesize the virtual firewall!', 'transmitter-back-up', '2024-12-08 05:28:58'),
+('The IB alarm is down, parse the redundant bus so we can back up the CLI transmitter!', 'bandwidth-quantify', '2024-12-08 05:48:09'),
+('Try to transmit the SAS monitor, maybe it will calculate the neural bus!', 'feed-compress', '2024-12-08 18:22:32'),
+('synthesizing the matrix won''t do anything, we need to quantify the haptic IB firewall!', 'port-quantify', '2024-12-09 06:59:58'),
+('You can''t index the pixel without copying the bluetooth HEX driver!', 'protocol-navigate', '2024-12-09 07:24:29'),
+('You can''t quantify the transmitter without synthesizing the cross-platform OCR transmitter!', 'alarm-compress', '2024-12-09 13:22:58'),
+('The VGA card is down, index the back-end program so we can reboot the DNS microchip!', 'bus-compress', '2024-12-10 06:26:58'),
+('Try to connect the THX sensor, maybe it will parse the redundant bandwidth!', 'bandwidth-generate', '2024-12-10 10:52:52'),
+('If we parse the alarm, we can get to the THX firewall through the wireless API interface!', 'bandwidth-generate', '2024-12-10 14:10:13'),
+('If we back up the port, we can get to the PCI hard drive through the neural ADP firewall!', 'firewall-back-up', '2024-12-10 18:10:24'),
+('If we copy the driver, we can get to the VGA port through the 1080p TCP sensor!', 'protocol-program', '2024-12-10 21:25:31'),
+('copying the bandwidth won''t do anything, we need to navigate the cross-platform XSS sensor!', 'monitor-generate', '2024-12-11 20:22:17'),
+('Try to bypass the SSD system, maybe it will index the online driver!', 'bandwidth-parse', '2024-12-12 07:13:23'),
+('I''ll back up the mobile SMS feed, that should feed the AGP pixel!', 'alarm-parse', '2024-12-12 19:00:53'),
+('I''ll copy the neural CSS capacitor, that should port the PCI microchip!', 'system-reboot', '2024-12-13 01:41:47'),
+('copying the panel won''t do anything, we need to copy the online SCSI panel!', 'capacitor-connect', '2024-12-13 02:27:27'),
+('We need to parse the multi-byte PNG matrix!', 'sensor-back-up', '2024-12-13 04:59:52'),
+('If we override the bandwidth, we can get to the API program through the cross-platform HEX interface!', 'transmitter-calculate', '2024-12-13 13:56:54'),
+('indexing the bandwidth won''t do anything, we need to index the solid state SDD panel!', 'firewall-calculate', '2024-12-13 22:30:17'),
+('You can''t connect the firewall without navigating the primary VGA microchip!', 'transmitter-copy', '2024-12-14 03:24:20'),
+('The SDD card is down, input the open-source driver so we can copy the HTTP panel!', 'card-copy', '2024-12-14 17:54:39'),
+('We need to compress the haptic DNS microchip!', 'panel-connect', '2024-12-14 23:37:02'),
+('Try to compress the SAS microchip, maybe it will override the digital system!', 'panel-program', '2024-12-15 12:25:24'),
+('connecting the transmitter won''t do anything, we need to input the redundant SDD bus!', 'pixel-parse', '2024-12-16 18:00:40'),
+('The TCP driver is down, navigate the virtual program so we can navigate the THX microchip!', 'system-input', '2024-12-16 19:19:17'),
+('If we quantify the protocol, we can get to the ASCII system through the mobile TCP array!', 'capacitor-index', '2024-12-16 23:17:01'),
+('Use the cross-platform SDD system, then you can transmit the digital array!', 'card-bypass', '2024-12-16 23:55:06'),
+('Try to override the SAS alarm, maybe it will connect the solid state circuit!', 'transmitter-compress', '2024-12-17 06:20:59'),
+('The GB system is down, back up the auxiliary interface so we can bypass the CSS pixel!', 'alarm-input', '2024-12-17 09:54:02'),
+('parsing the monitor won''t do anything, we need to navigate the wireless SMTP bandwidth!', 'feed-compress', '2024-12-17 10:19:44'),
+('You can''t navigate the monitor without generating the mobile RAM hard drive!', 'feed-calculate', '2024-12-18 06:56:24'),
+('I''ll parse the multi-byte USB protocol, that should card the HTTP microchip!', 'pixel-transmit', '2024-12-18 08:02:04'),
+('Use the open-source SDD interface, then you can synthesize the wireless application!', 'bandwidth-compress', '2024-12-18 20:20:03'),
+('I''ll parse the redundant CLI matrix, that should feed the AI hard drive!', 'protocol-quantify', '2024-12-19 14:13:08'),
+('I''ll override the wireless IP feed, that should capacitor the RSS feed!', 'capacitor-transmit', '2024-12-19 20:09:26'),
+('You can''t transmit the port without connecting the bluetooth SSD alarm!', 'port-navigate', '2024-12-20 08:06:09'),
+('I''ll compress the online RSS feed, that should alarm the VGA capacitor!', 'monitor-back-up', '2024-12-20 10:03:27'),
+('I''ll bypass the auxiliary API bandwidth, that should matrix the JBOD program!', 'program-program', '2024-12-20 11:14:45'),
+('Try to override the CLI array, maybe it will override the haptic feed!', 'transmitter-connect', '2024-12-20 17:17:24'),
+('We need to compress the neural PNG transmitter!', 'bandwidth-calculate', '2024-12-20 20:50:26'),
+('I''ll transmit the auxiliary CLI system, that should matrix the UTF8 protocol!', 'feed-input', '2024-12-21 09:05:12'),
+('I''ll index the 1080p PNG capacitor, that should firewall the PNG firewall!', 'program-parse', '2024-12-21 10:16:13'),
+('The XML interface is down, back up the mobile card so we can synthesize the XSS firewall!', 'driver-generate', '2024-12-21 13:41:02'),
+('We need to program the digital HTTP driver!', 'capacitor-connect', '2024-12-21 23:51:29'),
+('Use the solid state XSS bus, then you can index the mobile feed!', 'panel-program', '2024-12-22 00:58:35'),
+('The IB microchip is down, connect the 1080p bandwidth so we can compress the AI circuit!', 'panel-hack', '2024-12-22 10:29:38'),
+('If we reboot the driver, we can get to the COM panel through the back-end SDD sensor!', 'capacitor-synthesize', '2024-12-22 18:52:30'),
+('The PCI application is down, back up the 1080p alarm so we can input the SSL firewall!', 'program-input', '2024-12-23 05:49:15'),
+('Use the 1080p USB sensor, then you can index the 1080p application!', 'protocol-copy', '2024-12-23 20:28:49'),
+('The SCSI array is down, bypass the wireless bandwidth so we can copy the XSS matrix!', 'circuit-program', '2024-12-23 20:35:35'),
+('The CLI feed is down, index the bluetooth protocol so we can quantify the HDD alarm!', 'hard-drive-hack', '2024-12-23 20:47:49'),
+('We need to copy the online THX circuit!', 'monitor-index', '2024-12-23 22:11:34'),
+('The TCP card is down, connect the optical transmitter so we can calculate the COM card!', 'alarm-synthesize', '2024-12-24 08:49:11'),
+('Try to reboot the FTP interface, maybe it will synthesize the neural capacitor!', 'array-connect', '2024-12-24 10:27:31'),
+('copying the program won''t do anything, we need to index the 1080p USB matrix!', 'microchip-connect', '2024-12-24 19:42:24'),
+('Use the multi-byte UTF8 program, then you can hack the open-source card!', 'monitor-index', '2024-12-25 06:01:29'),
+('If we reboot the firewall, we can get to the RSS interface through the bluetooth PNG interface!', 'pixel-generate', '2024-12-25 18:32:54'),
+('The XSS port is down, copy the 1080p circuit so we can reboot the RAM firewall!', 'matrix-override', '2024-12-26 04:28:51'),
+('Use the digital USB capacitor, then you can bypass the neural system!', 'card-connect', '2024-12-27 05:52:30'),
+('Try to calculate the SMTP microchip, maybe it will quantify the back-end feed!', 'port-calculate', '2024-12-27 09:08:38'),
+('The UTF8 application is down, synthesize the open-source feed so we can synthesize the XSS application!', 'capacitor-transmit', '2024-12-27 10:16:16'),
+('calculating the alarm won''t do anything, we need to hack the solid state UDP sensor!', 'feed-generate', '2024-12-28 01:18:12'),
+('If we back up the hard drive, we can get to the JBOD port through the haptic USB system!', 'application-input', '2024-12-28 17:11:34'),
+('You can''t program the hard drive without parsing the redundant RAM port!', 'pixel-override', '2024-12-29 03:09:23'),
+('parsing the application won''t do anything, we need to transmit the back-end SAS bandwidth!', 'microchip-program', '2024-12-29 07:45:51'),
+('We need to copy the cross-platform XML matrix!', 'transmitter-program', '2024-12-29 18:29:50'),
+('Try to index the OCR bus, maybe it will quantify the open-source alarm!', 'feed-bypass', '2024-12-30 05:55:34'),
+('copying the monitor won''t do anything, we need to connect the haptic HDD system!', 'driver-navigate', '2024-12-30 16:04:50'),
+('Use the mobile API protocol, then you can bypass the virtual driver!', 'sensor-calculate', '2024-12-30 20:51:32'),
+('If we index the sensor, we can get to the XML bandwidth through the 1080p HTTP pixel!', 'array-override', '2024-12-31 04:20:47'),
+('The JSON port is down, input the back-end firewall so we can reboot the PNG program!', 'hard-drive-back-up', '2024-12-31 11:22:54'),
+('The TCP sensor is down, generate the virtual protocol so we can generate the SMS panel!', 'matrix-synthesize', '2025-01-01 06:25:07'),
+('The UTF8 bus is down, input the mobile monitor so we can compress the RAM monitor!', 'circuit-parse', '2025-01-01 08:26:16'),
+('Use the virtual OCR matrix, then you can compress the digital program!', 'port-synthesize', '2025-01-01 16:20:19'),
+('Use the auxiliary XML feed, then you can connect the primary monitor!', 'card-override', '2025-01-01 20:49:58'),
+('synthesizing the microchip won''t do anything, we need to synthesize the redundant HTTP application!', 'pixel-quantify', '2025-01-02 01:49:16'),
+('We need to back up the mobile USB interface!', 'capacitor-override', '2025-01-02 05:32:22'),
+('hacking the protocol won''t do anything, we need to connect the optical PNG array!', 'array-compress', '2025-01-02 09:01:44'),
+('hacking the sensor won''t do anything, we need to program the open-source THX hard drive!', 'array-transmit', '2025-01-02 09:43:02'),
+('You can''t index the panel without indexing the solid state ADP bus!', 'alarm-copy', '2025-01-02 20:34:00'),
+('The PCI hard drive is down, navigate the solid state array so we can parse the SMTP program!', 'bandwidth-calculate', '2025-01-02 20:48:34'),
+('Try to copy the ASCII card, maybe it will parse the bluetooth microchip!', 'driver-hack', '2025-01-03 03:58:28'),
+('navigating the system won''t do anything, we need to back up the haptic SDD microchip!', 'system-navigate', '2025-01-03 04:26:48'),
+('You can''t reboot the panel without hacking the auxiliary ASCII panel!', 'array-back-up', '2025-01-03 09:57:14'),
+('You can''t reboot the interface without hacking the 1080p XML microchip!', 'system-generate', '2025-01-03 18:44:51'),
+('Try to copy the HEX port, maybe it will connect the wireless program!', 'circuit-copy', '2025-01-04 08:31:05'),
+('transmitting the capacitor won''t do anything, we need to program the haptic JBOD driver!', 'panel-hack', '2025-01-04 14:48:27'),
+('You can''t index the array without calculating the back-end VGA microchip!', 'monitor-navigate', '2025-01-04 21:20:50'),
+('The SQL transmitter is down, compress the wireless system so we can quantify the PNG card!', 'sensor-synthesize', '2025-01-05 03:19:58'),
+('Use the 1080p DRAM bandwidth, then you can index the optical interface!', 'microchip-copy', '2025-01-05 09:56:29'),
+('If we calculate the program, we can get to the IP array through the multi-byte COM transmitter!', 'interface-navigate', '2025-01-05 19:06:46'),
+('You can''t bypass the alarm without calculating the auxiliary UDP monitor!', 'port-compress', '2025-01-06 07:20:51'),
+('Try to input the FTP feed, maybe it will override the auxiliary panel!', 'driver-connect', '2025-01-06 07:34:18'),
+('compressing the application won''t do anything, we need to synthesize the digital VGA port!', 'protocol-quantify', '2025-01-06 08:07:54'),
+('I''ll quantify the redundant TLS matrix, that should capacitor the USB pixel!', 'card-back-up', '2025-01-06 16:24:50'),
+('copying the monitor won''t do anything, we need to parse the online HDD port!', 'interface-reboot', '2025-01-06 23:37:56'),
+('Try to compress the IP pixel, maybe it will hack the optical feed!', 'bus-compress', '2025-01-07 03:13:53'),
+('You can''t back up the microchip without copying the digital SMS firewall!', 'port-back-up', '2025-01-07 19:00:06'),
+('compressing the alarm won''t do anything, we need to calculate the mobile JBOD card!', 'interface-navigate', '2025-01-08 00:25:32'),
+('We need to back up the bluetooth API hard drive!', 'application-back-up', '2025-01-08 03:02:13'),
+('Try to transmit the SQL hard drive, maybe it will copy the bluetooth monitor!', 'capacitor-quantify', '2025-01-08 12:01:29'),
+('Try to navigate the SDD card, maybe it will compress the back-end feed!', 'bus-hack', '2025-01-09 09:20:52'),
+('Try to generate the COM feed, maybe it will index the wireless bus!', 'port-connect', '2025-01-09 10:01:53'),
+('Use the haptic SDD sensor, then you can reboot the redundant system!', 'pixel-hack', '2025-01-09 12:46:13'),
+('Use the haptic SSD driver, then you can input the solid state system!', 'pixel-navigate', '2025-01-10 12:49:53'),
+('If we hack the driver, we can get to the RSS panel through the virtual UDP interface!', 'monitor-input', '2025-01-10 14:09:47'),
+('If we reboot the driver, we can get to the HEX capacitor through the solid state DRAM feed!', 'program-override', '2025-01-10 15:15:25'),
+('connecting the pixel won''t do anything, we need to hack the mobile TCP pixel!', 'program-compress', '2025-01-10 15:19:15'),
+('Use the primary SAS driver, then you can back up the virtual matrix!', 'transmitter-hack', '2025-01-10 22:01:23'),
+('programming the matrix won''t do anything, we need to input the bluetooth GB circuit!', 'card-program', '2025-01-11 03:32:20'),
+('Try to input the XML application, maybe it will generate the online port!', 'card-input', '2025-01-11 07:29:18'),
+('Try to transmit the SDD application, maybe it will copy the neural port!', 'port-connect', '2025-01-11 09:10:45'),
+('indexing the bus won''t do anything, we need to synthesize the 1080p HTTP alarm!', 'hard-drive-generate', '2025-01-11 12:01:58'),
+('Try to quantify the SSD bus, maybe it will reboot the multi-byte alarm!', 'microchip-reboot', '2025-01-11 17:30:18'),
+('I''ll reboot the back-end GB port, that should bandwidth the GB interface!', 'firewall-navigate', '2025-01-11 20:15:34'),
+('Use the 1080p USB sensor, then you can program the neural matrix!', 'monitor-calculate', '2025-01-12 02:03:10'),
+('Try to connect the SSD microchip, maybe it will back up the solid state bandwidth!', 'panel-override', '2025-01-12 09:18:16'),
+('I''ll compress the solid state FTP system, that should matrix the PNG capacitor!', 'pixel-synthesize', '2025-01-12 22:04:07'),
+('If we reboot the interface, we can get to the AGP application through the online GB circuit!', 'protocol-transmit', '2025-01-13 05:59:39'),
+('You can''t transmit the pixel without bypassing the mobile EXE panel!', 'program-quantify', '2025-01-13 09:40:36'),
+('We need to transmit the digital AI sensor!', 'system-calculate', '2025-01-13 16:10:29'),
+('I''ll generate the mobile SSL driver, that should alarm the UTF8 protocol!', 'transmitter-parse', '2025-01-14 03:44:36'),
+('You can''t override the sensor without backing up the optical SCSI matrix!', 'panel-connect', '2025-01-14 04:19:10'),
+('If we reboot the array, we can get to the IB feed through the cross-platform CSS interface!', 'firewall-program', '2025-01-14 17:25:05'),
+('Use the haptic HTTP program, then you can parse the wireless array!', 'system-reboot', '2025-01-15 12:47:10'),
+('Use the mobile VGA circuit, then you can quantify the wireless bandwidth!', 'pixel-transmit', '2025-01-15 13:17:55'),
+('We need to index the bluetooth RAM protocol!', 'array-program', '2025-01-15 22:21:57'),
+('The UDP alarm is down, parse the solid state sensor so we can program the RAM interface!', 'panel-generate', '2025-01-16 00:05:41'),
+('Use the multi-byte FTP alarm, then you can generate the optical panel!', 'microchip-reboot', '2025-01-16 00:49:08'),
+('hacking the protocol won''t do anything, we need to synthesize the open-source AGP circuit!', 'bandwidth-quantify', '2025-01-16 02:56:08'),
+('You can''t calculate the firewall without generating the virtual CSS system!', 'capacitor-hack', '2025-01-16 15:38:43'),
+('I''ll back up the multi-byte COM protocol, that should system the API system!', 'circuit-quantify', '2025-01-17 04:14:43'),
+('We need to back up the mobile SDD card!', 'system-synthesize', '2025-01-17 05:52:29'),
+('If we copy the system, we can get to the HDD microchip through the neural COM driver!', 'array-reboot', '2025-01-17 15:26:41'),
+('Try to calculate the RAM circuit, maybe it will override the optical matrix!', 'panel-navigate', '2025-01-18 07:12:22'),
+('You can''t bypass the card without overriding the primary EXE system!', 'monitor-input', '2025-01-18 13:45:13'),
+('We need to reboot the redundant VGA port!', 'card-input', '2025-01-19 10:38:55'),
+('The SDD circuit is down, copy the optical driver so we can calculate the PCI application!', 'feed-reboot', '2025-01-19 11:08:16'),
+('I''ll synthesize the open-source HTTP bandwidth, that should pixel the USB matrix!', 'panel-bypass', '2025-01-19 22:23:50'),
+('The GB array is down, override the cross-platform array so we can calculate the RAM pixel!', 'feed-bypass', '2025-01-19 23:15:44'),
+('We need to program the neural HDD firewall!', 'circuit-generate', '2025-01-20 00:58:06'),
+('You can''t transmit the pixel without programming the solid state AI array!', 'application-generate', '2025-01-20 01:04:46'),
+('If we calculate the system, we can get to the SSL monitor through the redundant EXE bandwidth!', 'port-reboot', '2025-01-20 02:16:33'),
+('transmitting the port won''t do anything, we need to program the neural UDP transmitter!', 'sensor-connect', '2025-01-20 18:47:29'),
+('The SQL matrix is down, parse the digital array so we can connect the PNG application!', 'monitor-override', '2025-01-20 22:09:14'),
+('Try to bypass the IB capacitor, maybe it will connect the online interface!', 'pixel-bypass', '2025-01-21 01:11:22'),
+('quantifying the firewall won''t do anything, we need to bypass the auxiliary EXE array!', 'feed-generate', '2025-01-21 06:34:39'),
+('I''ll calculate the open-source HDD transmitter, that should firewall the TCP port!', 'program-reboot', '2025-01-21 17:43:05'),
+('I''ll synthesize the back-end ADP capacitor, that should feed the GB hard drive!', 'driver-navigate', '2025-01-21 19:46:11'),
+('If we generate the interface, we can get to the JSON port through the open-source PNG protocol!', 'sensor-back-up', '2025-01-22 02:17:05'),
+('Try to hack the SSL sensor, maybe it will connect the back-end program!', 'alarm-transmit', '2025-01-22 03:46:14'),
+('Use the optical USB bus, then you can reboot the open-source driver!', 'matrix-connect', '2025-01-22 14:10:03'),
+('The AGP array is down, synthesize the virtual driver so we can program the VGA firewall!', 'feed-compress', '2025-01-22 22:59:44'),
+('You can''t back up the array without connecting the bluetooth DNS alarm!', 'hard-drive-back-up', '2025-01-23 15:43:40'),
+('I''ll calculate the online OCR circuit, that should interface the UDP protocol!', 'driver-index', '2025-01-23 16:07:34'),
+('generating the system won''t do anything, we need to synthesize the neural AI firewall!', 'pixel-hack', '2025-01-24 08:00:00'),
+('indexing the transmitter won''t do anything, we need to reboot the virtual IB interface!', 'protocol-hack', '2025-01-24 14:11:56'),
+('Try to synthesize the FTP pixel, maybe it will calculate the haptic matrix!', 'card-transmit', '2025-01-24 21:30:27'),
+('copying the application won''t do anything, we need to hack the haptic PNG circuit!', 'array-reboot', '2025-01-25 05:40:04'),
+('navigating the matrix won''t do anything, we need to bypass the cross-platform AGP feed!', 'application-quantify', '2025-01-25 05:41:54'),
+('I''ll index the solid state XML bus, that should pixel the PNG array!', 'matrix-calculate', '2025-01-25 07:22:28'),
+('I''ll index the auxiliary EXE transmitter, that should capacitor the RAM firewall!', 'bandwidth-generate', '2025-01-26 19:30:24'),
+('You can''t navigate the system without bypassing the multi-byte CLI bandwidth!', 'transmitter-override', '2025-01-26 20:31:02'),
+('You can''t hack the interface without parsing the neural PCI transmitter!', 'firewall-calculate', '2025-01-27 04:57:32'),
+('The OCR pixel is down, compress the digital port so we can copy the TCP transmitter!', 'protocol-connect', '2025-01-27 19:45:17'),
+('You can''t copy the protocol without programming the online HDD application!', 'hard-drive-hack', '2025-01-27 22:21:12'),
+('compressing the matrix won''t do anything, we need to calculate the primary SQL microchip!', 'interface-navigate', '2025-01-28 12:19:01'),
+('Use the open-source ADP circuit, then you can back up the primary matrix!', 'pixel-generate', '2025-01-28 23:00:46'),
+('generating the matrix won''t do anything, we need to bypass the auxiliary CSS hard drive!', 'protocol-copy', '2025-01-29 06:30:10'),
+('I''ll copy the 1080p FTP protocol, that should sensor the XSS panel!', 'capacitor-copy', '2025-01-29 22:05:07'),
+('We need to generate the back-end EXE transmitter!', 'transmitter-hack', '2025-01-30 15:35:30'),
+('We need to bypass the back-end HTTP transmitter!', 'sensor-generate', '2025-01-30 20:25:42'),
+('I''ll parse the bluetooth FTP card, that should bus the SCSI firewall!', 'firewall-reboot', '2025-01-31 06:59:08'),
+('If we parse the monitor, we can get to the XML bus through the digital XML panel!', 'transmitter-synthesize', '2025-02-01 08:09:36'),
+('Try to synthesize the DRAM protocol, maybe it will override the online sensor!', 'alarm-back-up', '2025-02-01 15:58:36'),
+('If we compress the interface, we can get to the IB interface through the virtual ASCII bus!', 'driver-navigate', '2025-02-01 20:03:37'),
+('Use the solid state COM card, then you can parse the 1080p matrix!', 'matrix-compress', '2025-02-04 11:04:31'),
+('Use the wireless EXE driver, then you can index the open-source bandwidth!', 'bus-bypass', '2025-02-04 16:19:08'),
+('Try to input the HTTP panel, maybe it will bypass the 1080p sensor!', 'sensor-navigate', '2025-02-05 09:29:14'),
+('transmitting the driver won''t do anything, we need to connect the redundant SSD firewall!', 'protocol-program', '2025-02-05 09:43:06'),
+('You can''t parse the program without programming the primary IB bandwidth!', 'hard-drive-copy', '2025-02-05 17:19:29'),
+('If we synthesize the monitor, we can get to the XML firewall through the open-source GB hard drive!', 'panel-generate', '2025-02-06 02:50:30'),
+('If we copy the firewall, we can get to the EXE interface through the optical ADP interface!', 'microchip-synthesize', '2025-02-06 05:34:57'),
+('I''ll generate the optical OCR bandwidth, that should port the HTTP program!', 'interface-calculate', '2025-02-06 11:44:04'),
+('Try to calculate the SCSI application, maybe it will back up the optical panel!', 'system-parse', '2025-02-06 15:23:14'),
+('The UDP panel is down, program the cross-platform interface so we can generate the SSL driver!', 'capacitor-transmit', '2025-02-06 18:25:34'),
+('The IB hard drive is down, input the cross-platform panel so we can parse the SCSI hard drive!', 'monitor-reboot', '2025-02-07 00:22:32'),
+('generating the interface won''t do anything, we need to program the solid state HDD firewall!', 'monitor-connect', '2025-02-07 08:32:29'),
+('We need to copy the solid state XSS alarm!', 'alarm-transmit', '2025-02-07 11:10:24'),
+('We need to synthesize the multi-byte COM sensor!', 'sensor-connect', '2025-02-07 19:11:00'),
+('If we navigate the application, we can get to the HTTP matrix through the mobile AGP interface!', 'monitor-program', '2025-02-07 23:15:45'),
+('The API transmitter is down, input the neural bandwidth so we can override the THX bandwidth!', 'array-back-up', '2025-02-08 00:34:42'),
+('If we hack the matrix, we can get to the ASCII feed through the wireless AGP interface!', 'port-parse', '2025-02-08 17:28:43'),
+('You can''t quantify the bandwidth without indexing the optical XML array!', 'interface-parse', '2025-02-08 23:23:12'),
+('backing up the circuit won''t do anything, we need to connect the redundant JSON transmitter!', 'system-hack', '2025-02-09 00:32:08'),
+('We need to compress the wireless RAM protocol!', 'firewall-transmit', '2025-02-09 17:12:29'),
+('We need to generate the virtual IP panel!', 'hard-drive-reboot', '2025-02-10 00:47:29'),
+('You can''t bypass the microchip without generating the wireless ADP matrix!', 'hard-drive-navigate', '2025-02-10 02:07:31'),
+('If we connect the array, we can get to the XML array through the primary SMS pixel!', 'program-connect', '2025-02-10 06:01:02'),
+('You can''t back up the port without navigating the auxiliary SSL application!', 'port-hack', '2025-02-10 09:55:38'),
+('I''ll generate the solid state COM system, that should interface the THX protocol!', 'pixel-parse', '2025-02-10 21:30:34'),
+('indexing the application won''t do anything, we need to back up the primary CSS pixel!', 'feed-compress', '2025-02-11 01:12:24'),
+('Use the primary TLS interface, then you can input the mobile capacitor!', 'circuit-parse', '2025-02-11 02:44:18'),
+('The CLI capacitor is down, generate the cross-platform protocol so we can compress the JSON program!', 'feed-bypass', '2025-02-11 07:22:59'),
+('We need to program the 1080p CLI program!', 'array-quantify', '2025-02-11 07:48:04'),
+('We need to calculate the primary XSS port!', 'firewall-program', '2025-02-11 10:44:50'),
+('If we index the protocol, we can get to the SQL protocol through the solid state IB port!', 'firewall-hack', '2025-02-11 16:10:02'),
+('If we copy the firewall, we can get to the IP sensor through the back-end XML capacitor!', 'monitor-transmit', '2025-02-11 20:07:36'),
+('You can''t transmit the alarm without transmitting the primary HTTP protocol!', 'interface-synthesize', '2025-02-12 10:27:22'),
+('Use the multi-byte API array, then you can hack the bluetooth application!', 'microchip-compress', '2025-02-13 02:38:26'),
+('hacking the hard drive won''t do anything, we need to synthesize the multi-byte ADP card!', 'interface-generate', '2025-02-13 09:11:07'),
+('I''ll bypass the solid state PCI circuit, that should bandwidth the FTP port!', 'transmitter-bypass', '2025-02-13 09:49:52'),
+('We need to quantify the mobile API sensor!', 'transmitter-calculate', '2025-02-13 23:17:30'),
+('We need to quantify the redundant THX sensor!', 'capacitor-navigate', '2025-02-14 22:55:33'),
+('If we input the firewall, we can get to the RSS hard drive through the cross-platform COM program!', 'circuit-synthesize', '2025-02-15 02:02:32'),
+('I''ll bypass the solid state JSON circuit, that should array the SAS bandwidth!', 'application-connect', '2025-02-15 04:50:37'),
+('I''ll compress the mobile RSS port, that should capacitor the TCP firewall!', 'monitor-quantify', '2025-02-15 06:55:23'),
+('We need to input the solid state FTP sensor!', 'driver-input', '2025-02-15 13:49:14'),
+('overriding the circuit won''t do anything, we need to compress the redundant TLS monitor!', 'program-quantify', '2025-02-15 22:31:29'),
+('We need to connect the online GB microchip!', 'microchip-transmit', '2025-02-16 00:16:07'),
+('Use the haptic HDD interface, then you can synthesize the bluetooth feed!', 'alarm-synthesize', '2025-02-16 01:03:09'),
+('Use the multi-byte OCR application, then you can input the virtual alarm!', 'port-compress', '2025-02-16 02:25:11'),
+('We need to transmit the haptic XSS alarm!', 'protocol-override', '2025-02-16 02:39:01'),
+('Use the primary TLS firewall, then you can calculate the primary circuit!', 'feed-hack', '2025-02-16 14:02:37'),
+('connecting the port won''t do anything, we need to bypass the wireless JSON program!', 'capacitor-synthesize', '2025-02-17 11:00:20'),
+('The UDP application is down, connect the neural panel so we can transmit the DNS system!', 'microchip-synthesize', '2025-02-17 13:42:40'),
+('Use the multi-byte UDP program, then you can program the auxiliary monitor!', 'microchip-index', '2025-02-17 23:05:57'),
+('Try to transmit the RAM interface, maybe it will synthesize the online circuit!', 'circuit-transmit', '2025-02-18 11:18:31'),
+('calculating the alarm won''t do anything, we need to override the primary UDP hard drive!', 'panel-generate', '2025-02-18 12:52:05'),
+('If we input the interface, we can get to the SMS transmitter through the wireless OCR circuit!', 'application-reboot', '2025-02-18 23:13:42'),
+('The UTF8 circuit is down, reboot the optical application so we can compress the VGA monitor!', 'card-navigate', '2025-02-19 03:01:34'),
+('We need to calculate the cross-platform SMS firewall!', 'microchip-back-up', '2025-02-20 04:21:56'),
+('You can''t transmit the system without indexing the bluetooth JSON protocol!', 'protocol-hack', '2025-02-20 12:38:56'),
+('Use the cross-platform ASCII program, then you can copy the virtual port!', 'matrix-program', '2025-02-20 13:55:20'),
+('Try to generate the UTF8 sensor, maybe it will override the wireless array!', 'pixel-back-up', '2025-02-20 17:35:24'),
+('Use the virtual COM firewall, then you can program the multi-byte driver!', 'monitor-navigate', '2025-02-21 06:45:25'),
+('I''ll back up the auxiliary FTP microchip, that should monitor the RSS matrix!', 'circuit-generate', '2025-02-21 11:05:51'),
+('You can''t synthesize the pixel without copying the wireless API interface!', 'alarm-generate', '2025-02-21 22:09:34'),
+('overriding the driver won''t do anything, we need to back up the multi-byte AGP card!', 'interface-override', '2025-02-22 12:52:21'),
+('hacking the monitor won''t do anything, we need to copy the virtual SDD interface!', 'bandwidth-transmit', '2025-02-23 10:43:09'),
+('If we hack the bandwidth, we can get to the TLS array through the open-source DRAM program!', 'alarm-transmit', '2025-02-23 19:25:27'),
+('You can''t generate the driver without navigating the virtual PNG panel!', 'program-input', '2025-02-24 05:09:47'),
+('If we quantify the system, we can get to the SSD bandwidth through the 1080p SSL port!', 'microchip-program', '2025-02-25 08:47:03'),
+('Try to transmit the ASCII alarm, maybe it will back up the virtual program!', 'hard-drive-synthesize', '2025-02-26 02:13:41'),
+('Try to program the UTF8 pixel, maybe it will quantify the multi-byte sensor!', 'circuit-transmit', '2025-02-26 04:43:24'),
+('You can''t back up the array without compressing the neural DNS system!', 'hard-drive-quantify', '2025-02-26 05:50:35'),
+('compressing the program won''t do anything, we need to reboot the neural RSS application!', 'bandwidth-program', '2025-02-26 09:09:17'),
+('We need to copy the primary EXE pixel!', 'card-connect', '2025-02-26 11:31:57'),
+('Try to input the PCI port, maybe it will generate the optical sensor!', 'circuit-transmit', '2025-02-26 19:11:04'),
+('I''ll hack the back-end AGP driver, that should card the HTTP bus!', 'bandwidth-navigate', '2025-02-26 21:51:56'),
+('synthesizing the feed won''t do anything, we need to reboot the cross-platform XSS program!', 'driver-compress', '2025-02-26 22:12:16'),
+('You can''t calculate the bandwidth without parsing the neural SMS array!', 'program-connect', '2025-02-27 10:57:38'),
+('We need to copy the digital JBOD monitor!', 'capacitor-program', '2025-02-27 13:57:55'),
+('We need to back up the open-source SDD monitor!', 'bandwidth-navigate', '2025-02-27 20:44:23'),
+('We need to index the solid state ASCII program!', 'hard-drive-connect', '2025-02-28 08:11:51'),
+('I''ll copy the auxiliary SMS pixel, that should driver the ADP monitor!', 'interface-navigate', '2025-02-28 11:23:48'),
+('overriding the transmitter won''t do anything, we need to transmit the neural USB alarm!', 'array-transmit', '2025-02-28 11:42:31'),
+('If we index the port, we can get to the XSS feed through the redundant TLS hard drive!', 'alarm-bypass', '2025-02-28 15:57:19'),
+('The SSD application is down, compress the 1080p card so we can copy the ADP array!', 'bus-generate', '2025-02-28 19:21:33'),
+('I''ll copy the online UDP transmitter, that should panel the SSD application!', 'protocol-connect', '2025-03-01 08:26:56'),
+('I''ll override the online SMTP hard drive, that should matrix the SQL matrix!', 'alarm-index', '2025-03-02 08:54:53'),
+('Use the solid state EXE circuit, then you can bypass the 1080p alarm!', 'protocol-parse', '2025-03-02 16:38:55'),
+('Try to parse the AGP circuit, maybe it will navigate the bluetooth pixel!', 'bandwidth-generate', '2025-03-03 00:24:31'),
+('I''ll navigate the multi-byte THX card, that should firewall the USB bus!', 'port-generate', '2025-03-03 10:42:49'),
+('I''ll override the wireless API microchip, that should capacitor the API capacitor!', 'monitor-calculate', '2025-03-03 22:28:23'),
+('We need to program the open-source API bus!', 'matrix-quantify', '2025-03-04 03:40:50'),
+('Try to index the AI alarm, maybe it will parse the multi-byte firewall!', 'circuit-quantify', '2025-03-04 04:27:59'),
+('Use the haptic CLI sensor, then you can index the auxiliary matrix!', 'feed-calculate', '2025-03-04 12:21:35'),
+('You can''t synthesize the monitor without calculating the auxiliary RSS matrix!', 'capacitor-parse', '2025-03-05 16:03:11'),
+('You can''t index the card without indexing the wireless VGA panel!', 'alarm-navigate', '2025-03-05 22:26:43'),
+('If we transmit the port, we can get to the THX port through the mobile SQL interface!', 'capacitor-parse', '2025-03-07 02:05:16'),
+('Use the 1080p EXE protocol, then you can quantify the back-end card!', 'sensor-input', '2025-03-07 06:33:45'),
+('transmitting the card won''t do anything, we need to synthesize the solid state DNS pixel!', 'feed-quantify', '2025-03-08 05:22:36'),
+('Use the solid state THX bus, then you can input the multi-byte pixel!', 'system-index', '2025-03-08 07:37:11'),
+('Use the 1080p GB sensor, then you can calculate the digital transmitter!', 'card-connect', '2025-03-09 05:10:09'),
+('You can''t parse the port without compressing the open-source HTTP capacitor!', 'interface-transmit', '2025-03-09 12:24:11'),
+('Use the wireless EXE bandwidth, then you can index the open-source firewall!', 'capacitor-synthesize', '2025-03-09 15:32:03'),
+('If we generate the interface, we can get to the EXE card through the open-source FTP monitor!', 'card-override', '2025-03-11 11:14:12'),
+('If we bypass the monitor, we can get to the AGP port through the 1080p COM firewall!', 'matrix-transmit', '2025-03-11 12:33:42'),
+('The USB application is down, connect the optical feed so we can hack the CLI port!', 'transmitter-parse', '2025-03-11 16:00:31'),
+('The GB array is down, navigate the open-source pixel so we can compress the FTP sensor!', 'feed-connect', '2025-03-11 21:13:18'),
+('You can''t connect the array without bypassing the virtual PNG hard drive!', 'card-program', '2025-03-12 15:30:01'),
+('You can''t back up the port without backing up the redundant HEX program!', 'alarm-hack', '2025-03-12 16:57:06'),
+('backing up the program won''t do anything, we need to navigate the back-end THX port!', 'application-back-up', '2025-03-12 17:36:23'),
+('connecting the interface won''t do anything, we need to program the wireless API application!', 'capacitor-index', '2025-03-12 17:38:50'),
+('You can''t bypass the firewall without overriding the optical SCSI matrix!', 'bandwidth-back-up', '2025-03-12 19:15:55'),
+('Use the optical ASCII panel, then you can parse the cross-platform port!', 'circuit-synthesize', '2025-03-13 05:08:58'),
+('We need to navigate the mobile CLI bus!', 'panel-copy', '2025-03-13 11:22:47'),
+('You can''t bypass the bus without bypassing the cross-platform ASCII transmitter!', 'driver-copy', '2025-03-13 18:49:51'),
+('You can''t reboot the transmitter without overriding the cross-platform SCSI pixel!', 'application-generate', '2025-03-13 20:23:05'),
+('The AI transmitter is down, hack the neural panel so we can hack the ASCII feed!', 'matrix-reboot', '2025-03-13 23:23:47'),
+('generating the port won''t do anything, we need to quantify the multi-byte API interface!', 'sensor-navigate', '2025-03-14 01:25:10'),
+('The HEX card is down, program the wireless program so we can parse the XML interface!', 'firewall-back-up', '2025-03-15 03:59:06'),
+('You can''t transmit the sensor without compressing the cross-platform TLS bus!', 'interface-quantify', '2025-03-15 10:22:12'),
+('The SCSI firewall is down, navigate the mobile hard drive so we can back up the CSS card!', 'pixel-synthesize', '2025-03-16 00:32:44'),
+('Try to hack the SCSI program, maybe it will synthesize the primary alarm!', 'interface-calculate', '2025-03-16 15:40:31'),
+('Try to bypass the OCR microchip, maybe it will program the auxiliary pixel!', 'panel-navigate', '2025-03-16 21:37:45'),
+('copying the panel won''t do anything, we need to reboot the haptic HDD hard drive!', 'driver-navigate', '2025-03-17 00:32:53'),
+('We need to program the solid state SDD firewall!', 'array-copy', '2025-03-17 12:56:25'),
+('calculating the system won''t do anything, we need to calculate the online SQL monitor!', 'hard-drive-override', '2025-03-18 14:04:11'),
+('The IP pixel is down, hack the optical monitor so we can copy the XSS capacitor!', 'program-back-up', '2025-03-18 14:05:19'),
+('I''ll copy the digital IP pixel, that should alarm the FTP alarm!', 'microchip-program', '2025-03-18 22:06:14'),
+('I''ll calculate the open-source SDD bandwidth, that should port the SMTP program!', 'firewall-program', '2025-03-20 17:25:06'),
+('I''ll copy the redundant HEX circuit, that should system the HDD bus!', 'protocol-index', '2025-03-21 12:16:41'),
+('We need to hack the cross-platform DRAM capacitor!', 'feed-compress', '2025-03-21 22:11:14'),
+('Use the haptic HEX feed, then you can connect the neural pixel!', 'interface-generate', '2025-03-22 02:04:25'),
+('We need to generate the bluetooth DNS feed!', 'panel-calculate', '2025-03-22 06:39:17'),
+('Try to override the GB firewall, maybe it will copy the auxiliary panel!', 'program-parse', '2025-03-22 14:07:32'),
+('You can''t back up the capacitor without compressing the primary XML card!', 'protocol-override', '2025-03-22 22:04:06'),
+('The SMS card is down, hack the neural capacitor so we can hack the THX array!', 'monitor-connect', '2025-03-22 23:35:55'),
+('You can''t generate the transmitter without calculating the online CLI firewall!', 'application-compress', '2025-03-23 15:25:53'),
+('The SCSI card is down, bypass the optical interface so we can bypass the DNS port!', 'bus-generate', '2025-03-24 01:00:04'),
+('I''ll transmit the digital USB circuit, that should monitor the OCR application!', 'matrix-override', '2025-03-24 03:11:51'),
+('You can''t reboot the hard drive without compressing the multi-byte SQL bus!', 'protocol-program', '2025-03-24 08:12:51'),
+('If we connect the bus, we can get to the THX sensor through the bluetooth EXE hard drive!', 'bandwidth-calculate', '2025-03-24 14:17:08'),
+('Try to reboot the PNG interface, maybe it will synthesize the open-source panel!', 'hard-drive-program', '2025-03-25 03:16:56'),
+('You can''t copy the program without synthesizing the back-end PNG interface!', 'matrix-parse', '2025-03-25 19:41:29'),
+('backing up the hard drive won''t do anything, we need to navigate the bluetooth SSL hard drive!', 'driver-synthesize', '2025-03-26 00:26:44'),
+('If we index the bandwidth, we can get to the PNG circuit through the virtual CLI transmitter!', 'bandwidth-transmit', '2025-03-26 14:59:14'),
+('The DRAM monitor is down, synthesize the virtual hard drive so we can index the USB alarm!', 'firewall-reboot', '2025-03-26 21:14:51'),
+('Use the wireless SSL bandwidth, then you can hack the wireless system!', 'transmitter-index', '2025-03-27 11:57:09'),
+('I''ll index the wireless TLS firewall, that should sensor the XSS capacitor!', 'circuit-program', '2025-03-27 21:03:38'),
+('I''ll hack the bluetooth RSS monitor, that should program the JBOD driver!', 'application-back-up', '2025-03-28 01:25:24'),
+('The CSS feed is down, hack the back-end program so we can parse the JSON driver!', 'circuit-override', '2025-03-28 03:05:11'),
+('You can''t bypass the bus without hacking the neural HTTP microchip!', 'bandwidth-reboot', '2025-03-28 19:40:51'),
+('We need to bypass the back-end SMS port!', 'card-override', '2025-03-28 23:42:22'),
+('The SAS monitor is down, index the haptic transmitter so we can override the SQL sensor!', 'interface-index', '2025-03-30 12:57:52'),
+('The RSS capacitor is down, copy the optical pixel so we can bypass the PNG port!', 'monitor-input', '2025-03-31 07:28:33'),
+('parsing the panel won''t do anything, we need to program the 1080p DRAM firewall!', 'panel-calculate', '2025-03-31 12:14:22'),
+('The PNG feed is down, synthesize the back-end monitor so we can input the ASCII driver!', 'port-connect', '2025-03-31 20:03:04'),
+('Try to input the VGA alarm, maybe it will parse the auxiliary monitor!', 'application-program', '2025-04-01 03:28:12'),
+('You can''t copy the feed without quantifying the mobile SQL pixel!', 'protocol-navigate', '2025-04-01 05:29:19'),
+('If we navigate the port, we can get to the VGA matrix through the open-source RSS alarm!', 'monitor-compress', '2025-04-01 08:51:43'),
+('You can''t parse the card without bypassing the mobile PNG panel!', 'interface-calculate', '2025-04-01 20:53:26'),
+('backing up the alarm won''t do anything, we need to reboot the redundant SMTP array!', 'system-calculate', '2025-04-02 00:47:18'),
+('Use the solid state AI alarm, then you can override the solid state capacitor!', 'bandwidth-back-up', '2025-04-02 03:05:25'),
+('If we input the bus, we can get to the ADP array through the optical SDD feed!', 'system-override', '2025-04-02 05:26:43'),
+('connecting the microchip won''t do anything, we need to generate the neural UDP driver!', 'protocol-back-up', '2025-04-02 07:14:26'),
+('You can''t compress the circuit without compressing the solid state RAM array!', 'matrix-transmit', '2025-04-02 09:36:12'),
+('Use the cross-platform SSD microchip, then you can index the bluetooth microchip!', 'card-copy', '2025-04-02 23:56:41'),
+('I''ll hack the open-source THX circuit, that should bus the IP card!', 'protocol-input', '2025-04-03 05:27:26'),
+('Use the 1080p SSL pixel, then you can parse the cross-platform bandwidth!', 'microchip-override', '2025-04-03 07:34:30'),
+('You can''t generate the application without compressing the solid state JSON protocol!', 'monitor-program', '2025-04-03 23:02:47'),
+('If we program the interface, we can get to the EXE microchip through the redundant SCSI feed!', 'microchip-index', '2025-04-04 02:16:02'),
+('Use the solid state HTTP panel, then you can program the virtual interface!', 'interface-transmit', '2025-04-04 18:27:21'),
+('If we quantify the transmitter, we can get to the THX monitor through the mobile PNG bandwidth!', 'bandwidth-transmit', '2025-04-05 01:41:38'),
+('Try to navigate the UDP protocol, maybe it will program the optical microchip!', 'driver-calculate', '2025-04-05 17:25:48'),
+('If we synthesize the feed, we can get to the JBOD bus through the redundant RAM bandwidth!', 'driver-override', '2025-04-06 01:01:00'),
+('You can''t connect the panel without transmitting the digital SDD sensor!', 'application-program', '2025-04-06 15:35:49'),
+('The GB feed is down, navigate the redundant driver so we can input the PCI program!', 'bus-generate', '2025-04-08 03:01:48'),
+('synthesizing the port won''t do anything, we need to calculate the redundant SQL port!', 'transmitter-program', '2025-04-08 20:18:28'),
+('Use the haptic SAS card, then you can transmit the primary application!', 'capacitor-generate', '2025-04-09 00:42:26'),
+('bypassing the bandwidth won''t do anything, we need to generate the 1080p SMTP panel!', 'feed-override', '2025-04-09 06:59:06'),
+('We need to program the redundant USB feed!', 'alarm-index', '2025-04-09 15:55:25'),
+('I''ll reboot the back-end JSON protocol, that should microchip the ADP port!', 'firewall-transmit', '2025-04-09 20:05:38'),
+('We need to index the digital SQL firewall!', 'matrix-bypass', '2025-04-11 04:22:21'),
+('I''ll quantify the haptic FTP system, that should circuit the HTTP microchip!', 'program-calculate', '2025-04-11 11:55:38'),
+('If we bypass the driver, we can get to the IB firewall through the primary AGP program!', 'bus-copy', '2025-04-12 01:27:01'),
+('Try to bypass the EXE protocol, maybe it will parse the auxiliary port!', 'microchip-copy', '2025-04-12 05:49:16'),
+('You can''t input the application without transmitting the bluetooth PNG port!', 'transmitter-parse', '2025-04-12 10:45:17'),
+('I''ll copy the open-source HEX bus, that should pixel the JSON bus!', 'panel-reboot', '2025-04-13 03:15:57'),
+('I''ll navigate the 1080p OCR feed, that should hard drive the ADP hard drive!', 'array-connect', '2025-04-13 03:19:17'),
+('I''ll connect the online RAM capacitor, that should bus the GB alarm!', 'program-transmit', '2025-04-13 09:48:13'),
+('navigating the bus won''t do anything, we need to navigate the digital SSD monitor!', 'bus-reboot', '2025-04-13 16:51:41'),
+('We need to back up the redundant HDD bus!', 'port-synthesize', '2025-04-14 05:43:57'),
+('compressing the application won''t do anything, we need to input the bluetooth SDD protocol!', 'array-hack', '2025-04-14 09:44:59'),
+('You can''t synthesize the transmitter without transmitting the back-end GB array!', 'interface-index', '2025-04-15 02:47:23'),
+('Use the primary CLI array, then you can reboot the digital card!', 'circuit-override', '2025-04-15 05:24:21'),
+('overriding the port won''t do anything, we need to parse the auxiliary AGP feed!', 'sensor-hack', '2025-04-16 02:29:18'),
+('You can''t program the port without generating the neural SSD application!', 'panel-parse', '2025-04-16 14:53:40'),
+('If we index the panel, we can get to the API circuit through the auxiliary RSS transmitter!', 'microchip-connect', '2025-04-17 01:53:18'),
+('Try to navigate the API program, maybe it will synthesize the open-source program!', 'array-override', '2025-04-17 16:15:29'),
+('Try to index the PNG monitor, maybe it will index the wireless interface!', 'port-back-up', '2025-04-17 17:20:33'),
+('I''ll back up the mobile GB port, that should program the UDP interface!', 'pixel-quantify', '2025-04-17 21:40:11'),
+('Try to index the TLS capacitor, maybe it will quantify the auxiliary matrix!', 'protocol-copy', '2025-04-18 09:13:18'),
+('You can''t parse the bus without compressing the virtual IP sensor!', 'alarm-back-up', '2025-04-18 10:28:41'),
+('If we program the array, we can get to the IP firewall through the auxiliary SAS transmitter!', 'program-quantify', '2025-04-18 18:19:31'),
+('We need to synthesize the haptic JBOD system!', 'circuit-synthesize', '2025-04-18 19:36:23'),
+('I''ll override the solid state PNG alarm, that should monitor the DRAM array!', 'feed-transmit', '2025-04-19 00:16:59'),
+('The IB pixel is down, reboot the mobile panel so we can transmit the IB interface!', 'card-navigate', '2025-04-19 02:39:32'),
+('overriding the interface won''t do anything, we need to bypass the redundant SSD firewall!', 'panel-hack', '2025-04-19 04:16:01'),
+('synthesizing the port won''t do anything, we need to navigate the wireless ASCII circuit!', 'feed-bypass', '2025-04-19 15:18:06'),
+('If we compress the interface, we can get to the AGP hard drive through the neural AI matrix!', 'bandwidth-input', '2025-04-19 16:19:06'),
+('The SQL matrix is down, navigate the open-source panel so we can input the EXE feed!', 'alarm-input', '2025-04-19 19:12:54'),
+('calculating the application won''t do anything, we need to parse the online USB port!', 'driver-bypass', '2025-04-19 19:57:55'),
+('copying the bus won''t do anything, we need to navigate the 1080p ADP array!', 'sensor-compress', '2025-04-20 04:56:06'),
+('Use the cross-platform OCR driver, then you can synthesize the back-end microchip!', 'driver-bypass', '2025-04-20 08:42:29'),
+('Use the redundant HDD transmitter, then you can reboot the bluetooth driver!', 'matrix-program', '2025-04-20 19:10:31'),
+('Use the back-end SCSI pixel, then you can copy the bluetooth driver!', 'card-bypass', '2025-04-20 20:27:27'),
+('Use the open-source EXE pixel, then you can calculate the cross-platform transmitter!', 'transmitter-copy', '2025-04-20 22:17:12'),
+('I''ll compress the 1080p HTTP card, that should protocol the AGP program!', 'capacitor-reboot', '2025-04-21 01:02:44'),
+('You can''t program the hard drive without generating the 1080p USB program!', 'bandwidth-compress', '2025-04-21 02:32:22'),
+('compressing the monitor won''t do anything, we need to override the primary ADP driver!', 'feed-index', '2025-04-21 06:51:51'),
+('I''ll navigate the 1080p ADP sensor, that should transmitter the UTF8 panel!', 'pixel-copy', '2025-04-21 16:03:01'),
+('bypassing the panel won''t do anything, we need to transmit the auxiliary SAS port!', 'hard-drive-compress', '2025-04-22 08:44:40'),
+('Use the digital SSD pixel, then you can parse the multi-byte bandwidth!', 'matrix-program', '2025-04-23 06:33:22'),
+('If we synthesize the bandwidth, we can get to the USB bus through the haptic PNG matrix!', 'hard-drive-reboot', '2025-04-24 09:43:02'),
+('Use the auxiliary SQL port, then you can copy the redundant protocol!', 'application-calculate', '2025-04-24 13:19:41'),
+('generating the system won''t do anything, we need to reboot the 1080p SAS bus!', 'alarm-back-up', '2025-04-25 06:59:54'),
+('We need to copy the mobile OCR hard drive!', 'program-back-up', '2025-04-26 06:18:40'),
+('quantifying the hard drive won''t do anything, we need to transmit the haptic SMTP circuit!', 'protocol-back-up', '2025-04-26 16:10:22'),
+('You can''t override the system without synthesizing the mobile DNS pixel!', 'pixel-back-up', '2025-04-26 18:47:19'),
+('If we calculate the monitor, we can get to the UTF8 panel through the wireless FTP array!', 'monitor-connect', '2025-04-26 21:58:55'),
+('You can''t quantify the hard drive without compressing the mobile SQL matrix!', 'bus-index', '2025-04-27 19:21:32'),
+('I''ll bypass the mobile TLS panel, that should microchip the VGA interface!', 'sensor-bypass', '2025-04-28 04:57:08'),
+('The IB panel is down, transmit the multi-byte monitor so we can calculate the SQL driver!', 'alarm-compress', '2025-04-29 07:32:14'),
+('We need to transmit the primary SAS firewall!', 'monitor-connect', '2025-04-29 14:06:35'),
+('You can''t parse the firewall without parsing the back-end XML pixel!', 'monitor-synthesize', '2025-04-29 23:59:23'),
+('calculating the system won''t do anything, we need to hack the 1080p GB feed!', 'array-generate', '2025-04-30 13:31:45'),
+('The TCP firewall is down, input the solid state program so we can transmit the PCI matrix!', 'port-back-up', '2025-04-30 14:04:02'),
+('If we quantify the matrix, we can get to the SSD system through the open-source DNS firewall!', 'matrix-compress', '2025-04-30 20:43:43'),
+('You can''t override the array without overriding the bluetooth AI sensor!', 'system-navigate', '2025-05-01 04:10:32'),
+('You can''t generate the matrix without indexing the neural CLI alarm!', 'program-quantify', '2025-05-02 17:31:20'),
+('The SDD capacitor is down, copy the auxiliary alarm so we can hack the UTF8 pixel!', 'application-calculate', '2025-05-03 02:25:30'),
+('You can''t hack the matrix without transmitting the mobile GB panel!', 'hard-drive-copy', '2025-05-03 10:56:16'),
+('Use the haptic IP capacitor, then you can generate the bluetooth system!', 'port-back-up', '2025-05-03 18:02:48'),
+('I''ll reboot the multi-byte UTF8 hard drive, that should hard drive the JSON card!', 'system-synthesize', '2025-05-03 22:33:01'),
+('backing up the microchip won''t do anything, we need to navigate the redundant UDP bandwidth!', 'bus-bypass', '2025-05-05 02:15:30'),
+('Try to program the HTTP sensor, maybe it will program the solid state hard drive!', 'port-parse', '2025-05-05 09:55:35'),
+('programming the bus won''t do anything, we need to override the optical RSS protocol!', 'matrix-quantify', '2025-05-05 12:51:05'),
+('The JSON driver is down, transmit the optical bus so we can index the SCSI pixel!', 'circuit-back-up', '2025-05-05 13:12:13'),
+('Use the 1080p HEX pixel, then you can parse the multi-byte alarm!', 'sensor-navigate', '2025-05-05 17:00:22'),
+('You can''t quantify the system without parsing the digital AI driver!', 'hard-drive-navigate', '2025-05-06 14:07:05'),
+('The TLS system is down, transmit the haptic system so we can generate the TCP matrix!', 'driver-connect', '2025-05-06 17:38:39'),
+('If we transmit the monitor, we can get to the SCSI monitor through the cross-platform AGP pixel!', 'sensor-calculate', '2025-05-06 23:17:29'),
+('The ADP protocol is down, synthesize the 1080p pixel so we can bypass the COM driver!', 'pixel-connect', '2025-05-07 09:13:03'),
+('We need to parse the multi-byte OCR port!', 'alarm-transmit', '2025-05-07 14:29:13'),
+('Use the auxiliary GB driver, then you can program the back-end pixel!', 'feed-parse', '2025-05-07 15:01:38'),
+('You can''t quantify the pixel without hacking the back-end API matrix!', 'card-transmit', '2025-05-07 21:04:21'),
+('We need to hack the redundant CSS feed!', 'program-program', '2025-05-07 21:26:54'),
+('You can''t override the card without navigating the optical ASCII interface!', 'alarm-copy', '2025-05-08 02:53:23'),
+('I''ll quantify the solid state DRAM card, that should system the PCI port!', 'circuit-back-up', '2025-05-08 08:44:52'),
+('Use the cross-platform IB application, then you can quantify the online bandwidth!', 'microchip-copy', '2025-05-08 08:51:57'),
+('I''ll synthesize the back-end PCI hard drive, that should array the IB application!', 'program-program', '2025-05-08 18:32:50'),
+('If we reboot the bandwidth, we can get to the SDD sensor through the bluetooth AGP driver!', 'microchip-navigate', '2025-05-08 20:31:24'),
+('The HEX driver is down, compress the virtual alarm so we can input the COM port!', 'matrix-navigate', '2025-05-09 03:23:28'),
+('You can''t program the hard drive without programming the virtual SAS panel!', 'feed-transmit', '2025-05-09 09:49:23'),
+('The GB transmitter is down, input the wireless interface so we can back up the RSS matrix!', 'program-reboot', '2025-05-09 17:03:26'),
+('Try to copy the JSON alarm, maybe it will navigate the optical sensor!', 'driver-reboot', '2025-05-09 17:53:39'),
+('navigating the circuit won''t do anything, we need to transmit the bluetooth SSL array!', 'array-calculate', '2025-05-09 23:57:51'),
+('The CSS transmitter is down, generate the digital microchip so we can transmit the SDD system!', 'panel-program', '2025-05-10 07:29:19'),
+('transmitting the protocol won''t do anything, we need to calculate the multi-byte SDD capacitor!', 'alarm-calculate', '2025-05-10 18:44:14'),
+('Use the online OCR firewall, then you can index the mobile protocol!', 'bus-navigate', '2025-05-11 00:47:48'),
+('Try to calculate the TLS pixel, maybe it will reboot the virtual circuit!', 'firewall-calculate', '2025-05-11 13:26:00'),
+('We need to connect the open-source AI pixel!', 'array-transmit', '2025-05-12 03:17:26'),
+('We need to transmit the wireless IP bus!', 'alarm-index', '2025-05-12 20:52:29'),
+('calculating the sensor won''t do anything, we need to program the online SMTP microchip!', 'system-generate', '2025-05-13 06:21:59'),
+('You can''t reboot the transmitter without copying the neural XSS firewall!', 'firewall-index', '2025-05-13 22:41:44'),
+('We need to program the multi-byte RAM matrix!', 'alarm-index', '2025-05-14 10:52:08'),
+('I''ll compress the redundant DRAM circuit, that should protocol the TLS hard drive!', 'system-input', '2025-05-14 20:58:11'),
+('You can''t bypass the sensor without bypassing the digital ADP protocol!', 'capacitor-copy', '2025-05-15 06:52:55'),
+('calculating the alarm won''t do anything, we need to generate the redundant USB feed!', 'transmitter-transmit', '2025-05-15 20:04:41'),
+('The FTP circuit is down, connect the online driver so we can input the UDP card!', 'monitor-copy', '2025-05-15 20:46:51'),
+('Try to connect the THX feed, maybe it will generate the virtual array!', 'card-bypass', '2025-05-15 20:47:32'),
+('You can''t connect the array without parsing the auxiliary HDD application!', 'program-input', '2025-05-15 23:16:28'),
+('If we override the panel, we can get to the VGA protocol through the wireless SMS transmitter!', 'driver-synthesize', '2025-05-16 05:14:51'),
+('If we parse the port, we can get to the XSS system through the mobile IP array!', 'system-override', '2025-05-16 19:32:59'),
+('I''ll copy the auxiliary HEX alarm, that should firewall the CLI hard drive!', 'driver-reboot', '2025-05-19 01:22:08'),
+('The AI bandwidth is down, calculate the haptic monitor so we can index the OCR port!', 'system-parse', '2025-05-19 13:25:44'),
+('Use the auxiliary SAS driver, then you can calculate the 1080p circuit!', 'feed-index', '2025-05-19 23:10:50'),
+('If we index the transmitter, we can get to the SQL firewall through the redundant SSD microchip!', 'panel-generate', '2025-05-20 04:49:18'),
+('If we synthesize the sensor, we can get to the GB system through the mobile UDP application!', 'port-program', '2025-05-20 10:14:00'),
+('We need to synthesize the open-source PCI pixel!', 'program-input', '2025-05-20 16:03:15'),
+('hacking the card won''t do anything, we need to calculate the neural FTP system!', 'sensor-calculate', '2025-05-20 17:12:15'),
+('The UTF8 bus is down, quantify the mobile system so we can override the RSS array!', 'card-synthesize', '2025-05-20 17:27:38'),
+('The HTTP program is down, generate the 1080p transmitter so we can reboot the CSS application!', 'capacitor-reboot', '2025-05-20 19:32:29'),
+('Use the 1080p PCI bus, then you can override the virtual system!', 'firewall-back-up', '2025-05-21 16:26:44'),
+('If we parse the hard drive, we can get to the AGP application through the solid state SAS bus!', 'firewall-program', '2025-05-21 20:21:31'),
+('Use the 1080p SSL panel, then you can connect the back-end sensor!', 'bandwidth-index', '2025-05-21 21:51:15'),
+('I''ll parse the cross-platform AI sensor, that should application the DRAM transmitter!', 'feed-index', '2025-05-22 06:53:28'),
+('If we navigate the capacitor, we can get to the SSD hard drive through the multi-byte HDD panel!', 'bandwidth-navigate', '2025-05-22 18:35:24'),
+('We need to transmit the multi-byte XML firewall!', 'transmitter-bypass', '2025-05-22 20:47:38'),
+('The SAS driver is down, navigate the redundant transmitter so we can hack the HDD matrix!', 'system-copy', '2025-05-24 12:19:57'),
+('The VGA array is down, connect the virtual port so we can compress the OCR array!', 'protocol-reboot', '2025-05-24 12:27:17'),
+('I''ll transmit the online HDD sensor, that should system the AGP protocol!', 'pixel-index', '2025-05-25 16:15:35'),
+('parsing the program won''t do anything, we need to calculate the optical SAS array!', 'pixel-index', '2025-05-25 23:21:08'),
+('Try to input the SQL firewall, maybe it will copy the bluetooth array!', 'monitor-quantify', '2025-05-26 00:23:13'),
+('We need to navigate the redundant ADP matrix!', 'panel-connect', '2025-05-26 11:44:15'),
+('connecting the panel won''t do anything, we need to quantify the redundant PNG alarm!', 'circuit-connect', '2025-05-27 07:37:38'),
+('We need to program the online UTF8 application!', 'alarm-input', '2025-05-27 10:02:50'),
+('backing up the hard drive won''t do anything, we need to copy the back-end GB matrix!', 'hard-drive-generate', '2025-05-28 01:24:33'),
+('If we navigate the hard drive, we can get to the VGA pixel through the virtual RAM sensor!', 'monitor-hack', '2025-05-28 05:39:14'),
+('If we parse the sensor, we can get to the CLI interface through the back-end USB driver!', 'firewall-reboot', '2025-05-28 06:43:25'),
+('The TCP capacitor is down, copy the bluetooth port so we can reboot the HEX port!', 'system-quantify', '2025-05-28 17:57:15'),
+('We need to copy the auxiliary HEX microchip!', 'bandwidth-calculate', '2025-05-28 22:00:54'),
+('We need to compress the online AI card!', 'circuit-connect', '2025-05-29 05:22:04'),
+('We need to index the haptic PCI sensor!', 'array-calculate', '2025-05-29 06:41:19'),
+('You can''t input the firewall without copying the optical SMS transmitter!', 'circuit-calculate', '2025-05-29 18:33:38'),
+('If we index the feed, we can get to the DNS bus through the open-source PCI protocol!', 'panel-calculate', '2025-05-30 10:32:19'),
+('If we back up the application, we can get to the SAS alarm through the digital PNG sensor!', 'application-quantify', '2025-05-30 12:11:55'),
+('If we transmit the bus, we can get to the SSD sensor through the bluetooth SSD hard drive!', 'application-synthesize', '2025-05-30 12:55:34'),
+('Use the haptic AGP pixel, then you can transmit the virtual feed!', 'hard-drive-navigate', '2025-05-31 09:56:25'),
+('We need to generate the primary SQL bus!', 'hard-drive-compress', '2025-05-31 09:59:24'),
+('programming the feed won''t do anything, we need to navigate the wireless SSD matrix!', 'bandwidth-navigate', '2025-06-01 02:32:10'),
+('Try to generate the DNS array, maybe it will synthesize the open-source interface!', 'hard-drive-bypass', '2025-06-01 03:08:34'),
+('You can''t connect the feed without backing up the digital SDD card!', 'card-program', '2025-06-01 03:30:43'),
+('Try to transmit the SSD panel, maybe it will copy the mobile bus!', 'port-generate', '2025-06-01 03:49:42'),
+('The THX bandwidth is down, back up the wireless protocol so we can parse the SCSI sensor!', 'matrix-parse', '2025-06-01 18:42:53'),
+('If we override the transmitter, we can get to the TLS transmitter through the bluetooth SAS card!', 'array-copy', '2025-06-01 23:55:05'),
+('The PCI card is down, reboot the solid state application so we can program the UDP bandwidth!', 'microchip-copy', '2025-06-02 00:44:11'),
+('You can''t calculate the application without programming the 1080p SQL array!', 'bus-index', '2025-06-02 12:17:28'),
+('We need to bypass the wireless JBOD firewall!', 'pixel-hack', '2025-06-03 01:04:13'),
+('You can''t reboot the panel without backing up the wireless CLI sensor!', 'microchip-navigate', '2025-06-03 06:15:45'),
+('I''ll transmit the back-end API application, that should panel the IB monitor!', 'sensor-generate', '2025-06-03 22:09:21'),
+('If we calculate the hard drive, we can get to the UTF8 monitor through the auxiliary RAM program!', 'bus-compress', '2025-06-04 04:15:06'),
+('You can''t quantify the port without overriding the online TLS port!', 'monitor-calculate', '2025-06-04 14:05:24'),
+('Try to connect the COM monitor, maybe it will parse the solid state pixel!', 'alarm-copy', '2025-06-04 23:21:52'),
+('If we back up the firewall, we can get to the AI array through the solid state FTP protocol!', 'driver-compress', '2025-06-05 03:07:53'),
+('You can''t bypass the circuit without synthesizing the multi-byte ASCII alarm!', 'panel-synthesize', '2025-06-05 05:42:03'),
+('We need to back up the redundant XML alarm!', 'array-connect', '2025-06-05 06:44:11'),
+('The DRAM pixel is down, copy the digital interface so we can generate the TCP monitor!', 'transmitter-calculate', '2025-06-05 14:06:14'),
+('backing up the feed won''t do anything, we need to hack the neural AI pixel!', 'interface-input', '2025-06-05 16:37:23'),
+('We need to hack the digital GB panel!', 'port-calculate', '2025-06-05 20:09:05'),
+('The CSS hard drive is down, synthesize the wireless hard drive so we can calculate the COM pixel!', 'feed-override', '2025-06-06 17:18:55'),
+('bypassing the bus won''t do anything, we need to back up the online IP circuit!', 'port-bypass', '2025-06-07 14:01:05'),
+('The PCI capacitor is down, override the digital microchip so we can program the FTP hard drive!', 'protocol-transmit', '2025-06-07 19:33:16'),
+('If we copy the capacitor, we can get to the HDD bus through the online VGA interface!', 'program-input', '2025-06-09 17:44:49'),
+('Try to parse the XML application, maybe it will navigate the haptic array!', 'pixel-parse', '2025-06-09 18:32:58'),
+('indexing the array won''t do anything, we need to index the cross-platform TLS capacitor!', 'array-back-up', '2025-06-09 20:52:51'),
+('We need to hack the online SDD transmitter!', 'transmitter-index', '2025-06-10 01:57:31'),
+('Use the cross-platform CSS driver, then you can index the bluetooth driver!', 'bandwidth-connect', '2025-06-10 14:19:44'),
+('You can''t program the pixel without transmitting the redundant SMS interface!', 'feed-connect', '2025-06-10 22:31:33'),
+('The VGA card is down, quantify the back-end transmitter so we can index the PNG circuit!', 'alarm-calculate', '2025-06-11 03:25:39'),
+('You can''t calculate the circuit without navigating the open-source AI capacitor!', 'sensor-calculate', '2025-06-11 03:49:55'),
+('The USB microchip is down, quantify the open-source capacitor so we can hack the DRAM driver!', 'bandwidth-program', '2025-06-11 05:03:14'),
+('We need to calculate the optical SMS protocol!', 'panel-hack', '2025-06-11 05:43:12'),
+('Try to parse the USB system, maybe it will back up the 1080p circuit!', 'alarm-connect', '2025-06-11 13:14:21'),
+('Use the wireless UTF8 protocol, then you can calculate the back-end panel!', 'microchip-program', '2025-06-12 03:15:29'),
+('compressing the panel won''t do anything, we need to connect the open-source VGA interface!', 'feed-program', '2025-06-12 07:09:49'),
+('The ADP sensor is down, quantify the mobile port so we can program the HEX program!', 'interface-navigate', '2025-06-12 11:18:45'),
+('The THX transmitter is down, calculate the haptic monitor so we can back up the DRAM pixel!', 'circuit-navigate', '2025-06-13 18:35:21'),
+('Use the bluetooth USB protocol, then you can index the online pixel!', 'firewall-bypass', '2025-06-13 19:59:57'),
+('The HDD microchip is down, parse the 1080p port so we can calculate the XSS protocol!', 'feed-parse', '2025-06-13 23:24:12'),
+('You can''t copy the program without compressing the neural DNS system!', 'capacitor-override', '2025-06-14 14:26:44'),
+('synthesizing the interface won''t do anything, we need to override the primary TLS port!', 'protocol-synthesize', '2025-06-14 22:56:51'),
+('We need to transmit the haptic SSL sensor!', 'sensor-reboot', '2025-06-15 09:30:30'),
+('Try to parse the XML application, maybe it will copy the mobile bus!', 'microchip-reboot', '2025-06-15 21:12:26'),
+('The ASCII feed is down, input the mobile circuit so we can calculate the DRAM feed!', 'transmitter-bypass', '2025-06-16 06:50:54'),
+('You can''t back up the circuit without quantifying the primary COM bus!', 'transmitter-program', '2025-06-16 07:53:16'),
+('Try to calculate the PCI matrix, maybe it will connect the cross-platform system!', 'matrix-calculate', '2025-06-16 11:25:17'),
+('The VGA driver is down, back up the redundant monitor so we can index the JBOD hard drive!', 'microchip-connect', '2025-06-16 13:30:16'),
+('Use the redundant XML driver, then you can override the virtual array!', 'panel-copy', '2025-06-16 17:28:52'),
+('navigating the application won''t do anything, we need to input the haptic PCI system!', 'firewall-generate', '2025-06-16 20:50:14'),
+('The AI hard drive is down, input the 1080p panel so we can reboot the IB bandwidth!', 'hard-drive-program', '2025-06-16 22:06:36'),
+('synthesizing the matrix won''t do anything, we need to connect the online XML array!', 'port-synthesize', '2025-06-17 10:22:42'),
+('Use the neural SQL port, then you can bypass the virtual interface!', 'program-generate', '2025-06-18 18:33:19'),
+('If we bypass the bandwidth, we can get to the VGA sensor through the virtual API port!', 'matrix-compress', '2025-06-18 21:54:24'),
+('I''ll input the haptic SAS capacitor, that should feed the USB panel!', 'circuit-navigate', '2025-06-19 01:40:54'),
+('Use the mobile SSL card, then you can copy the 1080p port!', 'port-compress', '2025-06-19 07:05:03'),
+('We need to reboot the online JBOD hard drive!', 'alarm-back-up', '2025-06-19 13:04:25'),
+('I''ll reboot the wireless COM microchip, that should matrix the UTF8 system!', 'pixel-reboot', '2025-06-19 19:18:27'),
+('We need to synthesize the haptic SCSI sensor!', 'microchip-calculate', '2025-06-19 22:47:00'),
+('compressing the firewall won''t do anything, we need to synthesize the solid state GB circuit!', 'panel-override', '2025-06-20 03:21:50'),
+('overriding the circuit won''t do anything, we need to back up the solid state HTTP bus!', 'hard-drive-copy', '2025-06-20 08:04:41'),
+('We need to parse the online SMTP transmitter!', 'microchip-back-up', '2025-06-20 16:47:59'),
+('Use the 1080p JSON pixel, then you can override the auxiliary interface!', 'pixel-override', '2025-06-21 10:38:24'),
+('I''ll generate the cross-platform HTTP sensor, that should driver the SSD monitor!', 'monitor-hack', '2025-06-21 20:08:31'),
+('Use the 1080p JBOD hard drive, then you can connect the auxiliary protocol!', 'transmitter-navigate', '2025-06-22 04:56:30'),
+('If we parse the protocol, we can get to the UDP bandwidth through the online OCR feed!', 'panel-calculate', '2025-06-22 07:03:22'),
+('I''ll quantify the online SAS monitor, that should firewall the PCI port!', 'hard-drive-index', '2025-06-22 15:35:42'),
+('copying the bandwidth won''t do anything, we need to input the haptic TCP card!', 'matrix-transmit', '2025-06-22 18:06:05'),
+('If we copy the port, we can get to the EXE port through the haptic ADP bandwidth!', 'transmitter-connect', '2025-06-23 05:52:11'),
+('If we generate the transmitter, we can get to the HTTP capacitor through the back-end API bus!', 'port-connect', '2025-06-23 07:52:29'),
+('Use the mobile CSS microchip, then you can input the auxiliary sensor!', 'microchip-synthesize', '2025-06-23 16:54:19'),
+('Try to navigate the USB monitor, maybe it will generate the mobile pixel!', 'capacitor-index', '2025-06-23 17:57:03'),
+('overriding the panel won''t do anything, we need to override the bluetooth HTTP transmitter!', 'array-copy', '2025-06-24 02:30:09'),
+('Try to quantify the SCSI application, maybe it will index the open-source monitor!', 'driver-navigate', '2025-06-24 06:48:09'),
+('If we compress the sensor, we can get to the USB bandwidth through the mobile ADP protocol!', 'port-navigate', '2025-06-24 21:29:04'),
+('Use the haptic JSON matrix, then you can reboot the multi-byte bandwidth!', 'pixel-synthesize', '2025-06-25 07:28:39'),
+('Try to navigate the VGA firewall, maybe it will override the solid state program!', 'bandwidth-input', '2025-06-25 10:27:02'),
+('calculating the capacitor won''t do anything, we need to override the multi-byte PNG port!', 'array-reboot', '2025-06-25 14:29:46'),
+('I''ll quantify the online ADP sensor, that should microchip the SQL capacitor!', 'interface-connect', '2025-06-26 03:33:00'),
+('The SMS bandwidth is down, copy the back-end driver so we can back up the IB system!', 'array-bypass', '2025-06-26 08:45:25'),
+('We need to back up the bluetooth OCR sensor!', 'microchip-generate', '2025-06-27 00:02:54'),
+('I''ll program the digital GB feed, that should bandwidth the ADP array!', 'card-bypass', '2025-06-27 20:06:14'),
+('If we quantify the feed, we can get to the XML application through the wireless SMS system!', 'circuit-hack', '2025-06-28 02:20:10'),
+('The USB application is down, navigate the open-source pixel so we can navigate the SMTP matrix!', 'system-bypass', '2025-06-28 12:27:37'),
+('The JBOD interface is down, connect the digital capacitor so we can quantify the SAS port!', 'matrix-input', '2025-06-28 12:30:29'),
+('I''ll bypass the 1080p ADP array, that should alarm the CLI alarm!', 'pixel-transmit', '2025-06-28 16:50:10'),
+('Try to generate the SMTP feed, maybe it will parse the cross-platform microchip!', 'sensor-input', '2025-06-28 20:30:07'),
+('I''ll transmit the auxiliary RSS program, that should microchip the XML bus!', 'alarm-back-up', '2025-06-28 20:56:01'),
+('The JSON driver is down, compress the redundant card so we can compress the AI interface!', 'bandwidth-quantify', '2025-06-29 05:00:25'),
+('programming the panel won''t do anything, we need to calculate the auxiliary SMTP bus!', 'capacitor-connect', '2025-06-29 11:18:00'),
+('The SMS feed is down, transmit the optical panel so we can copy the EXE card!', 'transmitter-calculate', '2025-06-29 16:41:06'),
+('calculating the monitor won''t do anything, we need to navigate the open-source FTP microchip!', 'bus-hack', '2025-06-29 23:55:48'),
+('Try to transmit the AI pixel, maybe it will index the mobile bus!', 'protocol-reboot', '2025-06-30 00:51:16'),
+('Use the bluetooth JSON monitor, then you can calculate the optical protocol!', 'panel-quantify', '2025-07-01 03:45:05'),
+('programming the bus won''t do anything, we need to quantify the auxiliary XSS array!', 'feed-index', '2025-07-01 13:57:57'),
+('indexing the array won''t do anything, we need to quantify the primary ADP bus!', 'transmitter-calculate', '2025-07-01 14:18:41'),
+('If we synthesize the bus, we can get to the PCI driver through the solid state DRAM card!', 'sensor-reboot', '2025-07-02 06:04:08'),
+('We need to generate the solid state XML protocol!', 'monitor-synthesize', '2025-07-02 16:44:34'),
+('The ADP feed is down, quantify the primary circuit so we can input the HEX sensor!', 'bandwidth-parse', '2025-07-02 18:16:45'),
+('The ADP alarm is down, quantify the online firewall so we can connect the COM hard drive!', 'capacitor-index', '2025-07-02 20:54:30'),
+('If we bypass the bus, we can get to the RAM matrix through the cross-platform AI capacitor!', 'application-generate', '2025-07-03 01:26:41'),
+('We need to navigate the mobile OCR hard drive!', 'hard-drive-reboot', '2025-07-03 21:28:06'),
+('The SQL bus is down, calculate the haptic array so we can parse the XML bandwidth!', 'port-back-up', '2025-07-03 23:53:46'),
+('I''ll hack the solid state CSS protocol, that should bus the ASCII application!', 'feed-program', '2025-07-04 00:59:13'),
+('We need to quantify the mobile XSS microchip!', 'matrix-navigate', '2025-07-04 04:42:12'),
+('calculating the driver won''t do anything, we need to synthesize the solid state SQL monitor!', 'protocol-reboot', '2025-07-06 02:28:14'),
+('You can''t reboot the monitor without generating the neural PCI firewall!', 'port-parse', '2025-07-06 02:39:56'),
+('Use the optical TLS circuit, then you can index the online driver!', 'bus-compress', '2025-07-06 17:46:52'),
+('You can''t override the pixel without transmitting the haptic ASCII microchip!', 'bandwidth-reboot', '2025-07-06 18:11:36'),
+('programming the bus won''t do anything, we need to index the cross-platform SQL port!', 'transmitter-transmit', '2025-07-06 19:47:02'),
+('The RSS port is down, bypass the 1080p bandwidth so we can synthesize the CSS program!', 'hard-drive-index', '2025-07-06 23:43:59'),
+('The JSON firewall is down, reboot the multi-byte bus so we can synthesize the CLI transmitter!', 'firewall-copy', '2025-07-07 00:21:29'),
+('We need to compress the 1080p UTF8 pixel!', 'bus-generate', '2025-07-07 05:16:13'),
+('We need to parse the 1080p API panel!', 'program-connect', '2025-07-07 16:28:12'),
+('Try to connect the UDP protocol, maybe it will bypass the digital driver!', 'interface-program', '2025-07-07 17:03:54'),
+('We need to copy the mobile UDP sensor!', 'application-synthesize', '2025-07-07 20:53:18'),
+('The JSON panel is down, hack the optical microchip so we can input the SSD bandwidth!', 'bandwidth-back-up', '2025-07-08 02:23:40'),
+('I''ll navigate the 1080p TCP card, that should feed the TLS circuit!', 'application-quantify', '2025-07-08 02:44:38'),
+('The IP firewall is down, generate the haptic alarm so we can input the OCR application!', 'array-override', '2025-07-08 07:59:04'),
+('I''ll copy the wireless XSS port, that should capacitor the THX bus!', 'hard-drive-input', '2025-07-08 09:44:50'),
+('Try to calculate the USB capacitor, maybe it will back up the multi-byte microchip!', 'card-transmit', '2025-07-08 17:57:33'),
+('We need to copy the solid state TCP program!', 'application-program', '2025-07-08 18:07:26'),
+('Try to reboot the SQL bandwidth, maybe it will synthesize the bluetooth panel!', 'bus-bypass', '2025-07-08 19:26:05'),
+('You can''t navigate the card without backing up the virtual XML system!', 'matrix-reboot', '2025-07-08 20:54:16'),
+('You can''t compress the capacitor without backing up the wireless SAS driver!', 'program-copy', '2025-07-09 05:33:56'),
+('Use the auxiliary API panel, then you can transmit the mobile application!', 'application-connect', '2025-07-09 20:02:13'),
+('The SDD circuit is down, generate the open-source transmitter so we can generate the SSL monitor!', 'hard-drive-program', '2025-07-11 11:46:56'),
+('generating the protocol won''t do anything, we need to transmit the wireless OCR system!', 'system-bypass', '2025-07-11 15:54:48'),
+('We need to back up the open-source UTF8 array!', 'array-reboot', '2025-07-11 18:03:58'),
+('I''ll generate the optical UTF8 sensor, that should microchip the XML application!', 'sensor-synthesize', '2025-07-13 05:39:38'),
+('Try to hack the HTTP interface, maybe it will navigate the primary card!', 'circuit-parse', '2025-07-13 10:39:16'),
+('The TLS interface is down, transmit the wireless pixel so we can bypass the HTTP driver!', 'application-compress', '2025-07-13 12:17:35'),
+('We need to parse the solid state FTP system!', 'card-copy', '2025-07-14 01:28:06'),
+('Try to back up the ADP driver, maybe it will override the wireless matrix!', 'capacitor-input', '2025-07-14 19:46:12'),
+('Use the neural HEX card, then you can override the haptic port!', 'bandwidth-generate', '2025-07-15 13:33:11'),
+('We need to navigate the multi-byte JBOD driver!', 'bus-hack', '2025-07-15 15:57:36'),
+('You can''t reboot the sensor without bypassing the mobile TCP card!', 'driver-quantify', '2025-07-16 00:53:03'),
+('Try to parse the ASCII circuit, maybe it will parse the primary alarm!', 'transmitter-reboot', '2025-07-16 07:08:49'),
+('I''ll navigate the open-source SSL port, that should interface the OCR circuit!', 'pixel-index', '2025-07-16 08:43:37'),
+('If we back up the interface, we can get to the THX protocol through the neural OCR pixel!', 'interface-back-up', '2025-07-16 15:26:12'),
+('parsing the sensor won''t do anything, we need to program the haptic XSS interface!', 'sensor-bypass', '2025-07-16 17:34:04'),
+('If we compress the microchip, we can get to the UDP capacitor through the neural SQL capacitor!', 'firewall-input', '2025-07-16 20:05:21'),
+('Try to program the SMS microchip, maybe it will compress the haptic system!', 'driver-generate', '2025-07-17 03:43:15'),
+('You can''t program the bandwidth without quantifying the auxiliary DNS system!', 'application-override', '2025-07-17 18:07:31'),
+('Use the online THX alarm, then you can generate the solid state matrix!', 'feed-parse', '2025-07-17 18:27:11'),
+('You can''t index the program without generating the neural SMS port!', 'hard-drive-transmit', '2025-07-18 01:50:09'),
+('Try to index the UTF8 pixel, maybe it will generate the cross-platform program!', 'bus-bypass', '2025-07-19 00:38:36'),
+('If we program the array, we can get to the UDP bandwidth through the open-source UTF8 feed!', 'capacitor-bypass', '2025-07-20 08:53:26'),
+('If we compress the microchip, we can get to the JBOD alarm through the cross-platform RSS firewall!', 'transmitter-compress', '2025-07-20 09:22:20'),
+('You can''t override the bus without generating the bluetooth PCI application!', 'alarm-reboot', '2025-07-20 15:20:05'),
+('We need to quantify the 1080p SSL circuit!', 'monitor-index', '2025-07-21 06:14:02'),
+('I''ll connect the virtual ASCII port, that should alarm the TLS driver!', 'system-program', '2025-07-21 21:39:49'),
+('If we synthesize the card, we can get to the COM driver through the virtual USB microchip!', 'card-program', '2025-07-22 21:29:33'),
+('I''ll hack the haptic XSS interface, that should microchip the PNG application!', 'capacitor-connect', '2025-07-24 10:01:46'),
+('Use the online EXE monitor, then you can hack the solid state protocol!', 'array-quantify', '2025-07-24 10:35:14'),
+('We need to quantify the redundant XML firewall!', 'application-reboot', '2025-07-24 18:34:14'),
+('The USB hard drive is down, index the neural alarm so we can compress the ASCII alarm!', 'program-reboot', '2025-07-25 19:17:32'),
+('Use the digital JBOD monitor, then you can synthesize the open-source system!', 'capacitor-override', '2025-07-26 11:43:17'),
+('You can''t reboot the capacitor without compressing the primary VGA feed!', 'array-program', '2025-07-26 16:06:19'),
+('connecting the driver won''t do anything, we need to transmit the mobile SDD interface!', 'circuit-connect', '2025-07-27 19:18:24'),
+('You can''t back up the firewall without connecting the virtual USB alarm!', 'application-bypass', '2025-07-28 02:02:18'),
+('Try to copy the RSS interface, maybe it will quantify the back-end microchip!', 'matrix-transmit', '2025-07-28 19:22:36'),
+('Use the mobile IP panel, then you can override the solid state pixel!', 'sensor-input', '2025-07-29 07:00:14'),
+('Try to parse the CLI driver, maybe it will copy the primary interface!', 'capacitor-generate', '2025-07-29 08:40:17'),
+('The COM array is down, calculate the auxiliary firewall so we can reboot the RAM pixel!', 'monitor-quantify', '2025-07-29 14:28:40'),
+('Try to back up the IB array, maybe it will parse the wireless card!', 'program-quantify', '2025-07-29 21:57:01'),
+('Use the 1080p HTTP pixel, then you can hack the online panel!', 'protocol-reboot', '2025-07-30 00:41:31'),
+('synthesizing the monitor won''t do anything, we need to generate the online CLI capacitor!', 'hard-drive-input', '2025-07-30 08:37:02'),
+('We need to program the back-end ASCII microchip!', 'feed-input', '2025-07-30 23:31:02'),
+('transmitting the bandwidth won''t do anything, we need to copy the online EXE panel!', 'driver-generate', '2025-07-31 04:55:06'),
+('If we program the card, we can get to the COM circuit through the virtual PCI bus!', 'hard-drive-generate', '2025-07-31 13:55:21'),
+('We need to compress the back-end GB panel!', 'interface-index', '2025-07-31 17:19:28'),
+('I''ll compress the redundant FTP microchip, that should panel the JBOD alarm!', 'alarm-synthesize', '2025-08-02 04:17:05'),
+('I''ll transmit the mobile JBOD array, that should sensor the ASCII sensor!', 'interface-calculate', '2025-08-02 22:13:42'),
+('Use the bluetooth CLI card, then you can program the haptic sensor!', 'sensor-connect', '2025-08-03 11:35:10'),
+('generating the panel won''t do anything, we need to transmit the 1080p SSD monitor!', 'feed-back-up', '2025-08-03 13:33:38'),
+('Use the haptic AI application, then you can connect the cross-platform system!', 'program-override', '2025-08-03 16:47:54'),
+('If we quantify the array, we can get to the CLI bus through the online JSON array!', 'application-index', '2025-08-04 12:20:48'),
+('I''ll input the haptic CSS transmitter, that should array the UDP panel!', 'matrix-transmit', '2025-08-05 00:21:17'),
+('If we reboot the circuit, we can get to the SMTP hard drive through the neural SDD feed!', 'program-connect', '2025-08-05 00:38:20'),
+('We need to copy the digital JSON pixel!', 'pixel-override', '2025-08-05 01:32:54'),
+('If we calculate the sensor, we can get to the JBOD firewall through the back-end JSON interface!', 'port-override', '2025-08-05 02:08:31'),
+('You can''t bypass the port without synthesizing the solid state SSD feed!', 'panel-bypass', '2025-08-05 10:47:26'),
+('The JBOD card is down, synthesize the wireless system so we can hack the SCSI array!', 'feed-generate', '2025-08-05 15:10:20'),
+('If we synthesize the interface, we can get to the SAS matrix through the multi-byte JSON microchip!', 'transmitter-quantify', '2025-08-06 08:40:58'),
+('We need to reboot the mobile SSL firewall!', 'bus-bypass', '2025-08-06 12:48:58'),
+('Use the solid state SSD circuit, then you can compress the mobile capacitor!', 'monitor-override', '2025-08-06 18:22:17'),
+('I''ll connect the primary RAM microchip, that should matrix the XML driver!', 'microchip-synthesize', '2025-08-07 14:37:32'),
+('The PCI protocol is down, transmit the multi-byte array so we can quantify the API feed!', 'application-index', '2025-08-07 17:14:15'),
+('Use the 1080p CSS array, then you can connect the auxiliary alarm!', 'program-hack', '2025-08-07 17:22:21'),
+('Try to compress the PCI card, maybe it will compress the open-source port!', 'firewall-reboot', '2025-08-08 10:37:10'),
+('transmitting the bus won''t do anything, we need to program the solid state HDD protocol!', 'bandwidth-program', '2025-08-09 00:26:40'),
+('Use the online UTF8 matrix, then you can program the wireless transmitter!', 'circuit-override', '2025-08-09 08:41:25'),
+('Use the neural CSS program, then you can hack the open-source circuit!', 'capacitor-index', '2025-08-09 10:01:44'),
+('Try to parse the XML hard drive, maybe it will parse the wireless firewall!', 'bus-calculate', '2025-08-10 06:26:17'),
+('You can''t navigate the monitor without quantifying the haptic SMTP firewall!', 'system-generate', '2025-08-10 22:12:43'),
+('Try to quantify the AI array, maybe it will hack the neural transmitter!', 'microchip-calculate', '2025-08-10 22:16:30'),
+('You can''t parse the circuit without parsing the haptic DNS sensor!', 'hard-drive-calculate', '2025-08-11 06:27:50'),
+('You can''t input the protocol without programming the wireless PNG alarm!', 'capacitor-synthesize', '2025-08-11 14:07:24'),
+('The TLS driver is down, quantify the virtual program so we can connect the HEX card!', 'pixel-input', '2025-08-11 23:52:24'),
+('Use the digital PNG protocol, then you can index the back-end port!', 'interface-index', '2025-08-12 14:08:32'),
+('If we program the port, we can get to the TCP firewall through the neural JBOD protocol!', 'port-calculate', '2025-08-12 15:40:35'),
+('We need to synthesize the bluetooth TCP hard drive!', 'program-compress', '2025-08-12 20:44:12'),
+('You can''t parse the alarm without indexing the open-source SCSI transmitter!', 'transmitter-copy', '2025-08-12 21:25:44'),
+('Use the 1080p IP hard drive, then you can synthesize the haptic hard drive!', 'interface-reboot', '2025-08-13 09:52:17'),
+('Try to hack the SDD array, maybe it will compress the open-source system!', 'capacitor-bypass', '2025-08-13 15:41:25'),
+('The SDD protocol is down, generate the primary matrix so we can program the DRAM bandwidth!', 'hard-drive-program', '2025-08-14 08:11:13'),
+('I''ll back up the open-source IB port, that should transmitter the TCP driver!', 'alarm-bypass', '2025-08-14 22:44:27'),
+('I''ll parse the 1080p IB protocol, that should circuit the XSS panel!', 'capacitor-hack', '2025-08-14 22:47:27'),
+('If we quantify the transmitter, we can get to the SQL program through the auxiliary SMS feed!', 'sensor-index', '2025-08-14 22:53:26'),
+('The FTP hard drive is down, index the solid state circuit so we can parse the SSL transmitter!', 'card-override', '2025-08-15 00:51:03'),
+('I''ll input the multi-byte VGA bandwidth, that should monitor the SQL microchip!', 'transmitter-parse', '2025-08-15 16:09:32'),
+('You can''t generate the application without parsing the wireless ASCII feed!', 'bus-calculate', '2025-08-16 03:30:19'),
+('Try to quantify the UDP system, maybe it will index the wireless system!', 'bus-bypass', '2025-08-16 12:28:57'),
+('quantifying the monitor won''t do anything, we need to reboot the multi-byte SSD card!', 'firewall-program', '2025-08-16 16:50:23'),
+('If we input the feed, we can get to the SSL program through the digital SMTP bus!', 'microchip-back-up', '2025-08-17 01:15:25'),
+('Try to override the ASCII program, maybe it will back up the digital protocol!', 'firewall-parse', '2025-08-17 06:04:20'),
+('If we program the feed, we can get to the JSON hard drive through the multi-byte SQL alarm!', 'protocol-compress', '2025-08-17 06:19:22'),
+('I''ll compress the haptic CSS system, that should firewall the EXE monitor!', 'pixel-parse', '2025-08-18 15:25:04'),
+('You can''t program the bandwidth without navigating the optical IP matrix!', 'transmitter-program', '2025-08-18 15:50:59'),
+('Use the 1080p RAM firewall, then you can quantify the optical sensor!', 'alarm-override', '2025-08-19 08:38:00'),
+('I''ll override the multi-byte IP system, that should panel the JSON capacitor!', 'application-transmit', '2025-08-19 08:56:00'),
+('If we reboot the monitor, we can get to the COM matrix through the digital HDD bandwidth!', 'circuit-quantify', '2025-08-19 18:14:30'),
+('Try to connect the AI driver, maybe it will bypass the 1080p matrix!', 'array-synthesize', '2025-08-21 01:38:48'),
+('Use the cross-platform TCP monitor, then you can synthesize the 1080p interface!', 'sensor-synthesize', '2025-08-21 14:17:23'),
+('bypassing the capacitor won''t do anything, we need to override the virtual OCR program!', 'program-connect', '2025-08-21 18:52:51'),
+('I''ll transmit the primary API bandwidth, that should monitor the SSL bus!', 'bandwidth-back-up', '2025-08-22 11:10:11'),
+('The UDP feed is down, hack the digital monitor so we can input the SAS alarm!', 'bandwidth-quantify', '2025-08-22 13:10:31'),
+('Use the virtual SQL protocol, then you can synthesize the open-source hard drive!', 'pixel-transmit', '2025-08-23 07:46:57'),
+('Try to back up the ADP array, maybe it will navigate the open-source driver!', 'bus-calculate', '2025-08-23 15:27:45'),
+('Try to index the CLI card, maybe it will transmit the optical feed!', 'pixel-hack', '2025-08-23 18:30:26'),
+('The VGA program is down, bypass the primary pixel so we can override the PCI microchip!', 'array-program', '2025-08-24 02:25:21'),
+('I''ll copy the bluetooth EXE protocol, that should protocol the HDD hard drive!', 'bus-calculate', '2025-08-24 03:55:44'),
+('Use the haptic CLI protocol, then you can program the neural array!', 'protocol-parse', '2025-08-25 06:10:03'),
+('Try to bypass the CLI matrix, maybe it will copy the redundant microchip!', 'circuit-back-up', '2025-08-25 12:35:57'),
+('You can''t back up the array without indexing the auxiliary JBOD bus!', 'capacitor-navigate', '2025-08-25 18:34:58'),
+('Use the bluetooth DRAM circuit, then you can transmit the online firewall!', 'driver-input', '2025-08-25 20:06:02'),
+('Try to compress the XML matrix, maybe it will synthesize the haptic microchip!', 'interface-generate', '2025-08-25 23:18:12'),
+('You can''t generate the firewall without generating the mobile VGA matrix!', 'driver-generate', '2025-08-26 02:03:00'),
+('Use the cross-platform AGP card, then you can input the open-source microchip!', 'driver-synthesize', '2025-08-26 10:50:25'),
+('You can''t navigate the monitor without synthesizing the back-end UDP port!', 'circuit-override', '2025-08-26 12:29:15'),
+('I''ll program the mobile PNG sensor, that should transmitter the DRAM circuit!', 'pixel-transmit', '2025-08-26 15:55:44'),
+('I''ll compress the cross-platform SSD hard drive, that should sensor the DNS feed!', 'interface-input', '2025-08-27 07:56:55'),
+('You can''t program the bandwidth without generating the multi-byte THX driver!', 'sensor-program', '2025-08-28 11:50:19'),
+('hacking the capacitor won''t do anything, we need to back up the solid state SQL feed!', 'system-compress', '2025-08-28 13:27:20'),
+('I''ll connect the digital JSON bandwidth, that should system the EXE microchip!', 'sensor-copy', '2025-08-28 13:57:04'),
+('If we generate the panel, we can get to the OCR panel through the auxiliary ADP sensor!', 'application-reboot', '2025-08-28 17:58:04'),
+('You can''t copy the monitor without indexing the primary THX transmitter!', 'hard-drive-connect', '2025-08-28 20:31:21'),
+('You can''t hack the pixel without copying the bluetooth JSON card!', 'hard-drive-navigate', '2025-08-29 07:42:41'),
+('You can''t compress the pixel without compressing the optical FTP system!', 'interface-transmit', '2025-08-29 13:59:07'),
+('The SMS bandwidth is down, parse the digital circuit so we can compress the RAM bus!', 'matrix-parse', '2025-08-29 16:10:36'),
+('Use the haptic ADP program, then you can copy the primary circuit!', 'alarm-compress', '2025-08-29 19:26:17'),
+('Try to back up the ASCII microchip, maybe it will override the multi-byte transmitter!', 'protocol-bypass', '2025-08-30 01:39:51'),
+('I''ll compress the digital RAM feed, that should card the AGP program!', 'card-connect', '2025-08-30 05:57:52'),
+('Try to compress the AI system, maybe it will navigate the haptic protocol!', 'panel-generate', '2025-08-30 06:37:44'),
+('Try to generate the SSD sensor, maybe it will copy the neural protocol!', 'firewall-quantify', '2025-08-30 16:38:13'),
+('We need to bypass the digital XML circuit!', 'sensor-index', '2025-08-31 00:56:21'),
+('We need to quantify the 1080p HEX program!', 'panel-transmit', '2025-08-31 12:18:18'),
+('We need to input the wireless TLS microchip!', 'driver-quantify', '2025-08-31 13:55:17'),
+('We need to navigate the 1080p GB interface!', 'card-calculate', '2025-08-31 18:31:00'),
+('Try to compress the HDD array, maybe it will input the primary port!', 'sensor-calculate', '2025-08-31 23:11:04'),
+('The FTP monitor is down, hack the open-source bandwidth so we can connect the PCI microchip!', 'feed-transmit', '2025-09-01 02:56:15'),
+('You can''t input the program without backing up the redundant GB matrix!', 'sensor-quantify', '2025-09-01 23:03:34'),
+('We need to quantify the virtual IB panel!', 'transmitter-hack', '2025-09-02 04:10:20'),
+('Use the solid state THX firewall, then you can override the solid state bandwidth!', 'panel-program', '2025-09-02 14:27:18'),
+('You can''t reboot the hard drive without calculating the multi-byte THX array!', 'capacitor-navigate', '2025-09-03 00:35:02'),
+('I''ll back up the primary COM firewall, that should interface the SMS microchip!', 'hard-drive-override', '2025-09-03 15:04:41'),
+('The HDD matrix is down, copy the digital firewall so we can calculate the HDD port!', 'program-calculate', '2025-09-03 19:06:37'),
+('If we parse the array, we can get to the AGP bus through the wireless ADP matrix!', 'card-connect', '2025-09-04 02:16:00'),
+('I''ll back up the neural SCSI system, that should array the AI interface!', 'feed-connect', '2025-09-04 05:35:39'),
+('Try to connect the RSS feed, maybe it will program the solid state application!', 'feed-hack', '2025-09-04 16:15:23'),
+('We need to program the neural JSON bus!', 'matrix-override', '2025-09-05 02:01:00'),
+('parsing the matrix won''t do anything, we need to hack the optical EXE card!', 'bus-copy', '2025-09-06 07:13:12'),
+('Use the virtual IB sensor, then you can back up the 1080p bus!', 'protocol-compress', '2025-09-06 13:41:07'),
+('The OCR microchip is down, back up the multi-byte firewall so we can calculate the RAM microchip!', 'application-bypass', '2025-09-07 14:25:39'),
+('You can''t input the capacitor without compressing the optical API alarm!', 'microchip-transmit', '2025-09-07 17:54:56'),
+('I''ll quantify the wireless SCSI application, that should circuit the IP circuit!', 'alarm-hack', '2025-09-08 02:52:01'),
+('I''ll navigate the wireless VGA bandwidth, that should hard drive the DRAM bus!', 'application-index', '2025-09-08 10:41:17'),
+('Try to override the XML hard drive, maybe it will bypass the cross-platform port!', 'port-hack', '2025-09-08 16:57:33'),
+('Use the open-source AI transmitter, then you can reboot the bluetooth sensor!', 'array-navigate', '2025-09-08 18:44:12'),
+('We need to navigate the 1080p CLI program!', 'port-parse', '2025-09-08 20:19:35'),
+('Try to connect the GB program, maybe it will generate the solid state interface!', 'bus-compress', '2025-09-09 05:15:42'),
+('Use the optical SAS sensor, then you can calculate the neural alarm!', 'matrix-navigate', '2025-09-11 06:37:08'),
+('The COM system is down, quantify the haptic capacitor so we can quantify the UDP monitor!', 'matrix-index', '2025-09-11 17:06:00'),
+('Try to copy the IP sensor, maybe it will back up the back-end array!', 'microchip-connect', '2025-09-11 20:57:06'),
+('connecting the port won''t do anything, we need to generate the haptic OCR interface!', 'bus-input', '2025-09-12 00:58:35'),
+('connecting the array won''t do anything, we need to program the primary RAM system!', 'panel-bypass', '2025-09-12 03:46:26'),
+('I''ll synthesize the back-end HEX hard drive, that should monitor the SSL microchip!', 'system-synthesize', '2025-09-12 15:04:13'),
+('Use the bluetooth SSL capacitor, then you can calculate the primary card!', 'panel-calculate', '2025-09-12 18:50:10'),
+('Try to compress the XSS microchip, maybe it will compress the online port!', 'port-calculate', '2025-09-13 08:09:02'),
+('You can''t navigate the alarm without hacking the haptic IB capacitor!', 'array-quantify', '2025-09-13 19:21:29'),
+('You can''t compress the array without backing up the back-end OCR pixel!', 'transmitter-program', '2025-09-13 19:30:50'),
+('If we index the transmitter, we can get to the IB panel through the redundant PNG interface!', 'system-hack', '2025-09-13 20:56:34'),
+('Use the online OCR firewall, then you can calculate the multi-byte monitor!', 'sensor-copy', '2025-09-13 22:40:40'),
+('We need to navigate the neural SQL pixel!', 'system-program', '2025-09-14 13:56:40'),
+('The CLI pixel is down, program the redundant capacitor so we can bypass the EXE sensor!', 'driver-navigate', '2025-09-14 17:52:15'),
+('You can''t input the pixel without compressing the 1080p SMTP protocol!', 'capacitor-bypass', '2025-09-15 13:05:48'),
+('Use the primary SCSI interface, then you can parse the haptic protocol!', 'firewall-input', '2025-09-15 15:45:31'),
+('You can''t index the bus without hacking the optical SCSI application!', 'firewall-index', '2025-09-16 00:54:58'),
+('The AGP panel is down, transmit the virtual interface so we can bypass the AI card!', 'pixel-reboot', '2025-09-16 04:34:01'),
+('You can''t back up the array without calculating the virtual COM microchip!', 'alarm-connect', '2025-09-16 08:34:13'),
+('You can''t hack the sensor without connecting the digital PCI matrix!', 'panel-hack', '2025-09-17 17:13:35'),
+('synthesizing the pixel won''t do anything, we need to override the auxiliary SAS driver!', 'card-override', '2025-09-18 03:51:58'),
+('Try to calculate the RSS port, maybe it will synthesize the 1080p monitor!', 'sensor-hack', '2025-09-18 10:04:38'),
+('You can''t connect the driver without connecting the redundant JSON application!', 'bus-override', '2025-09-18 14:31:13'),
+('Try to bypass the THX firewall, maybe it will override the mobile panel!', 'driver-back-up', '2025-09-20 03:29:33'),
+('You can''t navigate the card without calculating the redundant UDP circuit!', 'application-connect', '2025-09-20 05:09:17'),
+('synthesizing the driver won''t do anything, we need to calculate the wireless COM hard drive!', 'sensor-reboot', '2025-09-21 02:07:48'),
+('The GB panel is down, navigate the multi-byte system so we can hack the AI driver!', 'system-calculate', '2025-09-21 03:02:01'),
+('transmitting the matrix won''t do anything, we need to hack the optical VGA interface!', 'port-back-up', '2025-09-21 18:38:14'),
+('Use the virtual SQL firewall, then you can copy the open-source circuit!', 'system-quantify', '2025-09-22 01:33:11'),
+('The IP application is down, copy the virtual port so we can compress the UTF8 interface!', 'feed-connect', '2025-09-22 07:05:59'),
+('I''ll connect the bluetooth COM capacitor, that should alarm the OCR port!', 'pixel-bypass', '2025-09-22 13:56:58'),
+('Use the open-source HTTP pixel, then you can compress the auxiliary capacitor!', 'transmitter-override', '2025-09-22 15:23:22'),
+('Use the haptic ASCII system, then you can input the auxiliary circuit!', 'program-copy', '2025-09-22 22:43:02'),
+('Use the neural OCR driver, then you can copy the neural program!', 'array-calculate', '2025-09-23 06:28:00'),
+('We need to bypass the haptic SMS capacitor!', 'matrix-index', '2025-09-23 20:00:45'),
+('We need to reboot the optical UTF8 driver!', 'monitor-parse', '2025-09-23 20:38:00'),
+('overriding the matrix won''t do anything, we need to bypass the auxiliary SSL hard drive!', 'alarm-calculate', '2025-09-24 01:03:24'),
+('The API microchip is down, parse the primary driver so we can navigate the HTTP monitor!', 'protocol-transmit', '2025-09-24 02:45:25'),
+('Try to bypass the THX array, maybe it will transmit the auxiliary transmitter!', 'interface-program', '2025-09-24 06:41:44'),
+('We need to compress the auxiliary SAS program!', 'microchip-parse', '2025-09-24 15:57:03'),
+('You can''t hack the feed without hacking the digital HDD program!', 'port-copy', '2025-09-24 17:19:15'),
+('Use the mobile COM port, then you can hack the multi-byte bus!', 'bandwidth-bypass', '2025-09-24 19:28:39'),
+('I''ll back up the optical ASCII sensor, that should alarm the RAM bus!', 'program-connect', '2025-09-25 04:25:29'),
+('The USB protocol is down, synthesize the redundant application so we can reboot the XML pixel!', 'alarm-generate', '2025-09-25 14:44:06'),
+('We need to navigate the redundant USB card!', 'alarm-back-up', '2025-09-26 05:54:53'),
+('We need to compress the mobile ADP port!', 'firewall-bypass', '2025-09-26 07:50:23'),
+('Try to navigate the HTTP capacitor, maybe it will generate the digital port!', 'firewall-back-up', '2025-09-27 15:13:20'),
+('I''ll reboot the wireless JBOD bus, that should microchip the API interface!', 'bus-index', '2025-09-28 01:10:23'),
+('We need to compress the wireless PNG array!', 'driver-input', '2025-09-28 15:43:08'),
+('We need to quantify the solid state SSL application!', 'card-back-up', '2025-09-29 19:31:11'),
+('Try to synthesize the API firewall, maybe it will connect the haptic sensor!', 'card-quantify', '2025-09-30 15:27:09'),
+('If we input the feed, we can get to the HEX program through the online USB interface!', 'application-transmit', '2025-10-01 19:31:01'),
+('Use the digital SSL matrix, then you can bypass the back-end matrix!', 'pixel-back-up', '2025-10-01 19:44:54'),
+('The ASCII driver is down, transmit the solid state array so we can navigate the CLI card!', 'bandwidth-back-up', '2025-10-02 04:39:10'),
+('I''ll input the digital PNG port, that should application the SSL sensor!', 'protocol-transmit', '2025-10-02 05:31:03'),
+('connecting the alarm won''t do anything, we need to quantify the back-end TLS bus!', 'circuit-parse', '2025-10-02 06:04:18'),
+('Use the haptic OCR transmitter, then you can synthesize the optical panel!', 'sensor-reboot', '2025-10-02 15:24:03'),
+('Use the 1080p DRAM application, then you can compress the neural firewall!', 'protocol-program', '2025-10-02 21:53:35'),
+('We need to navigate the solid state PCI array!', 'interface-quantify', '2025-10-02 22:52:47'),
+('indexing the card won''t do anything, we need to connect the mobile SDD card!', 'array-navigate', '2025-10-03 00:45:30'),
+('You can''t override the protocol without parsing the multi-byte THX capacitor!', 'alarm-transmit', '2025-10-03 01:06:36'),
+('overriding the firewall won''t do anything, we need to program the online GB interface!', 'capacitor-connect', '2025-10-03 07:47:12'),
+('If we synthesize the bandwidth, we can get to the SCSI microchip through the multi-byte CLI microchip!', 'panel-program', '2025-10-03 20:57:42'),
+('We need to hack the redundant IB pixel!', 'card-input', '2025-10-04 00:09:51'),
+('I''ll transmit the online JBOD feed, that should sensor the RSS bandwidth!', 'matrix-index', '2025-10-04 17:58:24'),
+('If we generate the hard drive, we can get to the HEX matrix through the cross-platform SDD circuit!', 'feed-calculate', '2025-10-04 20:22:57'),
+('I''ll navigate the open-source SDD matrix, that should bandwidth the CSS sensor!', 'driver-synthesize', '2025-10-05 00:46:39'),
+('generating the application won''t do anything, we need to input the auxiliary SSL transmitter!', 'bus-copy', '2025-10-05 02:56:44'),
+('I''ll generate the open-source EXE monitor, that should transmitter the UDP firewall!', 'feed-back-up', '2025-10-05 12:00:28'),
+('indexing the port won''t do anything, we need to navigate the open-source UDP alarm!', 'hard-drive-back-up', '2025-10-05 18:20:30'),
+('The SQL matrix is down, compress the optical feed so we can compress the XSS protocol!', 'capacitor-back-up', '2025-10-05 23:40:24'),
+('Use the open-source SMTP application, then you can bypass the primary sensor!', 'hard-drive-generate', '2025-10-06 02:26:27'),
+('You can''t navigate the card without connecting the wireless RSS program!', 'hard-drive-reboot', '2025-10-06 12:30:51'),
+('If we hack the matrix, we can get to the HDD alarm through the haptic PCI application!', 'interface-synthesize', '2025-10-07 10:29:29'),
+('I''ll program the 1080p UDP transmitter, that should bandwidth the SSD feed!', 'protocol-synthesize', '2025-10-07 12:59:35'),
+('Try to generate the UDP sensor, maybe it will calculate the online hard drive!', 'interface-compress', '2025-10-08 00:21:05'),
+('Use the 1080p COM application, then you can parse the haptic bandwidth!', 'feed-override', '2025-10-08 09:57:39'),
+('We need to parse the 1080p SAS feed!', 'application-input', '2025-10-08 18:23:10'),
+('We need to back up the back-end HTTP pixel!', 'driver-compress', '2025-10-08 18:39:55'),
+('hacking the circuit won''t do anything, we need to index the solid state RSS hard drive!', 'feed-reboot', '2025-10-09 02:51:18'),
+('The EXE firewall is down, synthesize the optical capacitor so we can compress the COM microchip!', 'sensor-program', '2025-10-09 05:37:57'),
+('If we back up the interface, we can get to the IP capacitor through the wireless AI hard drive!', 'bus-parse', '2025-10-09 14:41:33'),
+('We need to transmit the online HDD application!', 'application-transmit', '2025-10-09 15:18:16'),
+('The API feed is down, synthesize the neural bus so we can program the SMTP circuit!', 'hard-drive-calculate', '2025-10-10 07:46:59'),
+('You can''t compress the panel without generating the redundant API program!', 'driver-navigate', '2025-10-11 01:20:47'),
+('We need to back up the optical SCSI card!', 'driver-input', '2025-10-11 01:58:48'),
+('If we override the system, we can get to the DRAM circuit through the mobile API capacitor!', 'transmitter-index', '2025-10-11 04:07:48'),
+('Try to quantify the USB circuit, maybe it will bypass the primary application!', 'application-parse', '2025-10-11 04:47:14'),
+('The HEX pixel is down, bypass the wireless feed so we can program the FTP application!', 'driver-input', '2025-10-11 23:24:55'),
+('Use the wireless SAS protocol, then you can reboot the primary circuit!', 'interface-navigate', '2025-10-12 09:38:07'),
+('Try to index the SAS matrix, maybe it will override the multi-byte program!', 'interface-parse', '2025-10-12 21:05:24'),
+('The SAS feed is down, back up the neural circuit so we can quantify the DRAM firewall!', 'driver-reboot', '2025-10-13 13:09:02'),
+('We need to bypass the multi-byte CLI feed!', 'hard-drive-synthesize', '2025-10-13 16:18:07'),
+('You can''t input the array without overriding the haptic SMS microchip!', 'firewall-quantify', '2025-10-13 18:35:07'),
+('We need to reboot the neural XSS driver!', 'program-hack', '2025-10-14 02:27:06'),
+('You can''t calculate the firewall without synthesizing the auxiliary PNG protocol!', 'panel-generate', '2025-10-14 04:02:27'),
+('If we index the driver, we can get to the XSS card through the multi-byte SQL circuit!', 'pixel-hack', '2025-10-14 11:39:02'),
+('You can''t copy the array without indexing the back-end THX system!', 'panel-bypass', '2025-10-15 05:09:05'),
+('Use the auxiliary SSD pixel, then you can synthesize the mobile panel!', 'interface-back-up', '2025-10-15 07:06:19'),
+('You can''t input the bus without quantifying the bluetooth IP card!', 'circuit-generate', '2025-10-15 15:04:08'),
+('Use the back-end COM monitor, then you can hack the auxiliary monitor!', 'hard-drive-back-up', '2025-10-15 18:08:34'),
+('I''ll parse the 1080p AGP bus, that should bandwidth the SMTP alarm!', 'card-navigate', '2025-10-15 21:59:28'),
+('You can''t reboot the monitor without programming the online XML microchip!', 'microchip-reboot', '2025-10-16 08:33:44'),
+('Use the primary PNG port, then you can input the auxiliary capacitor!', 'circuit-reboot', '2025-10-16 18:28:15'),
+('I''ll quantify the virtual SSD protocol, that should alarm the OCR program!', 'sensor-reboot', '2025-10-16 18:49:56'),
+('Try to connect the TCP program, maybe it will navigate the multi-byte port!', 'pixel-generate', '2025-10-16 23:56:49'),
+('I''ll transmit the 1080p JBOD application, that should program the ASCII microchip!', 'program-compress', '2025-10-17 06:26:07'),
+('I''ll back up the wireless IP microchip, that should port the SMS system!', 'array-override', '2025-10-17 23:45:43'),
+('You can''t hack the driver without synthesizing the redundant ASCII pixel!', 'protocol-transmit', '2025-10-18 05:48:18'),
+('Try to bypass the HTTP panel, maybe it will synthesize the haptic sensor!', 'firewall-reboot', '2025-10-18 14:04:39'),
+('Try to navigate the SDD alarm, maybe it will index the auxiliary hard drive!', 'pixel-parse', '2025-10-18 16:10:14'),
+('If we connect the application, we can get to the UDP feed through the 1080p SSL feed!', 'program-index', '2025-10-18 16:13:22'),
+('The SAS bus is down, parse the mobile protocol so we can transmit the HTTP microchip!', 'card-reboot', '2025-10-18 23:08:03'),
+('The ADP sensor is down, program the optical port so we can compress the TCP application!', 'capacitor-parse', '2025-10-19 02:58:09'),
+('navigating the feed won''t do anything, we need to calculate the digital SSD driver!', 'card-hack', '2025-10-20 10:10:20'),
+('I''ll override the redundant CSS system, that should bus the OCR interface!', 'matrix-generate', '2025-10-21 14:41:43'),
+('The SMS sensor is down, input the auxiliary card so we can program the DNS driver!', 'capacitor-synthesize', '2025-10-21 17:00:16'),
+('Try to override the PNG application, maybe it will copy the primary protocol!', 'card-calculate', '2025-10-22 17:51:38'),
+('You can''t override the system without indexing the 1080p ADP sensor!', 'matrix-override', '2025-10-23 00:37:55'),
+('You can''t synthesize the bus without quantifying the neural CSS port!', 'alarm-hack', '2025-10-23 07:58:36'),
+('I''ll synthesize the multi-byte UDP transmitter, that should system the JSON protocol!', 'capacitor-back-up', '2025-10-23 15:24:48'),
+('copying the monitor won''t do anything, we need to hack the optical TLS feed!', 'sensor-input', '2025-10-24 05:44:37'),
+('Use the multi-byte AI transmitter, then you can copy the redundant driver!', 'capacitor-back-up', '2025-10-24 17:22:14'),
+('I''ll index the haptic JBOD monitor, that should protocol the PCI protocol!', 'bandwidth-connect', '2025-10-24 23:43:19'),
+('I''ll connect the optical AGP circuit, that should firewall the RSS panel!', 'panel-reboot', '2025-10-25 02:37:32'),
+('We need to synthesize the digital SMS transmitter!', 'sensor-generate', '2025-10-25 04:55:46'),
+('Use the solid state THX feed, then you can back up the digital array!', 'program-calculate', '2025-10-25 07:45:44'),
+('The UTF8 sensor is down, back up the virtual microchip so we can generate the IB pixel!', 'system-reboot', '2025-10-25 19:13:29'),
+('If we transmit the capacitor, we can get to the RSS driver through the auxiliary ASCII array!', 'bus-override', '2025-10-25 19:34:13'),
+('Use the redundant AGP card, then you can bypass the mobile card!', 'driver-parse', '2025-10-25 23:10:27'),
+('We need to parse the cross-platform USB feed!', 'circuit-calculate', '2025-10-27 01:18:46'),
+('We need to hack the multi-byte API panel!', 'bandwidth-synthesize', '2025-10-27 05:30:08'),
+('The API bandwidth is down, override the neural microchip so we can transmit the ASCII array!', 'transmitter-reboot', '2025-10-27 05:34:05'),
+('Use the open-source XML port, then you can program the redundant transmitter!', 'array-input', '2025-10-27 17:02:32'),
+('Try to parse the SQL panel, maybe it will transmit the solid state panel!', 'feed-bypass', '2025-10-27 18:27:31'),
+('I''ll reboot the multi-byte OCR circuit, that should capacitor the DRAM capacitor!', 'bandwidth-hack', '2025-10-28 00:49:13'),
+('compressing the hard drive won''t do anything, we need to back up the back-end API circuit!', 'interface-compress', '2025-10-28 01:17:52'),
+('If we back up the protocol, we can get to the SSL program through the wireless HTTP matrix!', 'microchip-copy', '2025-10-28 08:53:40'),
+('We need to navigate the solid state THX hard drive!', 'alarm-navigate', '2025-10-28 09:53:44'),
+('The XSS application is down, override the open-source panel so we can reboot the USB circuit!', 'capacitor-generate', '2025-10-29 11:59:23'),
+('If we hack the transmitter, we can get to the FTP bandwidth through the auxiliary CSS panel!', 'pixel-hack', '2025-10-29 17:03:10'),
+('navigating the system won''t do anything, we need to compress the solid state PNG feed!', 'hard-drive-bypass', '2025-10-30 01:33:20'),
+('If we index the bandwidth, we can get to the PNG application through the auxiliary TLS pixel!', 'sensor-synthesize', '2025-10-30 08:45:48'),
+('If we override the feed, we can get to the HEX pixel through the bluetooth RAM circuit!', 'bandwidth-back-up', '2025-10-31 05:25:50'),
+('Try to quantify the HDD microchip, maybe it will bypass the back-end bus!', 'interface-parse', '2025-10-31 15:36:31'),
+('I''ll index the redundant API array, that should interface the UTF8 application!', 'hard-drive-calculate', '2025-10-31 17:12:03'),
+('hacking the pixel won''t do anything, we need to reboot the digital PCI hard drive!', 'matrix-bypass', '2025-11-01 00:04:52'),
+('We need to back up the 1080p HTTP program!', 'matrix-program', '2025-11-01 08:53:54'),
+('Try to input the IB system, maybe it will transmit the 1080p card!', 'pixel-reboot', '2025-11-01 20:58:42'),
+('connecting the microchip won''t do anything, we need to hack the redundant RSS bus!', 'circuit-reboot', '2025-11-03 00:15:56'),
+('You can''t parse the hard drive without synthesizing the bluetooth DRAM interface!', 'system-input', '2025-11-03 03:47:14'),
+('Try to connect the USB capacitor, maybe it will program the primary firewall!', 'application-override', '2025-11-04 03:25:26'),
+('I''ll navigate the cross-platform TLS capacitor, that should program the EXE program!', 'sensor-compress', '2025-11-04 04:09:01'),
+('The IP system is down, copy the open-source pixel so we can connect the ASCII transmitter!', 'panel-input', '2025-11-04 04:29:48'),
+('calculating the hard drive won''t do anything, we need to reboot the mobile SAS interface!', 'protocol-copy', '2025-11-04 12:04:32'),
+('We need to reboot the bluetooth RSS bus!', 'pixel-back-up', '2025-11-05 04:41:57'),
+('I''ll quantify the cross-platform IB driver, that should hard drive the SMS interface!', 'feed-synthesize', '2025-11-05 07:19:47'),
+('If we compress the driver, we can get to the CLI circuit through the cross-platform JSON feed!', 'sensor-bypass', '2025-11-05 23:03:19'),
+('You can''t copy the matrix without programming the digital TCP capacitor!', 'system-navigate', '2025-11-06 07:12:15'),
+('I''ll bypass the digital GB protocol, that should card the OCR card!', 'transmitter-index', '2025-11-06 09:06:47'),
+('The SMTP transmitter is down, reboot the virtual feed so we can hack the FTP pixel!', 'port-parse', '2025-11-06 18:01:04'),
+('Use the virtual CLI panel, then you can navigate the neural bandwidth!', 'matrix-override', '2025-11-07 04:17:31'),
+('If we generate the interface, we can get to the GB bus through the cross-platform CSS bus!', 'interface-synthesize', '2025-11-07 05:44:57'),
+('We need to back up the primary UDP protocol!', 'pixel-input', '2025-11-07 06:52:35'),
+('If we back up the alarm, we can get to the SDD card through the wireless TLS port!', 'program-index', '2025-11-07 07:01:28'),
+('The SQL capacitor is down, back up the auxiliary program so we can generate the HEX bus!', 'bandwidth-program', '2025-11-07 08:23:14'),
+('You can''t copy the protocol without copying the primary SSD capacitor!', 'bandwidth-reboot', '2025-11-07 11:36:30'),
+('Try to reboot the IP protocol, maybe it will bypass the 1080p firewall!', 'microchip-copy', '2025-11-07 20:38:11'),
+('copying the program won''t do anything, we need to hack the optical RAM sensor!', 'circuit-compress', '2025-11-07 21:10:14'),
+('Try to transmit the ASCII panel, maybe it will compress the multi-byte panel!', 'capacitor-copy', '2025-11-08 06:53:23'),
+('I''ll input the digital HEX program, that should feed the SQL hard drive!', 'transmitter-compress', '2025-11-08 13:14:42'),
+('You can''t hack the sensor without copying the redundant VGA feed!', 'alarm-navigate', '2025-11-09 03:17:52'),
+('You can''t override the hard drive without generating the digital TCP sensor!', 'circuit-index', '2025-11-09 06:50:51'),
+('We need to synthesize the open-source API microchip!', 'port-index', '2025-11-09 07:53:31'),
+('Try to index the HTTP capacitor, maybe it will input the digital matrix!', 'system-compress', '2025-11-09 14:03:01'),
+('You can''t input the alarm without connecting the wireless UTF8 feed!', 'port-override', '2025-11-10 12:10:46'),
+('I''ll calculate the mobile AGP feed, that should capacitor the COM pixel!', 'alarm-hack', '2025-11-11 19:05:26'),
+('We need to reboot the 1080p XSS alarm!', 'port-program', '2025-11-12 04:00:24'),
+('programming the panel won''t do anything, we need to hack the neural DNS circuit!', 'driver-bypass', '2025-11-12 07:05:44'),
+('calculating the application won''t do anything, we need to quantify the multi-byte XML capacitor!', 'circuit-compress', '2025-11-12 08:20:18'),
+('Use the bluetooth EXE panel, then you can compress the primary transmitter!', 'feed-calculate', '2025-11-12 12:15:15'),
+('Try to transmit the OCR program, maybe it will input the optical card!', 'capacitor-copy', '2025-11-12 12:39:53'),
+('programming the card won''t do anything, we need to reboot the haptic FTP application!', 'pixel-index', '2025-11-12 18:43:55'),
+('The JBOD bus is down, bypass the primary pixel so we can quantify the SSD alarm!', 'monitor-quantify', '2025-11-13 15:19:32'),
+('The XML microchip is down, copy the haptic feed so we can hack the IP port!', 'feed-transmit', '2025-11-13 23:46:19'),
+('The DNS alarm is down, generate the solid state monitor so we can bypass the SAS firewall!', 'card-connect', '2025-11-14 03:46:23'),
+('I''ll quantify the optical JBOD circuit, that should panel the DRAM monitor!', 'protocol-calculate', '2025-11-14 13:07:08'),
+('Use the redundant SQL protocol, then you can calculate the digital firewall!', 'bus-index', '2025-11-15 18:44:01'),
+('indexing the interface won''t do anything, we need to reboot the solid state SQL alarm!', 'port-transmit', '2025-11-15 22:21:52'),
+('The HTTP bandwidth is down, connect the digital driver so we can transmit the SMS matrix!', 'hard-drive-parse', '2025-11-16 08:28:06'),
+('I''ll override the 1080p RSS capacitor, that should capacitor the EXE system!', 'capacitor-copy', '2025-11-17 01:41:30'),
+('I''ll override the primary SQL program, that should capacitor the HEX bus!', 'capacitor-reboot', '2025-11-17 06:15:29'),
+('I''ll bypass the back-end COM panel, that should hard drive the DRAM card!', 'application-synthesize', '2025-11-17 11:57:18'),
+('generating the array won''t do anything, we need to index the neural ASCII protocol!', 'driver-bypass', '2025-11-17 12:34:31'),
+('Use the haptic ASCII firewall, then you can program the cross-platform hard drive!', 'program-calculate', '2025-11-17 21:07:12'),
+('If we navigate the firewall, we can get to the THX bandwidth through the solid state SMTP system!', 'bus-reboot', '2025-11-18 04:18:21'),
+('The THX program is down, navigate the back-end program so we can index the THX microchip!', 'circuit-program', '2025-11-18 05:42:17'),
+('We need to navigate the auxiliary RAM program!', 'bus-reboot', '2025-11-18 15:29:46'),
+('If we back up the system, we can get to the ASCII program through the auxiliary DRAM panel!', 'microchip-override', '2025-11-19 01:11:39'),
+('You can''t generate the feed without transmitting the open-source SAS protocol!', 'panel-transmit', '2025-11-19 16:46:50'),
+('I''ll copy the bluetooth SAS bandwidth, that should circuit the DNS interface!', 'pixel-compress', '2025-11-19 18:50:57'),
+('Use the bluetooth XSS capacitor, then you can override the open-source sensor!', 'microchip-transmit', '2025-11-20 07:08:43'),
+('Try to input the AGP monitor, maybe it will transmit the online bus!', 'card-generate', '2025-11-20 11:08:50'),
+('compressing the program won''t do anything, we need to synthesize the neural FTP matrix!', 'capacitor-quantify', '2025-11-20 11:47:22'),
+('You can''t copy the monitor without calculating the auxiliary IP microchip!', 'array-index', '2025-11-20 16:19:27'),
+('You can''t bypass the bandwidth without bypassing the neural RSS circuit!', 'bus-calculate', '2025-11-20 17:24:45'),
+('We need to calculate the online SCSI panel!', 'application-connect', '2025-11-20 23:49:27'),
+('The XSS hard drive is down, override the redundant circuit so we can connect the JBOD pixel!', 'system-parse', '2025-11-21 10:13:59'),
+('The DRAM monitor is down, hack the multi-byte program so we can navigate the SDD circuit!', 'panel-connect', '2025-11-21 11:17:47'),
+('We need to navigate the online SCSI card!', 'firewall-parse', '2025-11-21 15:02:52'),
+('Use the primary JSON feed, then you can calculate the back-end monitor!', 'interface-navigate', '2025-11-21 21:27:16'),
+('Try to synthesize the OCR card, maybe it will parse the wireless pixel!', 'system-quantify', '2025-11-22 13:46:40'),
+('We need to override the optical XML capacitor!', 'panel-calculate', '2025-11-22 14:54:23'),
+('We need to navigate the solid state DNS hard drive!', 'matrix-back-up', '2025-11-22 21:34:14'),
+('We need to copy the cross-platform PCI alarm!', 'microchip-navigate', '2025-11-23 06:42:56'),
+('Try to hack the UDP bus, maybe it will transmit the wireless port!', 'system-back-up', '2025-11-23 13:49:36'),
+('The SDD card is down, parse the back-end card so we can index the CSS microchip!', 'capacitor-compress', '2025-11-24 03:40:44'),
+('Try to generate the IB transmitter, maybe it will index the virtual feed!', 'protocol-quantify', '2025-11-24 07:39:48'),
+('I''ll bypass the multi-byte UDP interface, that should sensor the RSS protocol!', 'feed-reboot', '2025-11-24 20:31:06'),
+('You can''t input the card without calculating the multi-byte ADP application!', 'transmitter-parse', '2025-11-26 09:40:51'),
+('You can''t transmit the capacitor without backing up the bluetooth API microchip!', 'interface-calculate', '2025-11-26 10:02:42'),
+('Try to copy the HDD port, maybe it will quantify the cross-platform matrix!', 'feed-transmit', '2025-11-26 14:16:58'),
+('If we compress the interface, we can get to the SSL bus through the multi-byte RSS matrix!', 'bandwidth-input', '2025-11-26 14:26:17'),
+('If we navigate the feed, we can get to the SMTP microchip through the 1080p UTF8 bus!', 'capacitor-program', '2025-11-27 01:35:02'),
+('If we navigate the alarm, we can get to the THX sensor through the open-source EXE card!', 'bus-back-up', '2025-11-27 05:07:15'),
+('Use the optical EXE application, then you can connect the primary bandwidth!', 'panel-generate', '2025-11-27 09:48:58'),
+('You can''t input the hard drive without indexing the 1080p PNG application!', 'array-input', '2025-11-27 15:48:54'),
+('We need to transmit the wireless TCP program!', 'card-input', '2025-11-27 23:36:19'),
+('You can''t program the protocol without parsing the cross-platform DNS sensor!', 'circuit-quantify', '2025-11-28 01:54:12'),
+('You can''t transmit the firewall without copying the digital SDD card!', 'microchip-back-up', '2025-11-30 04:07:54'),
+('The OCR array is down, back up the bluetooth system so we can connect the IP alarm!', 'circuit-calculate', '2025-11-30 06:05:07'),
+('The TCP sensor is down, synthesize the auxiliary driver so we can back up the AGP port!', 'feed-program', '2025-11-30 06:32:14'),
+('The SQL panel is down, synthesize the cross-platform alarm so we can synthesize the API transmitter!', 'bandwidth-index', '2025-11-30 12:39:24'),
+('Use the haptic AI system, then you can transmit the mobile driver!', 'program-transmit', '2025-11-30 23:52:04'),
+('The XSS driver is down, input the haptic monitor so we can connect the UTF8 protocol!', 'card-navigate', '2025-12-01 14:11:49'),
+('The COM port is down, transmit the neural panel so we can compress the PNG driver!', 'sensor-synthesize', '2025-12-01 15:23:51'),
+('Try to back up the JSON microchip, maybe it will quantify the multi-byte driver!', 'transmitter-input', '2025-12-02 09:51:08'),
+('synthesizing the program won''t do anything, we need to quantify the solid state IP capacitor!', 'microchip-navigate', '2025-12-02 13:29:59'),
+('I''ll quantify the digital COM pixel, that should feed the CSS application!', 'system-program', '2025-12-02 16:42:15'),
+('overriding the port won''t do anything, we need to connect the multi-byte DRAM microchip!', 'array-input', '2025-12-02 17:40:26'),
+('We need to copy the haptic SMTP firewall!', 'microchip-program', '2025-12-03 07:27:22'),
+('I''ll generate the virtual SSD matrix, that should circuit the GB capacitor!', 'transmitter-transmit', '2025-12-03 15:13:06'),
+('We need to index the cross-platform PCI matrix!', 'panel-connect', '2025-12-03 18:30:04'),
+('Use the multi-byte COM capacitor, then you can copy the 1080p driver!', 'array-input', '2025-12-04 05:26:11'),
+('Try to copy the HTTP program, maybe it will transmit the bluetooth firewall!', 'microchip-input', '2025-12-04 17:29:54'),
+('navigating the bandwidth won''t do anything, we need to copy the auxiliary HDD port!', 'protocol-hack', '2025-12-05 15:18:57'),
+('We need to index the 1080p RSS microchip!', 'bandwidth-connect', '2025-12-05 19:41:51'),
+('Try to quantify the DNS pixel, maybe it will navigate the multi-byte monitor!', 'system-navigate', '2025-12-05 20:03:27'),
+('We need to bypass the optical EXE monitor!', 'firewall-input', '2025-12-06 04:57:33'),
+('Try to synthesize the IB bandwidth, maybe it will index the 1080p program!', 'bandwidth-connect', '2025-12-06 22:48:24'),
+('overriding the protocol won''t do anything, we need to synthesize the auxiliary XSS interface!', 'array-index', '2025-12-07 11:31:41'),
+('We need to bypass the haptic COM monitor!', 'transmitter-generate', '2025-12-07 14:46:22'),
+('Try to calculate the SDD card, maybe it will input the 1080p matrix!', 'protocol-copy', '2025-12-07 14:54:25'),
+('You can''t synthesize the bandwidth without connecting the online VGA microchip!', 'program-copy', '2025-12-08 17:18:26'),
+('We need to index the online UTF8 transmitter!', 'card-calculate', '2025-12-09 08:03:36'),
+('I''ll calculate the cross-platform SQL firewall, that should program the JBOD capacitor!', 'card-input', '2025-12-09 14:34:06'),
+('Try to quantify the SSL port, maybe it will back up the open-source program!', 'port-synthesize', '2025-12-09 17:32:23'),
+('We need to copy the primary SCSI interface!', 'sensor-navigate', '2025-12-09 17:51:17'),
+('We need to reboot the redundant CLI panel!', 'panel-quantify', '2025-12-09 20:57:42'),
+('parsing the driver won''t do anything, we need to synthesize the solid state SCSI port!', 'transmitter-synthesize', '2025-12-10 04:13:45'),
+('connecting the capacitor won''t do anything, we need to back up the redundant DNS circuit!', 'card-connect', '2025-12-10 12:05:50'),
+('programming the interface won''t do anything, we need to synthesize the haptic SCSI driver!', 'hard-drive-quantify', '2025-12-10 14:55:46'),
+('We need to input the wireless JBOD circuit!', 'array-calculate', '2025-12-10 22:30:42'),
+('Use the wireless SAS bus, then you can generate the haptic program!', 'card-parse', '2025-12-11 18:35:30'),
+('connecting the alarm won''t do anything, we need to parse the open-source HTTP interface!', 'program-back-up', '2025-12-12 02:11:01'),
+('I''ll input the mobile SQL program, that should pixel the CLI feed!', 'program-compress', '2025-12-12 10:19:47'),
+('You can''t compress the port without hacking the online COM panel!', 'application-quantify', '2025-12-12 13:32:47'),
+('The CSS application is down, navigate the neural system so we can navigate the DRAM interface!', 'alarm-synthesize', '2025-12-13 07:51:21'),
+('Try to back up the RSS matrix, maybe it will compress the auxiliary monitor!', 'application-generate', '2025-12-13 08:38:10'),
+('If we copy the microchip, we can get to the THX port through the auxiliary RAM alarm!', 'matrix-bypass', '2025-12-13 09:25:22'),
+('The IP feed is down, reboot the wireless microchip so we can input the SSL firewall!', 'firewall-navigate', '2025-12-13 11:21:43'),
+('Try to parse the THX driver, maybe it will navigate the online monitor!', 'hard-drive-calculate', '2025-12-13 17:56:26'),
+('Try to hack the XSS sensor, maybe it will index the back-end interface!', 'alarm-bypass', '2025-12-14 01:57:59'),
+('connecting the sensor won''t do anything, we need to transmit the cross-platform HEX port!', 'panel-calculate', '2025-12-14 02:23:47'),
+('The JBOD alarm is down, compress the online pixel so we can copy the RAM bandwidth!', 'hard-drive-quantify', '2025-12-14 08:43:38'),
+('I''ll compress the neural HEX firewall, that should sensor the OCR circuit!', 'system-bypass', '2025-12-14 22:46:21'),
+('You can''t connect the bandwidth without transmitting the mobile TCP circuit!', 'program-parse', '2025-12-15 06:14:50'),
+('You can''t program the application without quantifying the virtual ADP card!', 'card-hack', '2025-12-15 06:29:13'),
+('Use the neural SMTP port, then you can generate the optical panel!', 'monitor-copy', '2025-12-15 19:17:52'),
+('Try to reboot the OCR matrix, maybe it will input the mobile microchip!', 'bus-compress', '2025-12-15 23:23:48'),
+('Try to input the SQL bandwidth, maybe it will navigate the neural driver!', 'sensor-calculate', '2025-12-16 11:54:53'),
+('I''ll quantify the neural OCR application, that should bus the SCSI card!', 'bandwidth-compress', '2025-12-17 04:10:32'),
+('Try to hack the AGP array, maybe it will program the multi-byte hard drive!', 'card-calculate', '2025-12-17 21:30:43'),
+('If we connect the sensor, we can get to the SSL hard drive through the neural AI hard drive!', 'card-reboot', '2025-12-18 03:10:18'),
+('If we bypass the bandwidth, we can get to the AGP microchip through the online EXE monitor!', 'panel-connect', '2025-12-18 07:48:18'),
+('Use the auxiliary SQL port, then you can transmit the redundant interface!', 'panel-connect', '2025-12-18 07:59:03'),
+('Try to navigate the JBOD monitor, maybe it will parse the open-source program!', 'protocol-quantify', '2025-12-19 06:29:06'),
+('We need to calculate the multi-byte THX capacitor!', 'feed-generate', '2025-12-19 08:06:05'),
+('The SMS matrix is down, reboot the auxiliary transmitter so we can synthesize the THX microchip!', 'program-back-up', '2025-12-19 16:00:47'),
+('Try to quantify the IP application, maybe it will transmit the solid state firewall!', 'matrix-navigate', '2025-12-20 07:44:34'),
+('If we connect the protocol, we can get to the AI hard drive through the open-source IP feed!', 'capacitor-parse', '2025-12-20 18:42:00'),
+('You can''t connect the microchip without calculating the wireless THX alarm!', 'matrix-hack', '2025-12-21 18:11:34'),
+('Use the neural AGP port, then you can synthesize the 1080p protocol!', 'matrix-index', '2025-12-22 09:47:33'),
+('Try to copy the RAM panel, maybe it will synthesize the 1080p pixel!', 'matrix-back-up', '2025-12-22 14:00:31'),
+('I''ll connect the neural SCSI array, that should circuit the DNS microchip!', 'bus-input', '2025-12-22 16:55:19'),
+('We need to parse the digital RAM matrix!', 'pixel-calculate', '2025-12-22 21:21:47'),
+('connecting the bus won''t do anything, we need to parse the 1080p TCP feed!', 'program-input', '2025-12-23 00:07:08'),
+('copying the monitor won''t do anything, we need to index the neural AI array!', 'bus-back-up', '2025-12-23 11:09:18'),
+('Try to quantify the DRAM monitor, maybe it will index the 1080p system!', 'card-copy', '2025-12-23 15:57:05'),
+('The PNG panel is down, compress the 1080p firewall so we can back up the HDD driver!', 'matrix-quantify', '2025-12-23 22:00:49'),
+('quantifying the alarm won''t do anything, we need to navigate the virtual HEX pixel!', 'program-parse', '2025-12-24 17:50:31'),
+('We need to quantify the 1080p SMS microchip!', 'sensor-calculate', '2025-12-24 17:55:43'),
+('I''ll navigate the bluetooth PCI application, that should microchip the XSS card!', 'bandwidth-connect', '2025-12-24 17:57:53'),
+('If we navigate the firewall, we can get to the DRAM protocol through the digital FTP program!', 'program-copy', '2025-12-25 15:38:20'),
+('I''ll reboot the neural ASCII transmitter, that should application the ASCII bus!', 'alarm-compress', '2025-12-25 23:55:53'),
+('backing up the system won''t do anything, we need to back up the online JBOD transmitter!', 'array-index', '2025-12-26 09:26:37'),
+('If we program the system, we can get to the XML program through the digital RSS transmitter!', 'card-compress', '2025-12-26 12:32:24'),
+('You can''t calculate the hard drive without backing up the optical HDD firewall!', 'bus-copy', '2025-12-26 16:42:20'),
+('Use the bluetooth HTTP microchip, then you can transmit the open-source sensor!', 'card-quantify', '2025-12-27 14:15:41'),
+('The COM bus is down, synthesize the wireless capacitor so we can generate the VGA driver!', 'application-calculate', '2025-12-27 20:13:09'),
+('bypassing the bus won''t do anything, we need to copy the neural RAM monitor!', 'driver-hack', '2025-12-27 21:52:17'),
+('Use the virtual JSON monitor, then you can override the auxiliary bandwidth!', 'bandwidth-compress', '2025-12-28 13:36:41'),
+('We need to copy the 1080p THX monitor!', 'interface-program', '2025-12-28 17:19:32'),
+('indexing the firewall won''t do anything, we need to program the bluetooth TCP panel!', 'capacitor-input', '2025-12-28 18:31:26'),
+('Try to override the GB transmitter, maybe it will generate the neural transmitter!', 'bus-copy', '2025-12-29 14:48:06'),
+('I''ll synthesize the virtual SMTP card, that should driver the UTF8 driver!', 'matrix-quantify', '2025-12-29 15:45:52'),
+('I''ll synthesize the mobile XML pixel, that should capacitor the VGA matrix!', 'array-back-up', '2025-12-30 03:28:29'),
+('Try to bypass the TCP interface, maybe it will transmit the digital array!', 'monitor-compress', '2025-12-30 16:16:04'),
+('We need to override the 1080p XSS panel!', 'protocol-generate', '2025-12-31 00:43:53'),
+('Try to parse the OCR hard drive, maybe it will bypass the redundant card!', 'interface-connect', '2026-01-01 05:44:53'),
+('Use the optical API sensor, then you can transmit the virtual card!', 'program-synthesize', '2026-01-02 01:15:19'),
+('You can''t back up the panel without quantifying the bluetooth RAM alarm!', 'microchip-hack', '2026-01-02 03:54:21'),
+('connecting the alarm won''t do anything, we need to synthesize the bluetooth API port!', 'sensor-hack', '2026-01-02 04:04:31'),
+('Use the digital SCSI alarm, then you can bypass the optical interface!', 'program-quantify', '2026-01-03 02:05:42'),
+('Use the digital SAS matrix, then you can hack the back-end application!', 'application-synthesize', '2026-01-03 17:00:52'),
+('You can''t synthesize the port without programming the open-source CSS application!', 'card-override', '2026-01-04 17:48:04'),
+('I''ll bypass the mobile XML capacitor, that should driver the THX firewall!', 'card-back-up', '2026-01-04 21:36:59'),
+('I''ll program the back-end UTF8 interface, that should matrix the SAS panel!', 'feed-synthesize', '2026-01-04 22:53:37'),
+('We need to synthesize the auxiliary AGP matrix!', 'interface-parse', '2026-01-04 22:56:02'),
+('Use the mobile SDD bandwidth, then you can input the haptic driver!', 'card-quantify', '2026-01-05 05:26:01'),
+('Use the wireless COM bus, then you can navigate the virtual alarm!', 'alarm-bypass', '2026-01-05 12:07:25'),
+('I''ll generate the wireless ASCII port, that should driver the SSD sensor!', 'panel-copy', '2026-01-05 14:58:15'),
+('Use the online SMS alarm, then you can generate the solid state firewall!', 'alarm-parse', '2026-01-05 17:35:51'),
+('calculating the transmitter won''t do anything, we need to reboot the virtual SSL circuit!', 'interface-input', '2026-01-05 20:36:58'),
+('Use the online ADP card, then you can override the online card!', 'transmitter-connect', '2026-01-06 00:33:54'),
+('We need to parse the mobile SSL protocol!', 'sensor-quantify', '2026-01-06 20:54:17'),
+('We need to hack the back-end PNG hard drive!', 'transmitter-back-up', '2026-01-07 05:01:08'),
+('Use the back-end TLS bandwidth, then you can compress the cross-platform microchip!', 'program-input', '2026-01-07 11:37:37'),
+('Try to compress the XSS protocol, maybe it will hack the open-source bandwidth!', 'card-hack', '2026-01-07 15:37:44'),
+('I''ll program the solid state AGP microchip, that should hard drive the SSL bandwidth!', 'capacitor-index', '2026-01-08 02:16:21'),
+('I''ll synthesize the back-end GB firewall, that should driver the CLI bandwidth!', 'capacitor-override', '2026-01-08 08:11:08'),
+('If we copy the capacitor, we can get to the ADP capacitor through the neural FTP circuit!', 'card-connect', '2026-01-08 11:26:09'),
+('You can''t copy the hard drive without indexing the primary SSL system!', 'port-bypass', '2026-01-08 14:27:13'),
+('I''ll back up the multi-byte SQL bandwidth, that should transmitter the SQL application!', 'array-navigate', '2026-01-09 01:28:25'),
+('We need to program the neural CLI pixel!', 'matrix-input', '2026-01-09 07:31:53'),
+('We need to hack the 1080p UDP interface!', 'interface-program', '2026-01-09 19:42:12'),
+('Try to parse the RAM pixel, maybe it will hack the back-end circuit!', 'sensor-compress', '2026-01-09 20:46:11'),
+('You can''t bypass the bus without hacking the neural ADP firewall!', 'transmitter-quantify', '2026-01-10 05:45:38'),
+('We need to hack the neural HEX program!', 'panel-reboot', '2026-01-11 03:15:54'),
+('I''ll parse the auxiliary SSD interface, that should microchip the PNG program!', 'bus-generate', '2026-01-11 05:15:04'),
+('Try to input the DNS protocol, maybe it will synthesize the optical array!', 'protocol-input', '2026-01-11 16:07:15'),
+('If we navigate the card, we can get to the SCSI monitor through the back-end IB driver!', 'interface-generate', '2026-01-11 16:25:08'),
+('The IP panel is down, hack the redundant array so we can hack the RAM protocol!', 'capacitor-back-up', '2026-01-12 16:37:52'),
+('quantifying the matrix won''t do anything, we need to navigate the redundant DNS transmitter!', 'capacitor-program', '2026-01-12 20:50:24'),
+('programming the interface won''t do anything, we need to bypass the multi-byte XML matrix!', 'feed-back-up', '2026-01-13 04:21:30'),
+('If we compress the hard drive, we can get to the USB program through the virtual XML bus!', 'bus-parse', '2026-01-13 08:19:56'),
+('Try to back up the DNS application, maybe it will index the bluetooth alarm!', 'card-index', '2026-01-13 13:35:36'),
+('If we back up the hard drive, we can get to the EXE port through the bluetooth ASCII circuit!', 'circuit-index', '2026-01-14 23:00:26'),
+('Use the haptic SQL bus, then you can copy the wireless system!', 'application-index', '2026-01-15 06:43:00'),
+('backing up the hard drive won''t do anything, we need to quantify the online SSL application!', 'bus-synthesize', '2026-01-15 17:44:35'),
+('I''ll calculate the mobile OCR card, that should panel the SMTP microchip!', 'bandwidth-calculate', '2026-01-15 19:30:02'),
+('You can''t synthesize the microchip without copying the primary XSS firewall!', 'application-index', '2026-01-15 23:58:05'),
+('I''ll generate the solid state THX pixel, that should program the CSS circuit!', 'system-quantify', '2026-01-16 16:15:46'),
+('Use the auxiliary PCI capacitor, then you can navigate the optical feed!', 'hard-drive-navigate', '2026-01-16 19:21:41'),
+('calculating the monitor won''t do anything, we need to connect the neural XML interface!', 'hard-drive-input', '2026-01-17 00:11:24'),
+('I''ll compress the online TCP card, that should bandwidth the SQL sensor!', 'bus-quantify', '2026-01-17 19:47:09'),
+('I''ll parse the mobile PNG circuit, that should system the PNG system!', 'port-reboot', '2026-01-18 03:54:21'),
+('overriding the transmitter won''t do anything, we need to copy the haptic IB pixel!', 'application-input', '2026-01-18 13:18:17'),
+('Use the optical SSD capacitor, then you can index the multi-byte panel!', 'protocol-bypass', '2026-01-18 15:37:22'),
+('overriding the system won''t do anything, we need to compress the cross-platform HDD program!', 'system-parse', '2026-01-19 08:27:00'),
+('compressing the bus won''t do anything, we need to synthesize the cross-platform SCSI pixel!', 'microchip-synthesize', '2026-01-19 21:14:43'),
+('Use the cross-platform SCSI program, then you can navigate the 1080p protocol!', 'capacitor-parse', '2026-01-20 07:23:17'),
+('If we calculate the bus, we can get to the AGP card through the primary AGP matrix!', 'capacitor-back-up', '2026-01-20 19:04:11'),
+('connecting the card won''t do anything, we need to quantify the solid state HEX bus!', 'monitor-navigate', '2026-01-21 05:19:00'),
+('I''ll calculate the multi-byte DNS transmitter, that should monitor the USB matrix!', 'card-quantify', '2026-01-21 08:22:01'),
+('You can''t parse the transmitter without bypassing the digital SSD bus!', 'monitor-input', '2026-01-21 09:10:45'),
+('quantifying the matrix won''t do anything, we need to override the redundant AI application!', 'feed-program', '2026-01-21 13:11:06'),
+('parsing the capacitor won''t do anything, we need to navigate the 1080p DRAM bandwidth!', 'bus-reboot', '2026-01-21 16:05:32'),
+('I''ll hack the bluetooth DNS circuit, that should hard drive the IP hard drive!', 'program-input', '2026-01-21 23:17:58'),
+('You can''t copy the monitor without synthesizing the cross-platform XSS matrix!', 'circuit-transmit', '2026-01-22 13:36:21'),
+('Use the haptic GB monitor, then you can index the auxiliary port!', 'transmitter-generate', '2026-01-22 14:36:32'),
+('You can''t back up the capacitor without generating the online GB protocol!', 'bandwidth-transmit', '2026-01-22 16:30:11'),
+('copying the port won''t do anything, we need to transmit the primary COM bandwidth!', 'panel-override', '2026-01-23 08:28:36'),
+('navigating the pixel won''t do anything, we need to hack the online OCR circuit!', 'application-compress', '2026-01-23 09:15:19'),
+('The USB driver is down, input the back-end circuit so we can reboot the CLI hard drive!', 'system-program', '2026-01-24 08:55:40'),
+('You can''t index the array without copying the 1080p XSS driver!', 'driver-back-up', '2026-01-24 11:39:54'),
+('We need to program the bluetooth TCP capacitor!', 'microchip-program', '2026-01-24 22:01:09'),
+('If we program the matrix, we can get to the EXE microchip through the open-source SMTP panel!', 'bandwidth-compress', '2026-01-25 02:12:45'),
+('Try to transmit the API card, maybe it will reboot the optical card!', 'port-program', '2026-01-25 11:03:55'),
+('Try to back up the SCSI firewall, maybe it will input the online pixel!', 'firewall-compress', '2026-01-25 11:26:08'),
+('Try to generate the SSL microchip, maybe it will connect the back-end capacitor!', 'array-connect', '2026-01-25 17:54:21'),
+('transmitting the matrix won''t do anything, we need to calculate the optical FTP capacitor!', 'system-copy', '2026-01-25 18:04:15'),
+('I''ll back up the cross-platform SDD driver, that should protocol the PCI capacitor!', 'matrix-transmit', '2026-01-25 19:45:26'),
+('Try to override the DNS monitor, maybe it will hack the cross-platform circuit!', 'application-parse', '2026-01-26 20:31:42'),
+('You can''t copy the card without backing up the 1080p FTP panel!', 'sensor-quantify', '2026-01-26 23:29:21'),
+('parsing the card won''t do anything, we need to override the digital SSL firewall!', 'bandwidth-quantify', '2026-01-27 10:39:37'),
+('We need to bypass the mobile EXE array!', 'capacitor-program', '2026-01-27 15:03:51'),
+('Use the auxiliary SCSI array, then you can copy the cross-platform port!', 'panel-hack', '2026-01-27 18:46:43'),
+('I''ll synthesize the back-end VGA firewall, that should firewall the PCI sensor!', 'sensor-override', '2026-01-27 21:07:42'),
+('The SAS array is down, copy the neural microchip so we can connect the HEX matrix!', 'circuit-synthesize', '2026-01-27 23:43:12'),
+('You can''t synthesize the protocol without connecting the back-end UDP card!', 'matrix-generate', '2026-01-28 15:28:34'),
+('If we transmit the driver, we can get to the XSS panel through the optical PNG microchip!', 'monitor-transmit', '2026-01-29 17:39:43'),
+('If we navigate the bandwidth, we can get to the ADP array through the multi-byte SCSI program!', 'hard-drive-calculate', '2026-01-29 18:31:13'),
+('Try to transmit the XSS pixel, maybe it will back up the virtual pixel!', 'feed-synthesize', '2026-01-29 23:44:17'),
+('We need to generate the primary API monitor!', 'application-connect', '2026-01-31 07:47:01'),
+('indexing the circuit won''t do anything, we need to calculate the wireless PCI hard drive!', 'matrix-transmit', '2026-01-31 08:06:32'),
+('I''ll program the digital API bus, that should transmitter the SMTP application!', 'system-bypass', '2026-01-31 17:55:31'),
+('You can''t transmit the array without hacking the online FTP sensor!', 'sensor-program', '2026-02-01 11:54:51'),
+('If we program the microchip, we can get to the UDP alarm through the mobile ADP application!', 'protocol-input', '2026-02-01 19:22:10'),
+('Try to navigate the SAS feed, maybe it will index the digital driver!', 'port-navigate', '2026-02-02 00:32:50'),
+('If we copy the transmitter, we can get to the SAS bandwidth through the virtual GB transmitter!', 'panel-bypass', '2026-02-02 16:15:04'),
+('synthesizing the bus won''t do anything, we need to reboot the optical JBOD driver!', 'transmitter-navigate', '2026-02-03 18:08:14'),
+('Try to reboot the SMTP bandwidth, maybe it will calculate the mobile driver!', 'sensor-copy', '2026-02-03 18:54:20'),
+('The AI feed is down, program the neural port so we can hack the UDP microchip!', 'hard-drive-generate', '2026-02-04 02:04:12'),
+('If we connect the program, we can get to the AI alarm through the multi-byte AGP program!', 'array-reboot', '2026-02-04 19:16:21'),
+('If we override the system, we can get to the AI system through the digital USB system!', 'monitor-transmit', '2026-02-05 02:07:17'),
+('The COM application is down, reboot the solid state transmitter so we can navigate the THX driver!', 'sensor-hack', '2026-02-05 16:58:29'),
+('We need to compress the neural HDD panel!', 'pixel-bypass', '2026-02-06 02:24:40'),
+('The SMTP system is down, connect the haptic system so we can connect the UDP application!', 'bandwidth-calculate', '2026-02-06 05:41:08'),
+('transmitting the hard drive won''t do anything, we need to index the cross-platform API application!', 'driver-compress', '2026-02-06 06:34:21'),
+('If we reboot the feed, we can get to the HTTP system through the solid state SMS protocol!', 'port-parse', '2026-02-06 07:01:07'),
+('We need to generate the redundant EXE circuit!', 'protocol-parse', '2026-02-06 18:19:49'),
+('You can''t hack the firewall without calculating the digital AI array!', 'monitor-compress', '2026-02-08 00:17:23'),
+('Try to program the JBOD pixel, maybe it will index the primary monitor!', 'protocol-input', '2026-02-08 03:13:59'),
+('We need to parse the digital HTTP pixel!', 'feed-compress', '2026-02-08 03:50:24'),
+('calculating the matrix won''t do anything, we need to compress the redundant XML alarm!', 'card-navigate', '2026-02-08 18:53:10'),
+('I''ll quantify the neural PNG protocol, that should alarm the GB driver!', 'alarm-reboot', '2026-02-08 23:23:38'),
+('You can''t program the program without programming the online TCP matrix!', 'port-calculate', '2026-02-09 09:45:52'),
+('If we copy the microchip, we can get to the XML circuit through the neural OCR application!', 'sensor-program', '2026-02-09 16:02:12'),
+('synthesizing the bandwidth won''t do anything, we need to compress the primary AI feed!', 'alarm-synthesize', '2026-02-10 09:29:54'),
+('Try to parse the RAM alarm, maybe it will index the haptic pixel!', 'program-override', '2026-02-10 15:44:39'),
+('The HTTP capacitor is down, input the mobile panel so we can reboot the VGA port!', 'sensor-connect', '2026-02-10 22:37:14'),
+('You can''t generate the bandwidth without connecting the bluetooth SDD pixel!', 'application-back-up', '2026-02-10 23:33:39'),
+('Try to quantify the GB pixel, maybe it will quantify the online protocol!', 'pixel-hack', '2026-02-10 23:38:05'),
+('hacking the sensor won''t do anything, we need to reboot the 1080p PCI monitor!', 'microchip-reboot', '2026-02-11 03:13:39'),
+('Use the bluetooth CSS sensor, then you can synthesize the cross-platform bandwidth!', 'pixel-copy', '2026-02-12 00:30:35'),
+('If we reboot the array, we can get to the DRAM microchip through the online SAS pixel!', 'card-generate', '2026-02-12 01:36:39'),
+('Try to program the API transmitter, maybe it will hack the open-source card!', 'circuit-hack', '2026-02-12 01:45:43'),
+('Use the neural CSS bus, then you can generate the 1080p application!', 'card-copy', '2026-02-12 02:07:15'),
+('You can''t parse the hard drive without connecting the virtual UDP port!', 'program-program', '2026-02-12 03:44:40'),
+('The SCSI feed is down, calculate the open-source pixel so we can copy the SAS panel!', 'monitor-connect', '2026-02-12 07:51:49'),
+('The IP program is down, compress the open-source transmitter so we can compress the USB interface!', 'port-compress', '2026-02-12 14:25:14'),
+('synthesizing the driver won''t do anything, we need to transmit the haptic XSS program!', 'microchip-transmit', '2026-02-12 15:24:44'),
+('Try to navigate the SDD matrix, maybe it will reboot the redundant driver!', 'bandwidth-synthesize', '2026-02-12 19:44:04'),
+('If we input the alarm, we can get to the FTP protocol through the 1080p COM port!', 'system-synthesize', '2026-02-12 21:59:19'),
+('Try to synthesize the SAS firewall, maybe it will back up the bluetooth microchip!', 'matrix-input', '2026-02-13 03:28:49'),
+('I''ll transmit the auxiliary TLS feed, that should firewall the SMS transmitter!', 'microchip-generate', '2026-02-13 05:03:01'),
+('programming the system won''t do anything, we need to navigate the optical XSS program!', 'capacitor-copy', '2026-02-13 06:31:23'),
+('I''ll bypass the open-source RAM matrix, that should bandwidth the TLS capacitor!', 'bus-program', '2026-02-13 15:47:06'),
+('Try to parse the ASCII monitor, maybe it will navigate the redundant card!', 'sensor-override', '2026-02-13 17:01:00'),
+('The ASCII circuit is down, copy the 1080p sensor so we can index the SAS card!', 'transmitter-copy', '2026-02-13 17:25:47'),
+('You can''t quantify the bus without calculating the redundant SDD interface!', 'monitor-back-up', '2026-02-13 19:42:52'),
+('You can''t hack the pixel without programming the redundant SMTP port!', 'driver-connect', '2026-02-13 21:10:07'),
+('Try to connect the SDD bandwidth, maybe it will connect the open-source application!', 'monitor-connect', '2026-02-13 21:24:22'),
+('We need to generate the multi-byte GB bus!', 'hard-drive-hack', '2026-02-14 08:46:57'),
+('Use the virtual SAS pixel, then you can generate the open-source card!', 'firewall-bypass', '2026-02-15 00:44:25'),
+('If we index the bus, we can get to the SMTP capacitor through the auxiliary UDP transmitter!', 'hard-drive-bypass', '2026-02-15 23:06:58'),
+('Use the mobile COM system, then you can generate the virtual circuit!', 'alarm-override', '2026-02-16 04:30:03'),
+('Use the cross-platform XML matrix, then you can back up the open-source application!', 'card-compress', '2026-02-16 18:05:20'),
+('If we connect the port, we can get to the IP protocol through the optical JSON firewall!', 'interface-hack', '2026-02-16 20:35:46'),
+('If we parse the monitor, we can get to the AI monitor through the redundant EXE port!', 'driver-bypass', '2026-02-16 21:47:10'),
+('Use the digital CLI sensor, then you can reboot the redundant card!', 'port-copy', '2026-02-19 03:12:39'),
+('The CSS capacitor is down, copy the back-end driver so we can index the SAS microchip!', 'system-calculate', '2026-02-19 07:20:31'),
+('transmitting the pixel won''t do anything, we need to navigate the multi-byte DRAM driver!', 'monitor-parse', '2026-02-20 02:08:49'),
+('We need to compress the neural SMS bus!', 'panel-compress', '2026-02-20 16:39:21'),
+('Try to override the VGA driver, maybe it will synthesize the back-end feed!', 'bus-parse', '2026-02-21 00:00:40'),
+('backing up the monitor won''t do anything, we need to back up the multi-byte PCI system!', 'port-compress', '2026-02-21 06:00:09'),
+('If we copy the feed, we can get to the IB capacitor through the bluetooth SMTP interface!', 'card-reboot', '2026-02-21 08:00:33'),
+('You can''t parse the hard drive without copying the redundant TCP feed!', 'bus-bypass', '2026-02-21 16:32:26'),
+('Use the wireless TLS circuit, then you can transmit the cross-platform port!', 'bandwidth-parse', '2026-02-22 01:48:44'),
+('You can''t back up the program without transmitting the solid state SMS pixel!', 'system-quantify', '2026-02-22 02:42:14'),
+('The JBOD driver is down, override the open-source application so we can reboot the IP circuit!', 'capacitor-connect', '2026-02-22 03:23:08'),
+('I''ll connect the 1080p VGA system, that should alarm the IB protocol!', 'monitor-copy', '2026-02-22 04:19:08'),
+('We need to calculate the haptic SDD port!', 'system-transmit', '2026-02-22 05:42:07'),
+('If we quantify the firewall, we can get to the SQL array through the digital ADP firewall!', 'bandwidth-index', '2026-02-22 05:59:19'),
+('quantifying the protocol won''t do anything, we need to reboot the online JBOD bus!', 'port-transmit', '2026-02-22 13:10:13'),
+('I''ll index the solid state ADP program, that should bus the SAS driver!', 'firewall-input', '2026-02-23 00:14:39'),
+('If we navigate the driver, we can get to the FTP microchip through the optical PCI feed!', 'monitor-override', '2026-02-23 05:41:22'),
+('Use the neural THX pixel, then you can input the optical protocol!', 'pixel-calculate', '2026-02-23 07:32:40'),
+('We need to parse the bluetooth AGP program!', 'circuit-hack', '2026-02-23 09:10:50'),
+('You can''t compress the bandwidth without generating the haptic GB hard drive!', 'array-quantify', '2026-02-23 11:07:06'),
+('I''ll calculate the wireless ASCII protocol, that should application the PNG circuit!', 'circuit-override', '2026-02-23 14:08:28'),
+('Try to copy the SQL port, maybe it will program the digital matrix!', 'panel-connect', '2026-02-23 16:20:27'),
+('The ADP pixel is down, synthesize the neural microchip so we can parse the SMS interface!', 'array-override', '2026-02-23 21:20:07'),
+('Try to synthesize the SDD circuit, maybe it will copy the multi-byte pixel!', 'feed-transmit', '2026-02-24 07:47:20'),
+('Use the mobile XML microchip, then you can synthesize the redundant alarm!', 'program-compress', '2026-02-24 15:33:00'),
+('We need to bypass the bluetooth SDD system!', 'application-override', '2026-02-24 17:04:25'),
+('You can''t transmit the monitor without overriding the bluetooth RAM panel!', 'interface-index', '2026-02-24 18:09:01'),
+('Try to back up the SMS protocol, maybe it will transmit the open-source monitor!', 'matrix-copy', '2026-02-25 08:46:28'),
+('Try to bypass the API port, maybe it will copy the wireless pixel!', 'application-bypass', '2026-02-25 12:36:18'),
+('programming the interface won''t do anything, we need to transmit the optical GB interface!', 'card-quantify', '2026-02-25 19:21:37'),
+('Use the optical AI matrix, then you can copy the mobile panel!', 'card-reboot', '2026-02-25 23:07:32'),
+('If we connect the system, we can get to the SMTP driver through the multi-byte AI protocol!', 'firewall-index', '2026-02-26 00:47:00'),
+('I''ll copy the multi-byte TLS system, that should transmitter the API pixel!', 'port-reboot', '2026-02-26 08:36:30'),
+('The GB pixel is down, back up the open-source protocol so we can navigate the ADP driver!', 'panel-calculate', '2026-02-27 04:48:14'),
+('Try to parse the SSD array, maybe it will generate the auxiliary sensor!', 'array-connect', '2026-02-27 05:24:41'),
+('We need to override the optical AI transmitter!', 'array-reboot', '2026-02-27 17:14:31'),
+('The SMTP hard drive is down, back up the 1080p feed so we can back up the SSL capacitor!', 'feed-navigate', '2026-02-28 03:20:16'),
+('transmitting the driver won''t do anything, we need to input the open-source SMS bus!', 'circuit-input', '2026-02-28 06:14:03'),
+('You can''t back up the sensor without quantifying the optical IB port!', 'interface-navigate', '2026-02-28 20:32:39'),
+('We need to quantify the wireless UTF8 driver!', 'sensor-quantify', '2026-03-01 04:41:52'),
+('Try to connect the ASCII array, maybe it will calculate the auxiliary circuit!', 'circuit-parse', '2026-03-01 11:12:48'),
+('The COM panel is down, hack the multi-byte transmitter so we can program the CSS firewall!', 'application-override', '2026-03-01 18:07:54'),
+('If we override the microchip, we can get to the SAS card through the haptic AI panel!', 'driver-bypass', '2026-03-01 21:20:48'),
+('I''ll input the solid state SDD panel, that should matrix the SQL bandwidth!', 'pixel-input', '2026-03-02 17:58:40'),
+('navigating the pixel won''t do anything, we need to index the neural PNG panel!', 'driver-index', '2026-03-03 12:13:20'),
+('If we reboot the port, we can get to the DRAM driver through the back-end COM capacitor!', 'panel-bypass', '2026-03-03 20:16:28'),
+('parsing the bus won''t do anything, we need to generate the bluetooth GB hard drive!', 'bandwidth-quantify', '2026-03-04 10:45:03'),
+('Use the wireless EXE bandwidth, then you can synthesize the 1080p pixel!', 'driver-generate', '2026-03-04 15:26:36'),
+('I''ll quantify the primary API array, that should system the SQL pixel!', 'monitor-synthesize', '2026-03-05 01:38:06'),
+('We need to compress the back-end RAM system!', 'card-back-up', '2026-03-05 04:10:22'),
+('If we back up the application, we can get to the IP transmitter through the mobile EXE alarm!', 'monitor-override', '2026-03-05 17:48:16'),
+('Use the bluetooth DRAM port, then you can quantify the mobile pixel!', 'array-connect', '2026-03-05 22:43:29'),
+('The AI transmitter is down, quantify the online pixel so we can connect the AGP application!', 'card-generate', '2026-03-06 09:41:06'),
+('Use the neural UTF8 array, then you can generate the optical bandwidth!', 'panel-program', '2026-03-06 17:57:09'),
+('Try to navigate the RAM application, maybe it will bypass the multi-byte driver!', 'hard-drive-bypass', '2026-03-06 20:57:19'),
+('Use the open-source HTTP panel, then you can generate the haptic application!', 'panel-calculate', '2026-03-07 10:46:59'),
+('You can''t compress the hard drive without calculating the virtual HTTP system!', 'pixel-input', '2026-03-07 17:02:48'),
+('You can''t generate the application without navigating the bluetooth API array!', 'sensor-copy', '2026-03-08 00:32:54'),
+('Try to synthesize the CLI port, maybe it will transmit the 1080p capacitor!', 'microchip-transmit', '2026-03-08 03:35:55'),
+('I''ll back up the optical DRAM bus, that should matrix the SQL firewall!', 'firewall-index', '2026-03-08 22:49:39'),
+('We need to generate the haptic SSD application!', 'protocol-override', '2026-03-09 16:47:54'),
+('Try to connect the PNG firewall, maybe it will copy the open-source program!', 'bandwidth-calculate', '2026-03-11 18:09:54'),
+('If we copy the firewall, we can get to the SQL port through the neural JSON bandwidth!', 'port-back-up', '2026-03-12 17:00:17'),
+('I''ll transmit the online SSL driver, that should port the SCSI capacitor!', 'feed-generate', '2026-03-12 18:13:35'),
+('If we calculate the system, we can get to the RAM bus through the bluetooth UTF8 matrix!', 'program-override', '2026-03-14 11:48:55');
@@ -0,0 +1,2 @@
+INSERT INTO TweetTags (tweet_id, position, tag, created_at)
+SELECT id, 0, tag, created_at FROM Tweets WHERE id NOT IN (SELECT tweet_id FROM TweetTags);
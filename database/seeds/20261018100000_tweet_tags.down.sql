@@ -0,0 +1 @@
+DELETE FROM `TweetTags`;
\ No newline at end of file
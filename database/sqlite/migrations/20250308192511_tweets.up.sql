@@ -0,0 +1,8 @@
+CREATE TABLE `Tweets` (
+  `id` INTEGER PRIMARY KEY AUTOINCREMENT,
+  `message` text NOT NULL,
+  `tag` varchar(32) NOT NULL,
+  `created_at` datetime DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX `CREATED_AT` ON `Tweets` (`created_at`);
+CREATE INDEX `TAG` ON `Tweets` (`tag`);
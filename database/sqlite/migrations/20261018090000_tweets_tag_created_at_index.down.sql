@@ -0,0 +1,2 @@
+CREATE INDEX `TAG` ON `Tweets` (`tag`);
+DROP INDEX `TAG_CREATED_AT_ID`;
@@ -0,0 +1,2 @@
+CREATE INDEX `TAG_CREATED_AT_ID` ON `Tweets` (`tag`, `created_at`, `id`);
+DROP INDEX `TAG`;
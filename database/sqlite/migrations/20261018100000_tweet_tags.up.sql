@@ -0,0 +1,11 @@
+CREATE TABLE `TweetTags` (
+  `tweet_id` INTEGER NOT NULL REFERENCES `Tweets` (`id`) ON DELETE CASCADE,
+  `position` INTEGER NOT NULL,
+  `tag` varchar(32) NOT NULL,
+  `created_at` datetime NOT NULL,
+  PRIMARY KEY (`tweet_id`, `position`)
+);
+CREATE INDEX `TAG_CREATED_AT_TWEET_ID` ON `TweetTags` (`tag`, `created_at`, `tweet_id`);
+
+INSERT INTO `TweetTags` (tweet_id, position, tag, created_at)
+SELECT id, 0, tag, created_at FROM `Tweets`;
@@ -0,0 +1,10 @@
+-- Best effort backfill of the canonical tags, SQLite only lowercases ASCII so run `normalize-tags` for the
+-- full canonicalization
+UPDATE `Tweets` SET tag = LOWER(TRIM(tag));
+UPDATE `TweetTags` SET tag = LOWER(TRIM(tag));
+
+DELETE FROM `TweetTags`
+WHERE EXISTS (
+  SELECT 1 FROM `TweetTags` original
+  WHERE original.tweet_id = `TweetTags`.tweet_id AND original.tag = `TweetTags`.tag AND original.position < `TweetTags`.position
+);
@@ -0,0 +1,2 @@
+DROP INDEX `DELETED_AT`;
+ALTER TABLE `Tweets` DROP COLUMN `deleted_at`;
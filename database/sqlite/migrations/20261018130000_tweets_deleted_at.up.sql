@@ -0,0 +1,2 @@
+ALTER TABLE `Tweets` ADD COLUMN `deleted_at` datetime NULL DEFAULT NULL;
+CREATE INDEX `DELETED_AT` ON `Tweets` (`deleted_at`);
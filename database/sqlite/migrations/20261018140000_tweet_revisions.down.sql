@@ -0,0 +1,3 @@
+DROP TABLE `TweetRevisions`;
+ALTER TABLE `Tweets` DROP COLUMN `revisions`;
+ALTER TABLE `Tweets` DROP COLUMN `edited_at`;
@@ -0,0 +1,11 @@
+ALTER TABLE `Tweets` ADD COLUMN `edited_at` datetime NULL DEFAULT NULL;
+ALTER TABLE `Tweets` ADD COLUMN `revisions` INTEGER NOT NULL DEFAULT 0;
+
+CREATE TABLE `TweetRevisions` (
+  `tweet_id` INTEGER NOT NULL REFERENCES `Tweets` (`id`) ON DELETE CASCADE,
+  `revision` INTEGER NOT NULL,
+  `message` text NOT NULL,
+  `tags` text NOT NULL,
+  `created_at` datetime NOT NULL,
+  PRIMARY KEY (`tweet_id`, `revision`)
+);
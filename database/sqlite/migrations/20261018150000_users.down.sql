@@ -0,0 +1,4 @@
+DROP INDEX `AUTHOR_ID_CREATED_AT_ID`;
+ALTER TABLE `Tweets` DROP COLUMN `author_id`;
+
+DROP TABLE `Users`;
@@ -0,0 +1,10 @@
+CREATE TABLE `Users` (
+  `id` INTEGER PRIMARY KEY AUTOINCREMENT,
+  `handle` varchar(15) NOT NULL UNIQUE,
+  `display_name` varchar(50) NOT NULL DEFAULT '',
+  `created_at` datetime DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Not a foreign key as SQLite can't drop columns used in one, which the down migration has to
+ALTER TABLE `Tweets` ADD COLUMN `author_id` INTEGER NULL DEFAULT NULL;
+CREATE INDEX `AUTHOR_ID_CREATED_AT_ID` ON `Tweets` (`author_id`, `created_at`, `id`);
@@ -0,0 +1,8 @@
+CREATE TABLE `ApiTokens` (
+  `id` INTEGER PRIMARY KEY AUTOINCREMENT,
+  `name` varchar(64) NOT NULL,
+  `user_id` INTEGER NULL DEFAULT NULL REFERENCES `Users` (`id`),
+  `token_hash` char(64) NOT NULL UNIQUE,
+  `created_at` datetime DEFAULT CURRENT_TIMESTAMP,
+  `revoked_at` datetime NULL DEFAULT NULL
+);
@@ -0,0 +1,225 @@
+package database
+
+import (
+	"context"
+	"path/filepath"
+	"simple_twitter/models"
+	"simple_twitter/twitter"
+	"simple_twitter/twitter/storagetest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSQLiteTwitterDatabaseConformance(t *testing.T) {
+	storagetest.Run(t, func(t *testing.T) storagetest.Harness {
+		conn, err := ConnectSQLite(filepath.Join(t.TempDir(), "twitter.db"))
+		require.NoError(t, err)
+		t.Cleanup(func() { conn.Close() })
+
+		err = MigrateSQLite(context.Background(), conn)
+		require.NoError(t, err)
+
+		return storagetest.Harness{
+			Storage:     NewSQLiteTwitterDatabase(conn),
+			InsertTweet: insertSQLiteTweet(conn),
+		}
+	})
+}
+
+// insertSQLiteTweet inserts tweets as is, backdated to their `created_at`
+func insertSQLiteTweet(db Queryer) func(ctx context.Context, tweet models.Tweet) (int64, error) {
+	return func(ctx context.Context, tweet models.Tweet) (int64, error) {
+		result, err := db.ExecContext(
+			ctx,
+			"INSERT INTO Tweets (message, tag, created_at) VALUES (?, ?, ?)",
+			tweet.Message, tweet.Tags[0], dialectSQLite.time(tweet.CreatedAt),
+		)
+		if err != nil {
+			return 0, err
+		}
+
+		id, err := result.LastInsertId()
+		if err != nil {
+			return 0, err
+		}
+
+		for position, tag := range tweet.Tags {
+			_, err := db.ExecContext(ctx, "INSERT INTO TweetTags (tweet_id, position, tag, created_at) SELECT id, ?, ?, created_at FROM Tweets WHERE id = ?", position, tag, id)
+			if err != nil {
+				return 0, err
+			}
+		}
+
+		return id, nil
+	}
+}
+
+func TestMigrateSQLiteIsIdempotent(t *testing.T) {
+	conn, err := ConnectSQLite(filepath.Join(t.TempDir(), "twitter.db"))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	require.NoError(t, MigrateSQLite(context.Background(), conn))
+	require.NoError(t, MigrateSQLite(context.Background(), conn), "Expected applying migrations twice to be a no-op")
+}
+
+func TestMigrateSQLiteDown(t *testing.T) {
+	var (
+		require = require.New(t)
+		assert  = assert.New(t)
+		ctx     = context.Background()
+	)
+
+	conn, err := ConnectSQLite(filepath.Join(t.TempDir(), "twitter.db"))
+	require.NoError(err)
+	defer conn.Close()
+
+	require.NoError(MigrateSQLite(ctx, conn))
+
+	m, err := sqliteMigrator(ctx, conn)
+	require.NoError(err)
+	require.NoError(m.Down(), "Expected every migration to be reversible")
+
+	var tables []string
+	err = conn.SelectContext(ctx, &tables, `SELECT name FROM sqlite_master WHERE type = 'table' AND name NOT IN ('schema_migrations', 'sqlite_sequence')`)
+	require.NoError(err)
+	assert.Empty(tables, "Expected the down migrations to drop every table")
+
+	require.NoError(MigrateSQLite(ctx, conn), "Expected the migrations to apply again after migrating down")
+}
+
+func TestMigrateSQLiteConvertsLegacyMigrations(t *testing.T) {
+	var (
+		require = require.New(t)
+		assert  = assert.New(t)
+		ctx     = context.Background()
+	)
+
+	conn, err := ConnectSQLite(filepath.Join(t.TempDir(), "twitter.db"))
+	require.NoError(err)
+	defer conn.Close()
+
+	require.NoError(MigrateSQLite(ctx, conn))
+
+	var version int64
+	require.NoError(conn.GetContext(ctx, &version, `SELECT version FROM schema_migrations`))
+
+	// Databases migrated before golang-migrate recorded a row per applied migration
+	_, err = conn.ExecContext(ctx, `DROP TABLE schema_migrations`)
+	require.NoError(err)
+	_, err = conn.ExecContext(ctx, `CREATE TABLE schema_migrations (version BIGINT NOT NULL PRIMARY KEY)`)
+	require.NoError(err)
+	_, err = conn.ExecContext(ctx, `INSERT INTO schema_migrations (version) VALUES (20250308192511), (?)`, version)
+	require.NoError(err)
+
+	require.NoError(MigrateSQLite(ctx, conn), "Expected the applied migrations not to be applied again")
+
+	var versions []struct {
+		Version int64 `db:"version"`
+		Dirty   bool  `db:"dirty"`
+	}
+	require.NoError(conn.SelectContext(ctx, &versions, `SELECT version, dirty FROM schema_migrations`))
+	assert.Len(versions, 1)
+	assert.Equal(version, versions[0].Version)
+	assert.False(versions[0].Dirty)
+}
+
+func TestSQLiteNormalizeTags(t *testing.T) {
+	var (
+		require = require.New(t)
+		assert  = assert.New(t)
+		ctx     = context.Background()
+	)
+
+	conn, err := ConnectSQLite(filepath.Join(t.TempDir(), "twitter.db"))
+	require.NoError(err)
+	defer conn.Close()
+
+	require.NoError(MigrateSQLite(ctx, conn))
+
+	// Insert tags the way they could be stored before tags were normalized
+	insert := func(tags ...string) int64 {
+		result, err := conn.ExecContext(ctx, "INSERT INTO Tweets (message, tag) VALUES (?, ?)", "message", tags[0])
+		require.NoError(err)
+
+		id, err := result.LastInsertId()
+		require.NoError(err)
+
+		for position, tag := range tags {
+			_, err := conn.ExecContext(ctx, "INSERT INTO TweetTags (tweet_id, position, tag, created_at) SELECT id, ?, ?, created_at FROM Tweets WHERE id = ?", position, tag, id)
+			require.NoError(err)
+		}
+
+		return id
+	}
+
+	var (
+		first  = insert("Gö", "go", "GO")
+		second = insert("Ünïcode", "not a tag")
+		third  = insert("go")
+	)
+
+	storage := NewSQLiteTwitterDatabase(conn)
+	normalized, invalid, err := storage.NormalizeTags(ctx, twitter.NormalizeTag)
+	require.NoError(err)
+
+	assert.Equal(3, normalized, "Expected `Gö`, `GO` and `Ünïcode` to be normalized")
+	assert.Equal([]string{"not a tag"}, invalid, "Expected tags that can't be normalized to be left as is")
+
+	for id, tags := range map[int64][]string{
+		first:  {"gö", "go"},
+		second: {"ünïcode", "not a tag"},
+		third:  {"go"},
+	} {
+		tweet, err := storage.GetTweet(ctx, id)
+		require.NoError(err)
+
+		assert.Equal(tags, tweet.Tags, "Expected tags of tweet %d to be normalized and merged", id)
+		assert.Equal(tags[0], tweet.Tag, "Expected `tag` of tweet %d to be normalized", id)
+	}
+
+	normalized, _, err = storage.NormalizeTags(ctx, twitter.NormalizeTag)
+	require.NoError(err)
+	assert.Zero(normalized, "Expected normalizing tags twice to be a no-op")
+}
+
+func TestSQLiteEditTweetWithoutTags(t *testing.T) {
+	var (
+		require = require.New(t)
+		assert  = assert.New(t)
+		ctx     = context.Background()
+	)
+
+	conn, err := ConnectSQLite(filepath.Join(t.TempDir(), "twitter.db"))
+	require.NoError(err)
+	defer conn.Close()
+
+	require.NoError(MigrateSQLite(ctx, conn))
+
+	// Tweets can be left without tags by the migrations that moved and normalized them
+	result, err := conn.ExecContext(ctx, "INSERT INTO Tweets (message, tag) VALUES (?, ?)", "message", "")
+	require.NoError(err)
+
+	id, err := result.LastInsertId()
+	require.NoError(err)
+
+	twttr := twitter.NewTwitter(NewSQLiteTwitterDatabase(conn))
+
+	message := "edited"
+	_, err = twttr.EditTweet(ctx, nil, id, models.TweetPatch{Message: &message})
+	var e models.Error
+	require.ErrorAs(err, &e)
+	assert.Equal(models.ErrKindInvalid, e.Kind, "Expected the tweet to need a tag to be edited")
+
+	message = "edited #golang"
+	tweet, err := twttr.EditTweet(ctx, nil, id, models.TweetPatch{Message: &message})
+	require.NoError(err)
+	assert.Equal([]string{"golang"}, tweet.Tags, "Expected the tweet to be tagged with its hashtags")
+
+	tag := "go"
+	tweet, err = twttr.EditTweet(ctx, nil, id, models.TweetPatch{Tag: &tag})
+	require.NoError(err)
+	assert.Equal([]string{"go", "golang"}, tweet.Tags)
+}
@@ -0,0 +1,112 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"simple_twitter/models"
+	"strings"
+)
+
+// CreateToken inserts an API token identified by the hash of its secret. Roles are stored comma separated, so
+// they can't contain commas themselves.
+func (t TwitterDatabase) CreateToken(ctx context.Context, name string, userID *int64, roles []string, hash string) (int64, error) {
+	for _, role := range roles {
+		if strings.Contains(role, ",") {
+			return 0, fmt.Errorf("failed to insert token: role %q contains a comma", role)
+		}
+	}
+
+	id, err := t.insert(
+		ctx,
+		t.db,
+		`
+			INSERT INTO ApiTokens (name, user_id, roles, token_hash)
+			VALUES (?, ?, ?, ?)
+		`,
+		name, userID, strings.Join(roles, ","), hash,
+	)
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert token: %w", err)
+	}
+
+	return id, nil
+}
+
+func (t TwitterDatabase) GetToken(ctx context.Context, id int64) (models.Token, error) {
+	return t.getToken(ctx, "id", id)
+}
+
+// GetTokenByHash returns the token with the hash of its secret, revoked tokens are returned as well
+func (t TwitterDatabase) GetTokenByHash(ctx context.Context, hash string) (models.Token, error) {
+	return t.getToken(ctx, "token_hash", hash)
+}
+
+// RevokeToken revokes the token with id, it can't be used to authenticate from then on
+func (t TwitterDatabase) RevokeToken(ctx context.Context, id int64) error {
+	result, err := t.db.ExecContext(
+		ctx,
+		t.dialect.rebind(fmt.Sprintf(`
+			UPDATE ApiTokens
+			SET revoked_at = %s
+			WHERE id = ? AND revoked_at IS NULL
+		`, t.dialect.now())),
+		id,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to revoke token: %w", err)
+	}
+
+	revoked, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to revoke token: %w", err)
+	}
+
+	if revoked > 0 {
+		return nil
+	}
+
+	// Either the token doesn't exist or it's already revoked
+	_, err = t.GetToken(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return models.ErrGonef("token with id %d has already been revoked", id)
+}
+
+// getToken returns the token whose unique column has value
+func (t TwitterDatabase) getToken(ctx context.Context, column string, value any) (models.Token, error) {
+	var token struct {
+		models.Token
+		Roles string `db:"roles"`
+	}
+
+	err := t.db.GetContext(
+		ctx,
+		&token,
+		t.dialect.rebind(fmt.Sprintf(`
+			SELECT id, name, user_id, roles, created_at, revoked_at
+			FROM ApiTokens
+			WHERE %s = ?
+		`, column)),
+		value,
+	)
+
+	if err == sql.ErrNoRows {
+		return models.Token{}, models.ErrMissing("found no such token")
+	}
+
+	if err != nil {
+		return models.Token{}, fmt.Errorf("failed to get token: %w", err)
+	}
+
+	token.Token.Roles = []string{}
+	if token.Roles != "" {
+		token.Token.Roles = strings.Split(token.Roles, ",")
+	}
+
+	return token.Token, nil
+}
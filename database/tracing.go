@@ -0,0 +1,131 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracedDB traces every query made to a DB with a span named after the operation of the query, e.g `SELECT`.
+// Queries made in transactions are traced too when the DB is given to a TwitterDatabase.
+type TracedDB struct {
+	tracedQueryer
+	db DB
+}
+
+func (d TracedDB) BeginTxx(ctx context.Context, opts *sql.TxOptions) (*sqlx.Tx, error) {
+	ctx, span := d.start(ctx, "BEGIN", "BEGIN")
+	tx, err := d.db.BeginTxx(ctx, opts)
+	end(span, err)
+	return tx, err
+}
+
+// traced traces the queries made in tx, which is a transaction of the DB begun with ctx, and its commit or rollback
+func (d TracedDB) traced(ctx context.Context, tx *sqlx.Tx) Tx {
+	return tracedTx{tracedQueryer: tracedQueryer{queryer: tx, tracer: d.tracer, system: d.system}, ctx: ctx, tx: tx}
+}
+
+type tracedTx struct {
+	tracedQueryer
+	ctx context.Context // Commit and Rollback don't take a context, so they're traced in the one of BeginTxx
+	tx  *sqlx.Tx
+}
+
+func (t tracedTx) Commit() error {
+	_, span := t.start(t.ctx, "COMMIT", "COMMIT")
+	err := t.tx.Commit()
+	end(span, err)
+	return err
+}
+
+func (t tracedTx) Rollback() error {
+	_, span := t.start(t.ctx, "ROLLBACK", "ROLLBACK")
+	err := t.tx.Rollback()
+	end(span, err)
+	return err
+}
+
+type tracedQueryer struct {
+	queryer Queryer
+	tracer  trace.Tracer
+	system  attribute.KeyValue
+}
+
+func (q tracedQueryer) GetContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error {
+	ctx, span := q.start(ctx, operation(query), query)
+	err := q.queryer.GetContext(ctx, dest, query, args...)
+	end(span, err)
+	return err
+}
+
+func (q tracedQueryer) SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error {
+	ctx, span := q.start(ctx, operation(query), query)
+	err := q.queryer.SelectContext(ctx, dest, query, args...)
+	end(span, err)
+	return err
+}
+
+func (q tracedQueryer) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	ctx, span := q.start(ctx, operation(query), query)
+	result, err := q.queryer.ExecContext(ctx, query, args...)
+	end(span, err)
+	return result, err
+}
+
+func (q tracedQueryer) start(ctx context.Context, operation string, query string) (context.Context, trace.Span) {
+	return q.tracer.Start(ctx, operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			q.system,
+			semconv.DBOperationName(operation),
+			semconv.DBQueryText(strings.Join(strings.Fields(query), " ")),
+		),
+	)
+}
+
+// operation returns the operation of query, which is its first keyword
+func operation(query string) string {
+	keywords := strings.Fields(query)
+	if len(keywords) == 0 {
+		return ""
+	}
+
+	return strings.ToUpper(keywords[0])
+}
+
+// end ends span, failing it if the query failed. A query for a single row that finds none hasn't failed.
+func end(span trace.Span, err error) {
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+func newTracedDB(db DB, system attribute.KeyValue, provider trace.TracerProvider) TracedDB {
+	tracer := provider.Tracer("simple_twitter/database")
+	return TracedDB{tracedQueryer: tracedQueryer{queryer: db, tracer: tracer, system: system}, db: db}
+}
+
+// NewTracedDB traces the queries made to a MySQL db with the tracers of provider
+func NewTracedDB(db DB, provider trace.TracerProvider) TracedDB {
+	return newTracedDB(db, semconv.DBSystemNameMySQL, provider)
+}
+
+// NewTracedSQLiteDB traces the queries made to a SQLite db with the tracers of provider
+func NewTracedSQLiteDB(db DB, provider trace.TracerProvider) TracedDB {
+	return newTracedDB(db, semconv.DBSystemNameSQLite, provider)
+}
+
+// NewTracedPostgresDB traces the queries made to a Postgres db with the tracers of provider
+func NewTracedPostgresDB(db DB, provider trace.TracerProvider) TracedDB {
+	return newTracedDB(db, semconv.DBSystemNamePostgreSQL, provider)
+}
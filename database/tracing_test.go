@@ -0,0 +1,77 @@
+package database
+
+import (
+	"context"
+	"path/filepath"
+	"simple_twitter/models"
+	"simple_twitter/twitter/storagetest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestTracedSQLiteTwitterDatabaseConformance(t *testing.T) {
+	storagetest.Run(t, func(t *testing.T) storagetest.Harness {
+		conn, err := ConnectSQLite(filepath.Join(t.TempDir(), "twitter.db"))
+		require.NoError(t, err)
+		t.Cleanup(func() { conn.Close() })
+
+		err = MigrateSQLite(context.Background(), conn)
+		require.NoError(t, err)
+
+		db := NewTracedSQLiteDB(conn, noop.NewTracerProvider())
+		return storagetest.Harness{
+			Storage:     NewSQLiteTwitterDatabase(db),
+			InsertTweet: insertSQLiteTweet(db),
+		}
+	})
+}
+
+func TestTracedDB(t *testing.T) {
+	var (
+		require  = require.New(t)
+		assert   = assert.New(t)
+		recorder = tracetest.NewSpanRecorder()
+		provider = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	)
+
+	conn, err := ConnectSQLite(filepath.Join(t.TempDir(), "twitter.db"))
+	require.NoError(err)
+	defer conn.Close()
+
+	err = MigrateSQLite(context.Background(), conn)
+	require.NoError(err)
+
+	ctx, parent := provider.Tracer("test").Start(context.Background(), "parent")
+	storage := NewSQLiteTwitterDatabase(NewTracedSQLiteDB(conn, provider))
+
+	id, err := storage.CreateTweet(ctx, nil, "Hello world!", []string{"greetings"})
+	require.NoError(err)
+
+	_, err = storage.GetTweet(ctx, id+1)
+	require.Error(err)
+
+	err = storage.UpdateTweet(ctx, id+1, func(models.Tweet) (string, []string, error) {
+		return "Hello world!", []string{"greetings"}, nil
+	})
+	require.Error(err)
+	parent.End()
+
+	var names []string
+	for _, span := range recorder.Ended() {
+		if span.Name() == "parent" {
+			continue
+		}
+
+		names = append(names, span.Name())
+		assert.Equal(parent.SpanContext().SpanID(), span.Parent().SpanID(), "Expected queries to be traced as children of the span in the context")
+		assert.Contains(span.Attributes(), attribute.String("db.system.name", "sqlite"))
+	}
+
+	assert.Equal([]string{"BEGIN", "INSERT", "INSERT", "COMMIT", "SELECT", "BEGIN", "SELECT", "ROLLBACK"}, names, "Expected the queries made in transactions, and their commits and rollbacks, to be traced")
+}
@@ -3,53 +3,87 @@ package database
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"simple_twitter/models"
+	"simple_twitter/search"
+	"slices"
+	"strings"
 	"time"
+
+	"github.com/jmoiron/sqlx"
 )
 
-type DB interface {
+type Queryer interface {
 	GetContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
 	SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
 	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
 }
 
-type TwitterDatabase struct {
-	db DB
+type DB interface {
+	Queryer
+	BeginTxx(ctx context.Context, opts *sql.TxOptions) (*sqlx.Tx, error)
 }
 
-func (t TwitterDatabase) CreateTweet(ctx context.Context, message string, tag string) (int64, error) {
-	result, err := t.db.ExecContext(
-		ctx,
-		`
-			INSERT INTO Tweets (message, tag)
-			VALUES (?, ?)
-		`,
-		message, tag,
-	)
+// Tx is a transaction of a DB
+type Tx interface {
+	Queryer
+	Commit() error
+	Rollback() error
+}
 
-	if err != nil {
-		return 0, fmt.Errorf("failed to insert tweet: %w", err)
+type TwitterDatabase struct {
+	db      DB
+	dialect dialect
+}
+
+func (t TwitterDatabase) CreateTweet(ctx context.Context, authorID *int64, message string, tags []string) (int64, error) {
+	if len(tags) == 0 {
+		return 0, errors.New("failed to insert tweet: a tweet must have at least one tag")
 	}
 
-	id, err := result.LastInsertId()
+	var id int64
+	err := t.transaction(ctx, func(tx Queryer) error {
+		var err error
+		id, err = t.insert(
+			ctx,
+			tx,
+			`
+				INSERT INTO Tweets (message, tag, author_id)
+				VALUES (?, ?, ?)
+			`,
+			message, tags[0], authorID,
+		)
+
+		if err != nil {
+			return fmt.Errorf("failed to insert tweet: %w", err)
+		}
+
+		return t.insertTags(ctx, tx, id, tags)
+	})
+
 	if err != nil {
-		return 0, fmt.Errorf("failed to get id of created tweet: %w", err)
+		return 0, err
 	}
 
 	return id, nil
 }
 
 func (t TwitterDatabase) GetTweet(ctx context.Context, id int64) (models.Tweet, error) {
-	var tweet models.Tweet
+	var tweet struct {
+		models.Tweet
+		DeletedAt sql.NullTime `db:"deleted_at"`
+	}
+
 	err := t.db.GetContext(
 		ctx,
 		&tweet,
-		`
-			SELECT id, message, tag, created_at
+		t.dialect.rebind(`
+			SELECT id, message, tag, author_id, created_at, edited_at, revisions, deleted_at
 			FROM Tweets
 			WHERE id = ?
-		`,
+		`),
 		id,
 	)
 
@@ -61,43 +95,414 @@ func (t TwitterDatabase) GetTweet(ctx context.Context, id int64) (models.Tweet,
 		return models.Tweet{}, fmt.Errorf("failed to get tweet: %w", err)
 	}
 
-	return tweet, nil
+	if tweet.DeletedAt.Valid {
+		return models.Tweet{}, models.ErrGonef("tweet with id %d has been deleted", id)
+	}
+
+	tweets := []models.Tweet{tweet.Tweet}
+	err = t.loadTags(ctx, t.db, tweets)
+	if err != nil {
+		return models.Tweet{}, err
+	}
+
+	return tweets[0], nil
+}
+
+// GetTweets returns the tweets with ids in no particular order, leaving out those that are missing or deleted
+func (t TwitterDatabase) GetTweets(ctx context.Context, ids []int64) ([]models.Tweet, error) {
+	tweets := []models.Tweet{}
+	if len(ids) == 0 {
+		return tweets, nil
+	}
+
+	query, args, err := sqlx.In(
+		`
+			SELECT id, message, tag, author_id, created_at, edited_at, revisions
+			FROM Tweets
+			WHERE id IN (?) AND deleted_at IS NULL
+		`,
+		ids,
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query for tweets: %w", err)
+	}
+
+	err = t.db.SelectContext(ctx, &tweets, t.dialect.rebind(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tweets: %w", err)
+	}
+
+	err = t.loadTags(ctx, t.db, tweets)
+	if err != nil {
+		return nil, err
+	}
+
+	return tweets, nil
+}
+
+func (t TwitterDatabase) ListTweets(ctx context.Context, query models.TweetQuery) ([]models.Tweet, error) {
+	var (
+		from       = "Tweets"
+		createdAt  = "Tweets.created_at"
+		id         = "Tweets.id"
+		conditions = []string{"Tweets.deleted_at IS NULL"}
+		args       []interface{}
+	)
+
+	// Tweets with a tag are found, and ordered, by the `(tag, created_at, tweet_id)` index of their tags
+	if query.Tag != "" {
+		from = "TweetTags JOIN Tweets ON Tweets.id = TweetTags.tweet_id"
+		createdAt, id = "TweetTags.created_at", "TweetTags.tweet_id"
+		conditions = append(conditions, "TweetTags.tag = ?")
+		args = append(args, query.Tag)
+	}
+
+	if query.AuthorID > 0 {
+		conditions = append(conditions, "Tweets.author_id = ?")
+		args = append(args, query.AuthorID)
+	}
+
+	if !query.Since.IsZero() {
+		conditions = append(conditions, createdAt+" >= ?")
+		args = append(args, t.dialect.time(query.Since))
+	}
+
+	if !query.Until.IsZero() {
+		conditions = append(conditions, createdAt+" < ?")
+		args = append(args, t.dialect.time(query.Until))
+	}
+
+	if query.SinceID > 0 {
+		conditions = append(conditions, id+" > ?")
+		args = append(args, query.SinceID)
+	}
+
+	if query.MaxID > 0 {
+		conditions = append(conditions, id+" <= ?")
+		args = append(args, query.MaxID)
+	}
+
+	comparison, direction := ">", "ASC"
+	if query.Sort == models.SortNewest {
+		comparison, direction = "<", "DESC"
+	}
+
+	if query.After != nil {
+		conditions = append(conditions, fmt.Sprintf("(%[2]s %[1]s ? OR (%[2]s = ? AND %[3]s %[1]s ?))", comparison, createdAt, id))
+		args = append(args, t.dialect.time(query.After.CreatedAt), t.dialect.time(query.After.CreatedAt), query.After.ID)
+	}
+
+	tweets := []models.Tweet{}
+	err := t.db.SelectContext(
+		ctx,
+		&tweets,
+		t.dialect.rebind(fmt.Sprintf(`
+			SELECT Tweets.id, Tweets.message, Tweets.tag, Tweets.author_id, Tweets.created_at, Tweets.edited_at, Tweets.revisions
+			FROM %[1]s
+			WHERE %[2]s
+			ORDER BY %[3]s %[5]s, %[4]s %[5]s
+			LIMIT ? OFFSET ?
+		`, from, strings.Join(conditions, " AND "), createdAt, id, direction)),
+		append(args, query.Limit, query.Offset)...,
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tweets: %w", err)
+	}
+
+	err = t.loadTags(ctx, t.db, tweets)
+	if err != nil {
+		return nil, err
+	}
+
+	return tweets, nil
 }
 
-func (t TwitterDatabase) ListTweets(ctx context.Context, tag string, offset int, limit int) ([]models.Tweet, error) {
+func (t TwitterDatabase) SearchTweets(ctx context.Context, query models.SearchQuery) ([]models.Tweet, error) {
+	if !t.dialect.fullText() {
+		return nil, models.ErrUnsupported(fmt.Sprintf("full-text search isn't supported by the %s storage", t.dialect))
+	}
+
+	// Nothing matches without a term to match, the same as with the in-process index, so there is no need to query
+	included := slices.ContainsFunc(query.Terms, func(term models.SearchTerm) bool {
+		return !term.Exclude && !search.Ignored(term)
+	})
+	if !included {
+		return []models.Tweet{}, nil
+	}
+
+	var (
+		against    = booleanQuery(query.Terms)
+		conditions = []string{"deleted_at IS NULL", "MATCH (message) AGAINST (? IN BOOLEAN MODE)"}
+		args       = []interface{}{against}
+	)
+
+	if query.Tag != "" {
+		conditions = append(conditions, "EXISTS (SELECT 1 FROM TweetTags WHERE TweetTags.tweet_id = Tweets.id AND TweetTags.tag = ?)")
+		args = append(args, query.Tag)
+	}
+
 	tweets := []models.Tweet{}
 	err := t.db.SelectContext(
 		ctx,
 		&tweets,
-		`
-			SELECT id, message, tag, created_at
+		t.dialect.rebind(fmt.Sprintf(`
+			SELECT id, message, tag, author_id, created_at, edited_at, revisions
 			FROM Tweets
-			WHERE tag = ?
+			WHERE %s
+			ORDER BY MATCH (message) AGAINST (? IN BOOLEAN MODE) DESC, id DESC
 			LIMIT ? OFFSET ?
-		`,
-		tag, limit, offset,
+		`, strings.Join(conditions, " AND "))),
+		append(args, against, query.Limit, query.Offset)...,
 	)
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to get tweets: %w", err)
+		return nil, fmt.Errorf("failed to search tweets: %w", err)
+	}
+
+	err = t.loadTags(ctx, t.db, tweets)
+	if err != nil {
+		return nil, err
 	}
 
 	return tweets, nil
 }
 
+// UpdateTweet replaces the message and tags of the tweet with id with the ones edit returns for the current
+// version of the tweet, and keeps the version it replaces as a revision. The tweet is locked from when it's read
+// until it's updated, so concurrent edits can't overwrite each other. Errors from edit are returned as is.
+func (t TwitterDatabase) UpdateTweet(ctx context.Context, id int64, edit func(current models.Tweet) (string, []string, error)) error {
+	return t.transaction(ctx, func(tx Queryer) error {
+		var current struct {
+			models.Tweet
+			DeletedAt sql.NullTime `db:"deleted_at"`
+		}
+
+		err := tx.GetContext(
+			ctx,
+			&current,
+			t.dialect.rebind(fmt.Sprintf(`
+				SELECT id, message, tag, author_id, created_at, edited_at, revisions, deleted_at
+				FROM Tweets
+				WHERE id = ?
+				%s
+			`, t.dialect.forUpdate())),
+			id,
+		)
+
+		if err == sql.ErrNoRows {
+			return models.ErrMissingf("found no tweet with id %d", id)
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to get tweet: %w", err)
+		}
+
+		if current.DeletedAt.Valid {
+			return models.ErrGonef("tweet with id %d has been deleted", id)
+		}
+
+		tweets := []models.Tweet{current.Tweet}
+		err = t.loadTags(ctx, tx, tweets)
+		if err != nil {
+			return err
+		}
+
+		message, tags, err := edit(tweets[0])
+		if err != nil {
+			return err
+		}
+
+		if len(tags) == 0 {
+			return errors.New("failed to update tweet: a tweet must have at least one tag")
+		}
+
+		previousTags, err := json.Marshal(tweets[0].Tags)
+		if err != nil {
+			return fmt.Errorf("failed to marshal tags of revision: %w", err)
+		}
+
+		previousCreatedAt := current.CreatedAt
+		if current.EditedAt != nil {
+			previousCreatedAt = *current.EditedAt
+		}
+
+		_, err = tx.ExecContext(
+			ctx,
+			t.dialect.rebind(`
+				INSERT INTO TweetRevisions (tweet_id, revision, message, tags, created_at)
+				VALUES (?, ?, ?, ?, ?)
+			`),
+			id, current.Revisions+1, current.Message, string(previousTags), t.dialect.time(previousCreatedAt),
+		)
+
+		if err != nil {
+			return fmt.Errorf("failed to insert tweet revision: %w", err)
+		}
+
+		_, err = tx.ExecContext(
+			ctx,
+			t.dialect.rebind(fmt.Sprintf(`
+				UPDATE Tweets
+				SET message = ?, tag = ?, edited_at = %s, revisions = revisions + 1
+				WHERE id = ?
+			`, t.dialect.now())),
+			message, tags[0], id,
+		)
+
+		if err != nil {
+			return fmt.Errorf("failed to update tweet: %w", err)
+		}
+
+		_, err = tx.ExecContext(ctx, t.dialect.rebind(`DELETE FROM TweetTags WHERE tweet_id = ?`), id)
+		if err != nil {
+			return fmt.Errorf("failed to delete tweet tags: %w", err)
+		}
+
+		return t.insertTags(ctx, tx, id, tags)
+	})
+}
+
+// ListRevisions returns the prior versions of the tweet with id, oldest first
+func (t TwitterDatabase) ListRevisions(ctx context.Context, id int64) ([]models.TweetRevision, error) {
+	_, err := t.GetTweet(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []struct {
+		Revision  int       `db:"revision"`
+		Message   string    `db:"message"`
+		Tags      string    `db:"tags"`
+		CreatedAt time.Time `db:"created_at"`
+	}
+
+	err = t.db.SelectContext(
+		ctx,
+		&rows,
+		t.dialect.rebind(`
+			SELECT revision, message, tags, created_at
+			FROM TweetRevisions
+			WHERE tweet_id = ?
+			ORDER BY revision ASC
+		`),
+		id,
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tweet revisions: %w", err)
+	}
+
+	revisions := make([]models.TweetRevision, 0, len(rows))
+	for _, row := range rows {
+		revision := models.TweetRevision{Revision: row.Revision, Message: row.Message, CreatedAt: row.CreatedAt}
+		err := json.Unmarshal([]byte(row.Tags), &revision.Tags)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal tags of revision %d: %w", row.Revision, err)
+		}
+		revisions = append(revisions, revision)
+	}
+
+	return revisions, nil
+}
+
+// DeleteTweet soft deletes the tweet with id by setting its `deleted_at`, after which it's left out of
+// everything but PurgeTweets
+func (t TwitterDatabase) DeleteTweet(ctx context.Context, id int64) error {
+	result, err := t.db.ExecContext(
+		ctx,
+		t.dialect.rebind(fmt.Sprintf(`
+			UPDATE Tweets
+			SET deleted_at = %s
+			WHERE id = ? AND deleted_at IS NULL
+		`, t.dialect.now())),
+		id,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to delete tweet: %w", err)
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get number of deleted tweets: %w", err)
+	}
+
+	if deleted > 0 {
+		return nil
+	}
+
+	// Nothing was updated, so the tweet is either missing or already deleted, which GetTweet tells apart
+	_, err = t.GetTweet(ctx, id)
+	if err == nil {
+		return fmt.Errorf("failed to delete tweet: tweet with id %d wasn't updated", id)
+	}
+
+	return err
+}
+
+// PurgeTweets permanently deletes the tweets that were soft deleted before deletedBefore, together with their
+// tags and revisions, and returns the number of tweets purged
+func (t TwitterDatabase) PurgeTweets(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	var purged int64
+	err := t.transaction(ctx, func(tx Queryer) error {
+		for _, table := range []string{"TweetTags", "TweetRevisions"} {
+			_, err := tx.ExecContext(
+				ctx,
+				t.dialect.rebind(fmt.Sprintf(`
+					DELETE FROM %s
+					WHERE tweet_id IN (SELECT id FROM Tweets WHERE deleted_at < ?)
+				`, table)),
+				t.dialect.time(deletedBefore),
+			)
+
+			if err != nil {
+				return fmt.Errorf("failed to purge from %s: %w", table, err)
+			}
+		}
+
+		result, err := tx.ExecContext(
+			ctx,
+			t.dialect.rebind(`
+				DELETE FROM Tweets
+				WHERE deleted_at < ?
+			`),
+			t.dialect.time(deletedBefore),
+		)
+
+		if err != nil {
+			return fmt.Errorf("failed to purge tweets: %w", err)
+		}
+
+		purged, err = result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get number of purged tweets: %w", err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return purged, nil
+}
+
 func (t TwitterDatabase) AggregateTweetsByYear(ctx context.Context, from time.Time, to time.Time) ([]models.YearlyAggregate, error) {
 	var aggregates []models.YearlyAggregate
 	err := t.db.SelectContext(
 		ctx,
 		&aggregates,
-		`
-			SELECT YEAR(created_at) as year, count(id) as tweets
+		t.dialect.rebind(fmt.Sprintf(`
+			SELECT %s as year, count(id) as tweets
 			FROM Tweets
-			WHERE created_at BETWEEN ? AND ?
+			WHERE created_at BETWEEN ? AND ? AND deleted_at IS NULL
 			GROUP BY year
 			ORDER BY year ASC
-		`,
-		from, to,
+		`, t.dialect.year("created_at"))),
+		t.dialect.time(from), t.dialect.time(to),
 	)
 
 	if err != nil {
@@ -112,14 +517,14 @@ func (t TwitterDatabase) AggregateTweetsByMonth(ctx context.Context, from time.T
 	err := t.db.SelectContext(
 		ctx,
 		&aggregates,
-		`
-			SELECT YEAR(created_at) as year, MONTH(created_at) as month, count(id) as tweets
+		t.dialect.rebind(fmt.Sprintf(`
+			SELECT %s as year, %s as month, count(id) as tweets
 			FROM Tweets
-			WHERE created_at BETWEEN ? AND ?
+			WHERE created_at BETWEEN ? AND ? AND deleted_at IS NULL
 			GROUP BY year, month
 			ORDER BY year, month ASC
-		`,
-		from, to,
+		`, t.dialect.year("created_at"), t.dialect.month("created_at"))),
+		t.dialect.time(from), t.dialect.time(to),
 	)
 
 	if err != nil {
@@ -129,6 +534,240 @@ func (t TwitterDatabase) AggregateTweetsByMonth(ctx context.Context, from time.T
 	return aggregates, nil
 }
 
+// NormalizeTags rewrites every stored tag to the canonical form given by normalize, merging tags of a tweet that
+// have the same canonical form. Tags normalize fails for are left as is and returned as invalid. It's meant to be
+// run once to backfill tags that were stored before they were normalized.
+func (t TwitterDatabase) NormalizeTags(ctx context.Context, normalize func(tag string) (string, error)) (int, []string, error) {
+	var tags []string
+	err := t.db.SelectContext(ctx, &tags, `SELECT DISTINCT tag FROM TweetTags ORDER BY tag`)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to get tags: %w", err)
+	}
+
+	var (
+		normalized int
+		invalid    []string
+	)
+
+	for _, tag := range tags {
+		canonical, err := normalize(tag)
+		if err != nil {
+			invalid = append(invalid, tag)
+			continue
+		}
+
+		if canonical == tag {
+			continue
+		}
+
+		err = t.transaction(ctx, func(tx Queryer) error {
+			return t.renameTag(ctx, tx, tag, canonical)
+		})
+
+		if err != nil {
+			return normalized, invalid, fmt.Errorf("failed to normalize tag %q: %w", tag, err)
+		}
+
+		normalized++
+	}
+
+	return normalized, invalid, nil
+}
+
+// renameTag renames tag to canonical, dropping tag from tweets that already have canonical among their tags
+func (t TwitterDatabase) renameTag(ctx context.Context, tx Queryer, tag string, canonical string) error {
+	var duplicates []int64
+	err := tx.SelectContext(
+		ctx,
+		&duplicates,
+		t.dialect.rebind(`
+			SELECT renamed.tweet_id
+			FROM TweetTags renamed
+			JOIN TweetTags existing ON existing.tweet_id = renamed.tweet_id
+			WHERE renamed.tag = ? AND existing.tag = ?
+		`),
+		tag, canonical,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to get tweets with both tags: %w", err)
+	}
+
+	if len(duplicates) > 0 {
+		query, args, err := sqlx.In(`DELETE FROM TweetTags WHERE tag = ? AND tweet_id IN (?)`, tag, duplicates)
+		if err != nil {
+			return fmt.Errorf("failed to build query for duplicate tags: %w", err)
+		}
+
+		_, err = tx.ExecContext(ctx, t.dialect.rebind(query), args...)
+		if err != nil {
+			return fmt.Errorf("failed to delete duplicate tags: %w", err)
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, t.dialect.rebind(`UPDATE TweetTags SET tag = ? WHERE tag = ?`), canonical, tag)
+	if err != nil {
+		return fmt.Errorf("failed to update tweet tags: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, t.dialect.rebind(`UPDATE Tweets SET tag = ? WHERE tag = ?`), canonical, tag)
+	if err != nil {
+		return fmt.Errorf("failed to update tweets: %w", err)
+	}
+
+	return nil
+}
+
+// booleanQuery builds a MySQL boolean mode full-text query where every term that isn't excluded is required.
+// Terms of only stop words and short words are left out, MySQL doesn't index them so requiring them would
+// never match. Words only contain letters, marks and digits, so they can't be mistaken for operators.
+func booleanQuery(terms []models.SearchTerm) string {
+	parts := make([]string, 0, len(terms))
+	for _, term := range terms {
+		if search.Ignored(term) {
+			continue
+		}
+
+		operator := "+"
+		if term.Exclude {
+			operator = "-"
+		}
+
+		switch {
+		case term.Prefix:
+			parts = append(parts, operator+term.Words[0]+"*")
+		case len(term.Words) == 1:
+			parts = append(parts, operator+term.Words[0])
+		default:
+			parts = append(parts, operator+`"`+strings.Join(term.Words, " ")+`"`)
+		}
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// loadTags populates the tags of tweets, in the order they were given when the tweets were created
+func (t TwitterDatabase) loadTags(ctx context.Context, q Queryer, tweets []models.Tweet) error {
+	if len(tweets) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, 0, len(tweets))
+	for _, tweet := range tweets {
+		ids = append(ids, tweet.ID)
+	}
+
+	query, args, err := sqlx.In(
+		`
+			SELECT tweet_id, tag
+			FROM TweetTags
+			WHERE tweet_id IN (?)
+			ORDER BY tweet_id, position ASC
+		`,
+		ids,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to build query for tweet tags: %w", err)
+	}
+
+	var tags []struct {
+		TweetID int64  `db:"tweet_id"`
+		Tag     string `db:"tag"`
+	}
+
+	err = q.SelectContext(ctx, &tags, t.dialect.rebind(query), args...)
+	if err != nil {
+		return fmt.Errorf("failed to get tweet tags: %w", err)
+	}
+
+	byID := map[int64][]string{}
+	for _, tag := range tags {
+		byID[tag.TweetID] = append(byID[tag.TweetID], tag.Tag)
+	}
+
+	for idx := range tweets {
+		tweets[idx].Tags = append([]string{}, byID[tweets[idx].ID]...)
+	}
+
+	return nil
+}
+
+// insertTags inserts tags for the tweet with id, in the order given, along with the creation time of the
+// tweet so tweets with a tag can be listed in order from the tags alone
+func (t TwitterDatabase) insertTags(ctx context.Context, q Queryer, id int64, tags []string) error {
+	for position, tag := range tags {
+		_, err := q.ExecContext(
+			ctx,
+			t.dialect.rebind(`
+				INSERT INTO TweetTags (tweet_id, position, tag, created_at)
+				SELECT id, ?, ?, created_at FROM Tweets WHERE id = ?
+			`),
+			position, tag, id,
+		)
+
+		if err != nil {
+			return fmt.Errorf("failed to insert tweet tag: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// insert executes an `INSERT` statement for a single row and returns the id of the inserted row
+func (t TwitterDatabase) insert(ctx context.Context, q Queryer, query string, args ...interface{}) (int64, error) {
+	if t.dialect.returning() {
+		var id int64
+		err := q.GetContext(ctx, &id, t.dialect.rebind(query+" RETURNING id"), args...)
+		return id, err
+	}
+
+	result, err := q.ExecContext(ctx, t.dialect.rebind(query), args...)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get id of inserted row: %w", err)
+	}
+
+	return id, nil
+}
+
+// transaction runs fn in a transaction which is committed if fn succeeds and rolled back otherwise
+func (t TwitterDatabase) transaction(ctx context.Context, fn func(tx Queryer) error) error {
+	tx, err := t.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	// Transactions of a TracedDB are traced like the queries made outside of them
+	var q Tx = tx
+	if traced, ok := t.db.(TracedDB); ok {
+		q = traced.traced(ctx, tx)
+	}
+
+	if err := fn(q); err != nil {
+		q.Rollback()
+		return err
+	}
+
+	if err := q.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
 func NewTwitterDatabase(db DB) TwitterDatabase {
-	return TwitterDatabase{db: db}
+	return TwitterDatabase{db: db, dialect: dialectMySQL}
+}
+
+func NewSQLiteTwitterDatabase(db DB) TwitterDatabase {
+	return TwitterDatabase{db: db, dialect: dialectSQLite}
+}
+
+func NewPostgresTwitterDatabase(db DB) TwitterDatabase {
+	return TwitterDatabase{db: db, dialect: dialectPostgres}
 }
@@ -0,0 +1,48 @@
+package database
+
+import (
+	"simple_twitter/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBooleanQuery(t *testing.T) {
+	tests := []struct {
+		name     string
+		terms    []models.SearchTerm
+		expected string
+	}{
+		{
+			name:     "words are required",
+			terms:    []models.SearchTerm{{Words: []string{"brown"}}, {Words: []string{"fox"}}},
+			expected: "+brown +fox",
+		},
+		{
+			name:     "excluded phrase",
+			terms:    []models.SearchTerm{{Words: []string{"brown"}}, {Words: []string{"brown", "bread"}, Exclude: true}},
+			expected: `+brown -"brown bread"`,
+		},
+		{
+			name:     "prefix",
+			terms:    []models.SearchTerm{{Words: []string{"fo"}, Prefix: true}},
+			expected: "+fo*",
+		},
+		{
+			name:     "stop words and short words are left out",
+			terms:    []models.SearchTerm{{Words: []string{"the"}}, {Words: []string{"go"}}, {Words: []string{"fox"}}, {Words: []string{"ok"}, Exclude: true}},
+			expected: "+fox",
+		},
+		{
+			name:     "phrases with a stop word are kept",
+			terms:    []models.SearchTerm{{Words: []string{"the", "fox"}}},
+			expected: `+"the fox"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, booleanQuery(test.terms))
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"simple_twitter/models"
+)
+
+// CreateUser inserts a user with handle, which must not be taken by another user
+func (t TwitterDatabase) CreateUser(ctx context.Context, handle string, displayName string) (int64, error) {
+	var id int64
+	err := t.transaction(ctx, func(tx Queryer) error {
+		var taken int
+		err := tx.GetContext(ctx, &taken, t.dialect.rebind(`SELECT COUNT(*) FROM Users WHERE handle = ?`), handle)
+		if err != nil {
+			return fmt.Errorf("failed to get user: %w", err)
+		}
+
+		if taken > 0 {
+			return models.ErrConflictf("handle %q is already taken", handle)
+		}
+
+		id, err = t.insert(
+			ctx,
+			tx,
+			`
+				INSERT INTO Users (handle, display_name)
+				VALUES (?, ?)
+			`,
+			handle, displayName,
+		)
+
+		if err != nil {
+			return fmt.Errorf("failed to insert user: %w", err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (t TwitterDatabase) GetUser(ctx context.Context, id int64) (models.User, error) {
+	return t.getUser(ctx, "id", id)
+}
+
+func (t TwitterDatabase) GetUserByHandle(ctx context.Context, handle string) (models.User, error) {
+	return t.getUser(ctx, "handle", handle)
+}
+
+// getUser returns the user whose unique column has value
+func (t TwitterDatabase) getUser(ctx context.Context, column string, value any) (models.User, error) {
+	var user models.User
+	err := t.db.GetContext(
+		ctx,
+		&user,
+		t.dialect.rebind(fmt.Sprintf(`
+			SELECT id, handle, display_name, created_at
+			FROM Users
+			WHERE %s = ?
+		`, column)),
+		value,
+	)
+
+	if err == sql.ErrNoRows {
+		return models.User{}, models.ErrMissingf("found no user with %s %v", column, value)
+	}
+
+	if err != nil {
+		return models.User{}, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	return user, nil
+}
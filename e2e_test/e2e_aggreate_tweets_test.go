@@ -11,6 +11,8 @@ import (
 )
 
 func (e *E2ETestSuite) Test_AggregateTweetsByYear() {
+	e.skipUnlessSeeded()
+
 	var (
 		require = require.New(e.T())
 		assert  = assert.New(e.T())
@@ -41,6 +43,8 @@ func (e *E2ETestSuite) Test_AggregateTweetsByYear() {
 }
 
 func (e *E2ETestSuite) Test_AggregateTweetsByMonth() {
+	e.skipUnlessSeeded()
+
 	var (
 		require = require.New(e.T())
 		assert  = assert.New(e.T())
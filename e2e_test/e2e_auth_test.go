@@ -0,0 +1,278 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"simple_twitter/api"
+	"simple_twitter/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// authenticatedServer starts a server in front of the suite's storage that requires requests to authenticate,
+// except for anonymousRoutes
+func (e *E2ETestSuite) authenticatedServer(anonymousRoutes ...string) *httptest.Server {
+	server := api.NewServer("", e.twitter, api.WithAuthentication(e.twitter, anonymousRoutes...))
+	s := httptest.NewServer(server.Handler)
+	e.T().Cleanup(s.Close)
+	return s
+}
+
+// request makes a request to server, authenticated with token unless it's empty
+func (e *E2ETestSuite) request(server *httptest.Server, method string, path string, token string, body any) *http.Response {
+	var b []byte
+	if body != nil {
+		var err error
+		b, err = json.Marshal(body)
+		require.NoError(e.T(), err)
+	}
+
+	req, err := http.NewRequest(method, server.URL+path, bytes.NewReader(b))
+	require.NoError(e.T(), err)
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(e.T(), err)
+	return res
+}
+
+func (e *E2ETestSuite) Test_Authentication() {
+	var (
+		require = require.New(e.T())
+		assert  = assert.New(e.T())
+		server  = e.authenticatedServer("GET /tweets")
+		tweet   = models.CreateTweetRequest{Message: "This tweet is authenticated", Tag: "e2e-tests"}
+	)
+
+	_, token, err := e.twitter.CreateToken(context.Background(), "e2e", "", []string{"writer"})
+	require.NoError(err)
+
+	res := e.request(server, http.MethodPost, "/tweets", "", tweet)
+	defer res.Body.Close()
+
+	assert.Equal(http.StatusUnauthorized, res.StatusCode, "Expected `status code` without a token to be `401`")
+	assert.Contains(res.Header.Get("WWW-Authenticate"), "Bearer")
+	output := e.unmarshalError(res)
+	assert.Equal(models.ErrKindUnauthenticated, output.Kind, "Expected `error kind` to be `unauthenticated`")
+
+	res = e.request(server, http.MethodPost, "/tweets", token, tweet)
+	defer res.Body.Close()
+	assert.Equal(http.StatusCreated, res.StatusCode, "Expected `status code` with a token to be `201`")
+
+	res = e.request(server, http.MethodGet, "/tweets", "", nil)
+	defer res.Body.Close()
+	assert.Equal(http.StatusOK, res.StatusCode, "Expected anonymous routes to not require a token")
+
+	res = e.request(server, http.MethodGet, "/tweets", "st_not-a-token", nil)
+	defer res.Body.Close()
+	assert.Equal(http.StatusUnauthorized, res.StatusCode, "Expected invalid tokens to be rejected on anonymous routes too")
+}
+
+func (e *E2ETestSuite) Test_AuthenticationRevokedToken() {
+	var (
+		require = require.New(e.T())
+		assert  = assert.New(e.T())
+		server  = e.authenticatedServer()
+	)
+
+	token, secret, err := e.twitter.CreateToken(context.Background(), "e2e", "", []string{"writer"})
+	require.NoError(err)
+
+	res := e.request(server, http.MethodGet, "/tweets", secret, nil)
+	defer res.Body.Close()
+	assert.Equal(http.StatusOK, res.StatusCode, "Expected `status code` to be `200`")
+
+	err = e.twitter.RevokeToken(context.Background(), token.ID)
+	require.NoError(err)
+
+	res = e.request(server, http.MethodGet, "/tweets", secret, nil)
+	defer res.Body.Close()
+	assert.Equal(http.StatusUnauthorized, res.StatusCode, "Expected revoked tokens to be rejected")
+}
+
+func (e *E2ETestSuite) Test_AuthenticationPostsAsUser() {
+	var (
+		require = require.New(e.T())
+		assert  = assert.New(e.T())
+		server  = e.authenticatedServer()
+		user    = e.createUser(e.uniqueHandle())
+		other   = e.createUser(e.uniqueHandle())
+	)
+
+	_, token, err := e.twitter.CreateToken(context.Background(), "e2e", user.Handle, []string{"writer"})
+	require.NoError(err)
+
+	res := e.request(server, http.MethodPost, "/tweets", token, models.CreateTweetRequest{Message: "This tweet is posted by the user of the token", Tag: "e2e-tests"})
+	defer res.Body.Close()
+
+	require.Equal(http.StatusCreated, res.StatusCode, "Expected `status code` to be `201`")
+	tweet := e.unmarshalTweet(res)
+	require.NotNil(tweet.AuthorID, "Expected `author_id` to be the user of the token")
+	assert.Equal(user.ID, *tweet.AuthorID)
+
+	res = e.request(server, http.MethodPost, "/tweets", token, models.CreateTweetRequest{Message: "This tweet is posted as someone else", Tag: "e2e-tests", AuthorID: &other.ID})
+	defer res.Body.Close()
+	assert.Equal(http.StatusForbidden, res.StatusCode, "Expected posting as another user to be rejected")
+}
+
+func (e *E2ETestSuite) Test_AuthorizationTokensWithoutUser() {
+	var (
+		require = require.New(e.T())
+		assert  = assert.New(e.T())
+		server  = e.authenticatedServer()
+		user    = e.createUser(e.uniqueHandle())
+		message = "This tweet is edited"
+	)
+
+	_, service, err := e.twitter.CreateToken(context.Background(), "e2e", "", []string{"writer"})
+	require.NoError(err)
+
+	_, moderator, err := e.twitter.CreateToken(context.Background(), "e2e", "", []string{"admin"})
+	require.NoError(err)
+
+	res := e.request(server, http.MethodPost, "/tweets", service, models.CreateTweetRequest{Message: "This tweet is posted as a user", Tag: "e2e-tests", AuthorID: &user.ID})
+	defer res.Body.Close()
+	assert.Equal(http.StatusForbidden, res.StatusCode, "Expected tokens without a user to not post as a user")
+
+	res = e.request(server, http.MethodPost, "/tweets", service, models.CreateTweetRequest{Message: "This tweet is posted anonymously", Tag: "e2e-tests"})
+	defer res.Body.Close()
+	require.Equal(http.StatusCreated, res.StatusCode, "Expected `status code` to be `201`")
+	path := fmt.Sprintf("/tweets/%d", e.unmarshalTweet(res).ID)
+
+	res = e.request(server, http.MethodPatch, path, service, models.TweetPatch{Message: &message})
+	defer res.Body.Close()
+	assert.Equal(http.StatusForbidden, res.StatusCode, "Expected tokens without a user to not edit tweets")
+
+	res = e.request(server, http.MethodDelete, path, service, nil)
+	defer res.Body.Close()
+	assert.Equal(http.StatusForbidden, res.StatusCode, "Expected tokens without a user to not delete tweets")
+
+	res = e.request(server, http.MethodPost, "/tweets", moderator, models.CreateTweetRequest{Message: "This tweet is posted as a user", Tag: "e2e-tests", AuthorID: &user.ID})
+	defer res.Body.Close()
+	require.Equal(http.StatusCreated, res.StatusCode, "Expected tokens with the `tweets:moderate` scope to post as a user")
+	tweet := e.unmarshalTweet(res)
+	require.NotNil(tweet.AuthorID)
+	assert.Equal(user.ID, *tweet.AuthorID)
+
+	res = e.request(server, http.MethodDelete, path, moderator, nil)
+	defer res.Body.Close()
+	assert.Equal(http.StatusNoContent, res.StatusCode, "Expected tokens with the `tweets:moderate` scope to delete every tweet")
+}
+
+func (e *E2ETestSuite) Test_AuthorizationChangesOwnTweets() {
+	var (
+		require = require.New(e.T())
+		assert  = assert.New(e.T())
+		server  = e.authenticatedServer()
+		author  = e.createUser(e.uniqueHandle())
+		other   = e.createUser(e.uniqueHandle())
+		message = "This tweet is edited"
+	)
+
+	_, authorToken, err := e.twitter.CreateToken(context.Background(), "e2e", author.Handle, []string{"writer"})
+	require.NoError(err)
+
+	_, otherToken, err := e.twitter.CreateToken(context.Background(), "e2e", other.Handle, []string{"writer"})
+	require.NoError(err)
+
+	_, moderator, err := e.twitter.CreateToken(context.Background(), "e2e", other.Handle, []string{"admin"})
+	require.NoError(err)
+
+	res := e.request(server, http.MethodPost, "/tweets", authorToken, models.CreateTweetRequest{Message: "This tweet is posted by its author", Tag: "e2e-tests"})
+	defer res.Body.Close()
+	require.Equal(http.StatusCreated, res.StatusCode, "Expected `status code` to be `201`")
+	path := fmt.Sprintf("/tweets/%d", e.unmarshalTweet(res).ID)
+
+	res = e.request(server, http.MethodPatch, path, otherToken, models.TweetPatch{Message: &message})
+	defer res.Body.Close()
+
+	assert.Equal(http.StatusForbidden, res.StatusCode, "Expected editing the tweet of another user to be `403`")
+	output := e.unmarshalError(res)
+	assert.Equal(models.ErrKindForbidden, output.Kind, "Expected `error kind` to be `forbidden`")
+
+	res = e.request(server, http.MethodDelete, path, otherToken, nil)
+	defer res.Body.Close()
+
+	assert.Equal(http.StatusForbidden, res.StatusCode, "Expected deleting the tweet of another user to be `403`")
+	output = e.unmarshalError(res)
+	assert.Equal(models.ErrKindForbidden, output.Kind, "Expected `error kind` to be `forbidden`")
+
+	res = e.request(server, http.MethodPatch, path, authorToken, models.TweetPatch{Message: &message})
+	defer res.Body.Close()
+	assert.Equal(http.StatusOK, res.StatusCode, "Expected the author to be able to edit their tweet")
+
+	res = e.request(server, http.MethodPatch, path, moderator, models.TweetPatch{Message: &message})
+	defer res.Body.Close()
+	assert.Equal(http.StatusOK, res.StatusCode, "Expected tokens with the `tweets:moderate` scope to be able to edit every tweet")
+
+	res = e.request(server, http.MethodDelete, path, moderator, nil)
+	defer res.Body.Close()
+	assert.Equal(http.StatusNoContent, res.StatusCode, "Expected tokens with the `tweets:moderate` scope to be able to delete every tweet")
+}
+
+func (e *E2ETestSuite) Test_AuthorizationAggregateRequiresAdmin() {
+	var (
+		require = require.New(e.T())
+		assert  = assert.New(e.T())
+		server  = e.authenticatedServer(api.DefaultAnonymousRoutes...)
+		path    = "/tweets/_aggregate?group_by=year&from=2024-01-01&to=2025-12-31"
+	)
+
+	_, writer, err := e.twitter.CreateToken(context.Background(), "e2e", "", []string{"writer"})
+	require.NoError(err)
+
+	_, admin, err := e.twitter.CreateToken(context.Background(), "e2e", "", []string{"reader", "admin"})
+	require.NoError(err)
+
+	res := e.request(server, http.MethodGet, path, "", nil)
+	defer res.Body.Close()
+	assert.Equal(http.StatusUnauthorized, res.StatusCode, "Expected anonymous requests to the aggregate to be rejected")
+
+	res = e.request(server, http.MethodGet, path, writer, nil)
+	defer res.Body.Close()
+
+	assert.Equal(http.StatusForbidden, res.StatusCode, "Expected `status code` without the `admin:stats` scope to be `403`")
+	output := e.unmarshalError(res)
+	assert.Equal(models.ErrKindForbidden, output.Kind, "Expected `error kind` to be `forbidden`")
+
+	res = e.request(server, http.MethodGet, path, admin, nil)
+	defer res.Body.Close()
+	assert.Equal(http.StatusOK, res.StatusCode, "Expected `status code` with the `admin:stats` scope to be `200`")
+}
+
+func (e *E2ETestSuite) Test_AuthorizationScopes() {
+	var (
+		require = require.New(e.T())
+		assert  = assert.New(e.T())
+		server  = e.authenticatedServer(api.DefaultAnonymousRoutes...)
+	)
+
+	_, reader, err := e.twitter.CreateToken(context.Background(), "e2e", "", []string{"reader"})
+	require.NoError(err)
+
+	res := e.request(server, http.MethodGet, "/tweets", reader, nil)
+	defer res.Body.Close()
+	assert.Equal(http.StatusOK, res.StatusCode, "Expected readers to be able to list tweets")
+
+	res = e.request(server, http.MethodPost, "/tweets", reader, models.CreateTweetRequest{Message: "Readers can't post", Tag: "e2e-tests"})
+	defer res.Body.Close()
+	assert.Equal(http.StatusForbidden, res.StatusCode, "Expected readers to not be able to post tweets")
+
+	res = e.request(server, http.MethodPost, "/users", reader, models.CreateUserRequest{Handle: e.uniqueHandle()})
+	defer res.Body.Close()
+	assert.Equal(http.StatusForbidden, res.StatusCode, "Expected readers to not be able to create users")
+
+	_, _, err = e.twitter.CreateToken(context.Background(), "e2e", "", []string{"superuser"})
+	assert.Error(err, "Expected tokens to not be created with roles that aren't configured")
+}
@@ -1,8 +1,12 @@
 package test
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/http"
+	"net/url"
 	"simple_twitter/models"
+	"simple_twitter/twitter"
 	"strings"
 
 	"github.com/stretchr/testify/assert"
@@ -15,7 +19,7 @@ func (e *E2ETestSuite) Test_CreateTweet() {
 		assert  = assert.New(e.T())
 	)
 
-	input := models.Tweet{
+	input := models.CreateTweetRequest{
 		Message: "This is a test tweet! ✅",
 		Tag:     "e2e-tests",
 	}
@@ -28,9 +32,12 @@ func (e *E2ETestSuite) Test_CreateTweet() {
 	output := e.unmarshalTweet(res)
 
 	assert.NotZero(output.ID, "Expected created tweet to have an `id`")
-	assert.Greater(output.ID, int64(2000), "Expected `id` of created tweet to be higher than 2000") // Seed data uses the first 2000 ID's
+	if e.seeded {
+		assert.Greater(output.ID, int64(2000), "Expected `id` of created tweet to be higher than 2000") // Seed data uses the first 2000 ID's
+	}
 	assert.Equal(input.Message, output.Message, "Expected `message` to be the same in input and output")
 	assert.Equal(input.Tag, output.Tag, "Expected `tag` to be the same in input and output")
+	assert.Equal([]string{input.Tag}, output.Tags, "Expected `tags` to only hold `tag`")
 	assert.NotZero(output.CreatedAt, "Expected `created at` of created tweet to be set")
 }
 
@@ -40,7 +47,7 @@ func (e *E2ETestSuite) Test_CreateTweetWithoutMessage() {
 		assert  = assert.New(e.T())
 	)
 
-	input := models.Tweet{Tag: "e2e-tests"}
+	input := models.CreateTweetRequest{Tag: "e2e-tests"}
 	res, err := http.Post(e.buildURL("/tweets", nil), "application/json", e.marshalTweet(input))
 	require.NoError(err)
 	defer res.Body.Close()
@@ -58,7 +65,7 @@ func (e *E2ETestSuite) Test_CreateTweetWithTooLongMessage() {
 		assert  = assert.New(e.T())
 	)
 
-	input := models.Tweet{
+	input := models.CreateTweetRequest{
 		Message: "👋 This is a test tweet that shouldn't have a message that's longer than 140 code points! Is this longer than that? 🤔 Yeah - looks that way! ✅",
 		Tag:     "e2e-tests",
 	}
@@ -82,7 +89,7 @@ func (e *E2ETestSuite) Test_CreateTweetWithoutTag() {
 		assert  = assert.New(e.T())
 	)
 
-	input := models.Tweet{Message: "This is a test tweet! ✅"}
+	input := models.CreateTweetRequest{Message: "This is a test tweet! ✅"}
 	res, err := http.Post(e.buildURL("/tweets", nil), "application/json", e.marshalTweet(input))
 	require.NoError(err)
 	defer res.Body.Close()
@@ -100,7 +107,7 @@ func (e *E2ETestSuite) Test_CreateTweetWithTooLongTag() {
 		assert  = assert.New(e.T())
 	)
 
-	input := models.Tweet{
+	input := models.CreateTweetRequest{
 		Message: "This is a test tweet! ✅",
 		Tag:     "e2e-tests-should-test-that-tags-cant-be-this-long",
 	}
@@ -117,3 +124,209 @@ func (e *E2ETestSuite) Test_CreateTweetWithTooLongTag() {
 	assert.True(strings.Contains(output.Message, "too long"), "Expected `error message` to contain `too long`")
 	assert.True(strings.Contains(output.Message, "32"), "Expected `error message` to contain `32`")
 }
+
+func (e *E2ETestSuite) Test_CreateTweetWithTags() {
+	var (
+		require = require.New(e.T())
+		assert  = assert.New(e.T())
+		tag     = e.uniqueTag("e2e-tags")
+	)
+
+	input := models.CreateTweetRequest{
+		Message: "This is a cross posted test tweet! ✅",
+		Tags:    []string{"e2e-tests", tag, "e2e-tests"},
+	}
+
+	res, err := http.Post(e.buildURL("/tweets", nil), "application/json", e.marshalTweet(input))
+	require.NoError(err)
+	defer res.Body.Close()
+
+	assert.Equal(http.StatusCreated, res.StatusCode)
+	output := e.unmarshalTweet(res)
+
+	assert.Equal([]string{"e2e-tests", tag}, output.Tags, "Expected `tags` to be the same as input without duplicates")
+	assert.Equal("e2e-tests", output.Tag, "Expected `tag` to be the first of the `tags`")
+
+	res, err = http.Get(e.buildURL("/tweets", url.Values{"tag": {tag}}))
+	require.NoError(err)
+	defer res.Body.Close()
+
+	assert.Equal(http.StatusOK, res.StatusCode)
+	tweets := e.unmarshalTweets(res)
+	require.Len(tweets, 1, "Expected tweet to be listed by any of its tags")
+	assert.Equal(output.ID, tweets[0].ID, "Expected the created tweet to be listed by any of its tags")
+}
+
+func (e *E2ETestSuite) Test_CreateTweetWithTagAndTags() {
+	var (
+		require = require.New(e.T())
+		assert  = assert.New(e.T())
+	)
+
+	input := models.CreateTweetRequest{
+		Message: "This is a test tweet! ✅",
+		Tag:     "e2e-tests",
+		Tags:    []string{"e2e-tests-too"},
+	}
+
+	res, err := http.Post(e.buildURL("/tweets", nil), "application/json", e.marshalTweet(input))
+	require.NoError(err)
+	defer res.Body.Close()
+
+	assert.Equal(http.StatusCreated, res.StatusCode)
+	output := e.unmarshalTweet(res)
+
+	assert.Equal([]string{"e2e-tests", "e2e-tests-too"}, output.Tags, "Expected legacy `tag` to be the first of the `tags`")
+	assert.Equal("e2e-tests", output.Tag, "Expected `tag` to be the same in input and output")
+}
+
+func (e *E2ETestSuite) Test_CreateTweetWithTooManyTags() {
+	var (
+		require = require.New(e.T())
+		assert  = assert.New(e.T())
+	)
+
+	var tags []string
+	for i := range twitter.MAX_TWEET_TAGS + 1 {
+		tags = append(tags, fmt.Sprintf("e2e-tests-%d", i))
+	}
+
+	res, err := http.Post(e.buildURL("/tweets", nil), "application/json", e.marshalTweet(models.CreateTweetRequest{
+		Message: "This is a test tweet! ✅",
+		Tags:    tags,
+	}))
+	require.NoError(err)
+	defer res.Body.Close()
+
+	assert.Equal(http.StatusBadRequest, res.StatusCode, "Expected `status code` to be `400`")
+	output := e.unmarshalError(res)
+
+	assert.Equal(models.ErrKindInvalid, output.Kind, "Expected `error kind` to be `invalid`")
+	assert.True(strings.Contains(output.Message, "tags"), "Expected `error message` to contain `tags`")
+	assert.True(strings.Contains(output.Message, fmt.Sprint(twitter.MAX_TWEET_TAGS)), "Expected `error message` to contain the max number of tags")
+}
+
+func (e *E2ETestSuite) Test_CreateTweetWithHashtags() {
+	var (
+		require = require.New(e.T())
+		assert  = assert.New(e.T())
+		hashtag = strings.ReplaceAll(e.uniqueTag("e2e_hashtag"), "-", "_")
+	)
+
+	input := models.CreateTweetRequest{
+		Message: "Testing #" + hashtag + " and #ünïcode_測試, but not #1, foo#bar or #e2e-tests twice: #e2e ✅",
+		Tag:     "e2e-tests",
+	}
+
+	res, err := http.Post(e.buildURL("/tweets", nil), "application/json", e.marshalTweet(input))
+	require.NoError(err)
+	defer res.Body.Close()
+
+	assert.Equal(http.StatusCreated, res.StatusCode)
+	output := e.unmarshalTweet(res)
+
+	assert.Equal([]string{hashtag, "ünïcode_測試", "e2e"}, output.Hashtags, "Expected `hashtags` to be extracted from the message")
+	assert.Equal([]string{"e2e-tests", hashtag, "ünïcode_測試", "e2e"}, output.Tags, "Expected `tags` to include the hashtags")
+	assert.Equal("e2e-tests", output.Tag, "Expected `tag` to be the same in input and output")
+
+	res, err = http.Get(e.buildURL("/tweets", url.Values{"tag": {hashtag}}))
+	require.NoError(err)
+	defer res.Body.Close()
+
+	assert.Equal(http.StatusOK, res.StatusCode)
+	tweets := e.unmarshalTweets(res)
+	require.Len(tweets, 1, "Expected tweet to be listed by its hashtags")
+	assert.Equal(output.ID, tweets[0].ID, "Expected the created tweet to be listed by its hashtags")
+	assert.Equal(output.Hashtags, tweets[0].Hashtags, "Expected `hashtags` to be the same when listed")
+}
+
+func (e *E2ETestSuite) Test_CreateTweetWithLongHashtag() {
+	var (
+		require = require.New(e.T())
+		assert  = assert.New(e.T())
+	)
+
+	input := models.CreateTweetRequest{
+		Message: "This hashtag is too long to be a tag #" + strings.Repeat("x", twitter.MAX_TWEET_TAG_LENGTH+1),
+		Tag:     "e2e-tests",
+	}
+
+	res, err := http.Post(e.buildURL("/tweets", nil), "application/json", e.marshalTweet(input))
+	require.NoError(err)
+	defer res.Body.Close()
+
+	assert.Equal(http.StatusCreated, res.StatusCode)
+	output := e.unmarshalTweet(res)
+
+	assert.Empty(output.Hashtags, "Expected hashtags longer than the max tag length to be ignored")
+	assert.Equal([]string{"e2e-tests"}, output.Tags, "Expected `tags` to not include ignored hashtags")
+}
+
+func (e *E2ETestSuite) Test_CreateTweetWithNonCanonicalTags() {
+	var (
+		require = require.New(e.T())
+		assert  = assert.New(e.T())
+		tag     = e.uniqueTag("e2e-canonical")
+	)
+
+	input := models.CreateTweetRequest{
+		Message: "This is a test tweet about #GoLang! ✅",
+		// "GO\u0308" is "GÖ" with a combining diaeresis rather than the precomposed "Ö"
+		Tags: []string{" " + strings.ToUpper(tag) + " ", "GO\u0308", "gö", "Go", "golang"},
+	}
+
+	res, err := http.Post(e.buildURL("/tweets", nil), "application/json", e.marshalTweet(input))
+	require.NoError(err)
+	defer res.Body.Close()
+
+	assert.Equal(http.StatusCreated, res.StatusCode)
+	output := e.unmarshalTweet(res)
+
+	assert.Equal([]string{tag, "gö", "go", "golang"}, output.Tags, "Expected `tags` to be canonical and without duplicates")
+	assert.Equal(tag, output.Tag, "Expected `tag` to be canonical")
+	assert.Equal([]string{"golang"}, output.Hashtags, "Expected `hashtags` to be canonical")
+
+	res, err = http.Get(e.buildURL("/tweets", url.Values{"tag": {strings.ToUpper(tag)}}))
+	require.NoError(err)
+	defer res.Body.Close()
+
+	assert.Equal(http.StatusOK, res.StatusCode)
+	tweets := e.unmarshalTweets(res)
+	require.Len(tweets, 1, "Expected tweet to be listed by any form of its tags")
+	assert.Equal(output.ID, tweets[0].ID, "Expected the created tweet to be listed by any form of its tags")
+}
+
+func (e *E2ETestSuite) Test_CreateTweetWithInvalidTagCharacters() {
+	var (
+		require = require.New(e.T())
+		assert  = assert.New(e.T())
+	)
+
+	res, err := http.Post(e.buildURL("/tweets", nil), "application/json", e.marshalTweet(models.CreateTweetRequest{
+		Message: "This is a test tweet! ✅",
+		Tag:     "e2e tests!",
+	}))
+	require.NoError(err)
+	defer res.Body.Close()
+
+	assert.Equal(http.StatusBadRequest, res.StatusCode, "Expected `status code` to be `400`")
+	output := e.unmarshalError(res)
+
+	assert.Equal(models.ErrKindInvalid, output.Kind, "Expected `error kind` to be `invalid`")
+	assert.True(strings.Contains(output.Message, "tag"), "Expected `error message` to contain `tag`")
+}
+
+func (e *E2ETestSuite) Test_CreateTweetWithServerOwnedFields() {
+	var (
+		assert = assert.New(e.T())
+	)
+
+	for _, field := range []string{`"id":1`, `"created_at":"2025-03-16T18:13:11Z"`, `"edited_at":"2025-03-16T18:13:11Z"`, `"hashtags":["golang"]`, `"revisions":1`} {
+		res := e.postJSON("/tweets", json.RawMessage(`{"message":"This is a test tweet! ✅","tag":"e2e-tests",`+field+`}`))
+		defer res.Body.Close()
+
+		assert.Equalf(http.StatusBadRequest, res.StatusCode, "Expected `status code` with %s to be `400`", field)
+		output := e.unmarshalError(res)
+		assert.Equalf(models.ErrKindInvalid, output.Kind, "Expected `error kind` with %s to be `invalid`", field)
+	}
+}
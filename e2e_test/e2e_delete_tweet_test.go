@@ -0,0 +1,99 @@
+package test
+
+import (
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"simple_twitter/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (e *E2ETestSuite) Test_DeleteTweet() {
+	var (
+		require = require.New(e.T())
+		assert  = assert.New(e.T())
+		tag     = e.uniqueTag("e2e-delete")
+		word    = fmt.Sprintf("e2e%08x", rand.Uint32())
+	)
+
+	deleted := e.createTweet("This tweet will be deleted "+word, tag)
+	kept := e.createTweet("This tweet will be kept "+word, tag)
+
+	res := e.deleteTweet(deleted.ID)
+	defer res.Body.Close()
+	assert.Equal(http.StatusNoContent, res.StatusCode, "Expected `status code` to be `204`")
+
+	res, err := http.Get(e.buildURL(fmt.Sprintf("/tweets/%d", deleted.ID), nil))
+	require.NoError(err)
+	defer res.Body.Close()
+
+	assert.Equal(http.StatusGone, res.StatusCode, "Expected `status code` of a deleted tweet to be `410`")
+	output := e.unmarshalError(res)
+	assert.Equal(models.ErrKindGone, output.Kind, "Expected `error kind` to be `gone`")
+
+	res, err = http.Get(e.buildURL("/tweets", url.Values{"tag": {tag}}))
+	require.NoError(err)
+	defer res.Body.Close()
+
+	assert.Equal(http.StatusOK, res.StatusCode)
+	tweets := e.unmarshalTweets(res)
+	require.Len(tweets, 1, "Expected deleted tweets to not be listed")
+	assert.Equal(kept.ID, tweets[0].ID, "Expected tweets that aren't deleted to still be listed")
+
+	res, err = http.Get(e.buildURL("/tweets/_search", url.Values{"q": {word}}))
+	require.NoError(err)
+	defer res.Body.Close()
+
+	assert.Equal(http.StatusOK, res.StatusCode)
+	page := e.unmarshalSearchPage(res)
+	require.Len(page.Tweets, 1, "Expected deleted tweets to not be found")
+	assert.Equal(kept.ID, page.Tweets[0].ID, "Expected tweets that aren't deleted to still be found")
+}
+
+func (e *E2ETestSuite) Test_DeleteTweetTwice() {
+	var (
+		assert = assert.New(e.T())
+	)
+
+	tweet := e.createTweet("This tweet will be deleted twice", "e2e-tests")
+
+	res := e.deleteTweet(tweet.ID)
+	defer res.Body.Close()
+	assert.Equal(http.StatusNoContent, res.StatusCode, "Expected `status code` to be `204`")
+
+	res = e.deleteTweet(tweet.ID)
+	defer res.Body.Close()
+	assert.Equal(http.StatusGone, res.StatusCode, "Expected `status code` of deleting a deleted tweet to be `410`")
+}
+
+func (e *E2ETestSuite) Test_DeleteTweetMissing() {
+	var (
+		assert = assert.New(e.T())
+	)
+
+	res := e.deleteTweet(999999999)
+	defer res.Body.Close()
+
+	assert.Equal(http.StatusNotFound, res.StatusCode, "Expected `status code` to be `404`")
+	output := e.unmarshalError(res)
+	assert.Equal(models.ErrKindMissing, output.Kind, "Expected `error kind` to be `missing`")
+}
+
+func (e *E2ETestSuite) Test_DeleteTweetInvalidID() {
+	var (
+		require = require.New(e.T())
+		assert  = assert.New(e.T())
+	)
+
+	req, err := http.NewRequest(http.MethodDelete, e.buildURL("/tweets/not-an-id", nil), nil)
+	require.NoError(err)
+
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(err)
+	defer res.Body.Close()
+
+	assert.Equal(http.StatusBadRequest, res.StatusCode, "Expected `status code` to be `400`")
+}
@@ -0,0 +1,171 @@
+package test
+
+import (
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"simple_twitter/models"
+	"strings"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (e *E2ETestSuite) Test_EditTweet() {
+	var (
+		require = require.New(e.T())
+		assert  = assert.New(e.T())
+		tag     = e.uniqueTag("e2e-edit")
+		message = "This tweet has a tpyo"
+		fixed   = "This tweet had a typo"
+	)
+
+	tweet := e.createTweet(message, tag)
+	assert.Nil(tweet.EditedAt, "Expected `edited_at` to be unset before the tweet is edited")
+	assert.Zero(tweet.Revisions, "Expected `revisions` to be `0` before the tweet is edited")
+
+	res := e.editTweet(tweet.ID, models.TweetPatch{Message: &fixed})
+	defer res.Body.Close()
+
+	require.Equal(http.StatusOK, res.StatusCode, "Expected `status code` to be `200`")
+	edited := e.unmarshalTweet(res)
+	assert.Equal(tweet.ID, edited.ID)
+	assert.Equal(fixed, edited.Message)
+	assert.Equal([]string{tag}, edited.Tags, "Expected tags to be kept when only the message is edited")
+	assert.Equal(tweet.CreatedAt, edited.CreatedAt, "Expected `created_at` to not change")
+	assert.NotNil(edited.EditedAt, "Expected `edited_at` to be set")
+	assert.Equal(1, edited.Revisions, "Expected `revisions` to be `1`")
+
+	res, err := http.Get(e.buildURL(fmt.Sprintf("/tweets/%d/revisions", tweet.ID), nil))
+	require.NoError(err)
+	defer res.Body.Close()
+
+	require.Equal(http.StatusOK, res.StatusCode, "Expected `status code` to be `200`")
+	revisions := e.unmarshalRevisions(res)
+	require.Len(revisions.Revisions, 1)
+	assert.Equal(1, revisions.Revisions[0].Revision)
+	assert.Equal(message, revisions.Revisions[0].Message, "Expected the revision to hold the message before the edit")
+	assert.Equal([]string{tag}, revisions.Revisions[0].Tags)
+	assert.Equal(tweet.CreatedAt, revisions.Revisions[0].CreatedAt)
+}
+
+func (e *E2ETestSuite) Test_EditTweetTags() {
+	var (
+		require = require.New(e.T())
+		assert  = assert.New(e.T())
+		oldTag  = e.uniqueTag("e2e-edit")
+		newTag  = e.uniqueTag("e2e-edit")
+		word    = fmt.Sprintf("e2e%08x", rand.Uint32())
+	)
+
+	tweet := e.createTweet("This tweet will be retagged #"+word, oldTag)
+	assert.Equal([]string{oldTag, word}, tweet.Tags)
+
+	res := e.editTweet(tweet.ID, models.TweetPatch{Tag: &newTag})
+	defer res.Body.Close()
+
+	require.Equal(http.StatusOK, res.StatusCode, "Expected `status code` to be `200`")
+	edited := e.unmarshalTweet(res)
+	assert.Equal(newTag, edited.Tag)
+	assert.Equal([]string{newTag, word}, edited.Tags, "Expected hashtags to be kept as tags")
+
+	res, err := http.Get(e.buildURL("/tweets", url.Values{"tag": {oldTag}}))
+	require.NoError(err)
+	defer res.Body.Close()
+	assert.Empty(e.unmarshalTweets(res), "Expected the tweet to not be listed by its old tag")
+
+	res, err = http.Get(e.buildURL("/tweets", url.Values{"tag": {newTag}}))
+	require.NoError(err)
+	defer res.Body.Close()
+	tweets := e.unmarshalTweets(res)
+	require.Len(tweets, 1, "Expected the tweet to be listed by its new tag")
+	assert.Equal(tweet.ID, tweets[0].ID)
+
+	message := "This tweet lost its hashtag"
+	res = e.editTweet(tweet.ID, models.TweetPatch{Message: &message})
+	defer res.Body.Close()
+
+	require.Equal(http.StatusOK, res.StatusCode, "Expected `status code` to be `200`")
+	edited = e.unmarshalTweet(res)
+	assert.Equal([]string{newTag}, edited.Tags, "Expected hashtags removed from the message to be removed from the tags")
+	assert.Equal(2, edited.Revisions)
+}
+
+func (e *E2ETestSuite) Test_EditTweetSearch() {
+	var (
+		require = require.New(e.T())
+		assert  = assert.New(e.T())
+		before  = fmt.Sprintf("e2e%08x", rand.Uint32())
+		after   = fmt.Sprintf("e2e%08x", rand.Uint32())
+		message = "This tweet was edited " + after
+	)
+
+	tweet := e.createTweet("This tweet will be edited "+before, "e2e-tests")
+
+	res := e.editTweet(tweet.ID, models.TweetPatch{Message: &message})
+	defer res.Body.Close()
+	require.Equal(http.StatusOK, res.StatusCode, "Expected `status code` to be `200`")
+
+	res, err := http.Get(e.buildURL("/tweets/_search", url.Values{"q": {before}}))
+	require.NoError(err)
+	defer res.Body.Close()
+	assert.Empty(e.unmarshalSearchPage(res).Tweets, "Expected edited tweets to not be found by their old message")
+
+	res, err = http.Get(e.buildURL("/tweets/_search", url.Values{"q": {after}}))
+	require.NoError(err)
+	defer res.Body.Close()
+	page := e.unmarshalSearchPage(res)
+	require.Len(page.Tweets, 1, "Expected edited tweets to be found by their new message")
+	assert.Equal(tweet.ID, page.Tweets[0].ID)
+}
+
+func (e *E2ETestSuite) Test_EditTweetInvalid() {
+	var (
+		assert  = assert.New(e.T())
+		tooLong = strings.Repeat("a", 141)
+		empty   = ""
+	)
+
+	tweet := e.createTweet("This tweet won't be edited", "e2e-tests")
+
+	for name, patch := range map[string]models.TweetPatch{
+		"nothing to edit":  {},
+		"empty message":    {Message: &empty},
+		"too long message": {Message: &tooLong},
+		"invalid tag":      {Tag: &empty},
+		"too many tags":    {Tags: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11"}},
+	} {
+		res := e.editTweet(tweet.ID, patch)
+		defer res.Body.Close()
+
+		assert.Equalf(http.StatusBadRequest, res.StatusCode, "Expected `status code` of %s to be `400`", name)
+		output := e.unmarshalError(res)
+		assert.Equalf(models.ErrKindInvalid, output.Kind, "Expected `error kind` of %s to be `invalid`", name)
+	}
+}
+
+func (e *E2ETestSuite) Test_EditTweetMissing() {
+	var (
+		require = require.New(e.T())
+		assert  = assert.New(e.T())
+		message = "This tweet doesn't exist"
+	)
+
+	res := e.editTweet(999999999, models.TweetPatch{Message: &message})
+	defer res.Body.Close()
+	assert.Equal(http.StatusNotFound, res.StatusCode, "Expected `status code` to be `404`")
+
+	res, err := http.Get(e.buildURL("/tweets/999999999/revisions", nil))
+	require.NoError(err)
+	defer res.Body.Close()
+	assert.Equal(http.StatusNotFound, res.StatusCode, "Expected `status code` to be `404`")
+
+	tweet := e.createTweet("This tweet will be deleted before it's edited", "e2e-tests")
+	res = e.deleteTweet(tweet.ID)
+	defer res.Body.Close()
+
+	res = e.editTweet(tweet.ID, models.TweetPatch{Message: &message})
+	defer res.Body.Close()
+	assert.Equal(http.StatusGone, res.StatusCode, "Expected `status code` of editing a deleted tweet to be `410`")
+}
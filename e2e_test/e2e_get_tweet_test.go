@@ -0,0 +1,78 @@
+package test
+
+import (
+	"fmt"
+	"net/http"
+	"simple_twitter/models"
+	"strings"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (e *E2ETestSuite) Test_GetTweet() {
+	var (
+		require = require.New(e.T())
+		assert  = assert.New(e.T())
+	)
+
+	input := models.CreateTweetRequest{
+		Message: "This is a test tweet! ✅",
+		Tag:     "e2e-tests",
+	}
+
+	res, err := http.Post(e.buildURL("/tweets", nil), "application/json", e.marshalTweet(input))
+	require.NoError(err)
+	defer res.Body.Close()
+
+	require.Equal(http.StatusCreated, res.StatusCode)
+	created := e.unmarshalTweet(res)
+
+	res, err = http.Get(e.buildURL(fmt.Sprintf("/tweets/%d", created.ID), nil))
+	require.NoError(err)
+	defer res.Body.Close()
+
+	assert.Equal(http.StatusOK, res.StatusCode)
+	output := e.unmarshalTweet(res)
+
+	assert.Equal(created.ID, output.ID, "Expected `id` to be the same as the created tweet")
+	assert.Equal(input.Message, output.Message, "Expected `message` to be the same as the created tweet")
+	assert.Equal(input.Tag, output.Tag, "Expected `tag` to be the same as the created tweet")
+	assert.True(created.CreatedAt.Equal(output.CreatedAt), "Expected `created at` to be the same as the created tweet")
+}
+
+func (e *E2ETestSuite) Test_GetTweetMissing() {
+	var (
+		require = require.New(e.T())
+		assert  = assert.New(e.T())
+	)
+
+	res, err := http.Get(e.buildURL("/tweets/999999999", nil))
+	require.NoError(err)
+	defer res.Body.Close()
+
+	assert.Equal(http.StatusNotFound, res.StatusCode, "Expected `status code` to be `404`")
+	output := e.unmarshalError(res)
+
+	assert.Equal(models.ErrKindMissing, output.Kind, "Expected `error kind` to be `missing`")
+	assert.True(strings.Contains(output.Message, "999999999"), "Expected `error message` to contain the `id`")
+}
+
+func (e *E2ETestSuite) Test_GetTweetWithInvalidID() {
+	var (
+		require = require.New(e.T())
+		assert  = assert.New(e.T())
+	)
+
+	for _, id := range []string{"abc", "0", "-1"} {
+		res, err := http.Get(e.buildURL("/tweets/"+id, nil))
+		require.NoError(err)
+		defer res.Body.Close()
+
+		assert.Equalf(http.StatusBadRequest, res.StatusCode, "Expected `status code` to be `400` for `id` %s", id)
+		output := e.unmarshalError(res)
+
+		assert.Equal(models.ErrKindInvalid, output.Kind, "Expected `error kind` to be `invalid`")
+		assert.True(strings.Contains(output.Message, "id"), "Expected `error message` to contain `id`")
+	}
+}
@@ -1,8 +1,12 @@
 package test
 
 import (
+	"fmt"
 	"net/http"
 	"net/url"
+	"simple_twitter/models"
+	"strings"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -26,6 +30,8 @@ func (e *E2ETestSuite) Test_GetTweetsWithTag() {
 }
 
 func (e *E2ETestSuite) Test_GetTweetsWithLimit() {
+	e.skipUnlessSeeded()
+
 	var (
 		require = require.New(e.T())
 		assert  = assert.New(e.T())
@@ -44,16 +50,276 @@ func (e *E2ETestSuite) Test_GetTweetsWithLimit() {
 }
 
 func (e *E2ETestSuite) Test_GetTweetsWithNoTag() {
+	var (
+		require = require.New(e.T())
+		assert  = assert.New(e.T())
+		tags    = []string{e.uniqueTag("e2e-all-tags"), e.uniqueTag("e2e-all-tags")}
+	)
+
+	var created []int64
+	for _, tag := range tags {
+		res, err := http.Post(e.buildURL("/tweets", nil), "application/json", e.marshalTweet(models.CreateTweetRequest{
+			Message: "This is a test tweet! ✅",
+			Tag:     tag,
+		}))
+		require.NoError(err)
+		require.Equal(http.StatusCreated, res.StatusCode)
+		created = append([]int64{e.unmarshalTweet(res).ID}, created...)
+		res.Body.Close()
+	}
+
+	res, err := http.Get(e.buildURL("/tweets", url.Values{"sort": {"newest"}, "limit": {"2"}}))
+	require.NoError(err)
+	defer res.Body.Close()
+
+	assert.Equal(http.StatusOK, res.StatusCode)
+	tweets := e.unmarshalTweets(res)
+	require.Len(tweets, 2, "Expected tweets to be returned when tag is unspecified")
+	assert.Equal(created, []int64{tweets[0].ID, tweets[1].ID}, "Expected the latest tweets across all tags")
+}
+
+func (e *E2ETestSuite) Test_GetTweetsInTimeWindow() {
+	e.skipUnlessSeeded()
+
+	var (
+		require = require.New(e.T())
+		assert  = assert.New(e.T())
+
+		since = time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+		until = time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	res, err := http.Get(e.buildURL("/tweets", url.Values{
+		"since": {since.Format(time.RFC3339)},
+		"until": {until.Format(time.RFC3339)},
+		"limit": {"500"},
+	}))
+	require.NoError(err)
+	defer res.Body.Close()
+
+	assert.Equal(http.StatusOK, res.StatusCode)
+	tweets := e.unmarshalTweets(res)
+	assert.Len(tweets, 40, "Expected the 40 tweets from March 2024")
+	for _, tweet := range tweets {
+		assert.False(tweet.CreatedAt.Before(since), "Expected no tweets before `since`")
+		assert.True(tweet.CreatedAt.Before(until), "Expected no tweets at or after `until`")
+	}
+}
+
+func (e *E2ETestSuite) Test_GetTweetsInIDWindow() {
+	e.skipUnlessSeeded()
+
 	var (
 		require = require.New(e.T())
 		assert  = assert.New(e.T())
 	)
 
-	res, err := http.Get(e.buildURL("/tweets", nil))
+	res, err := http.Get(e.buildURL("/tweets", url.Values{"since_id": {"100"}, "max_id": {"110"}}))
 	require.NoError(err)
 	defer res.Body.Close()
 
 	assert.Equal(http.StatusOK, res.StatusCode)
 	tweets := e.unmarshalTweets(res)
-	assert.Len(tweets, 0, "Expected no tweets to be returned when tag is unspecified")
+	assert.Len(tweets, 10, "Expected the tweets with ids 101 through 110")
+	for _, tweet := range tweets {
+		assert.Greater(tweet.ID, int64(100), "Expected no tweets with `id` at or below `since_id`")
+		assert.LessOrEqual(tweet.ID, int64(110), "Expected no tweets with `id` above `max_id`")
+	}
+}
+
+func (e *E2ETestSuite) Test_GetTweetsWithInvalidWindow() {
+	var (
+		require = require.New(e.T())
+		assert  = assert.New(e.T())
+	)
+
+	for param, query := range map[string]url.Values{
+		"since":    {"since": {"yesterday"}},
+		"until":    {"since": {"2025-01-01T00:00:00Z"}, "until": {"2024-01-01T00:00:00Z"}},
+		"since_id": {"since_id": {"110"}, "max_id": {"100"}},
+		"max_id":   {"max_id": {"-1"}},
+	} {
+		res, err := http.Get(e.buildURL("/tweets", query))
+		require.NoError(err)
+		defer res.Body.Close()
+
+		assert.Equal(http.StatusBadRequest, res.StatusCode, "Expected `status code` to be `400`")
+		output := e.unmarshalError(res)
+
+		assert.Equal(models.ErrKindInvalid, output.Kind, "Expected `error kind` to be `invalid`")
+		assert.Truef(strings.Contains(output.Message, param), "Expected `error message` to contain `%s`", param)
+	}
+}
+
+func (e *E2ETestSuite) Test_GetTweetsWithCursor() {
+	var (
+		require = require.New(e.T())
+		assert  = assert.New(e.T())
+		tag     = e.uniqueTag("e2e-cursor")
+	)
+
+	var created []int64
+	for i := range 5 {
+		res, err := http.Post(e.buildURL("/tweets", nil), "application/json", e.marshalTweet(models.CreateTweetRequest{
+			Message: fmt.Sprintf("Cursor tweet number %d", i),
+			Tag:     tag,
+		}))
+		require.NoError(err)
+		require.Equal(http.StatusCreated, res.StatusCode)
+		created = append(created, e.unmarshalTweet(res).ID)
+		res.Body.Close()
+	}
+
+	var (
+		listed []int64
+		query  = url.Values{"tag": {tag}, "limit": {"2"}}
+	)
+	for range len(created) {
+		res, err := http.Get(e.buildURL("/tweets", query))
+		require.NoError(err)
+		defer res.Body.Close()
+
+		require.Equal(http.StatusOK, res.StatusCode)
+		page := e.unmarshalTweetPage(res)
+		assert.LessOrEqual(len(page.Tweets), 2, "Expected at most 2 tweets to be returned when limit is 2")
+		for _, tweet := range page.Tweets {
+			listed = append(listed, tweet.ID)
+		}
+
+		if page.NextCursor == nil {
+			break
+		}
+
+		cursor, err := page.NextCursor.MarshalText()
+		require.NoError(err)
+		query.Set("cursor", string(cursor))
+	}
+
+	assert.Equal(created, listed, "Expected paging with `next_cursor` to list every tweet exactly once, in order")
+}
+
+func (e *E2ETestSuite) Test_GetTweetsWithInvalidCursor() {
+	var (
+		require = require.New(e.T())
+		assert  = assert.New(e.T())
+	)
+
+	res, err := http.Get(e.buildURL("/tweets", url.Values{"tag": {"protocol-reboot"}, "cursor": {"not-a-cursor"}}))
+	require.NoError(err)
+	defer res.Body.Close()
+
+	assert.Equal(http.StatusBadRequest, res.StatusCode, "Expected `status code` to be `400`")
+	output := e.unmarshalError(res)
+
+	assert.Equal(models.ErrKindInvalid, output.Kind, "Expected `error kind` to be `invalid`")
+	assert.True(strings.Contains(output.Message, "cursor"), "Expected `error message` to contain `cursor`")
+}
+
+func (e *E2ETestSuite) Test_GetTweetsWithCursorAndOffset() {
+	var (
+		require = require.New(e.T())
+		assert  = assert.New(e.T())
+	)
+
+	cursor, err := models.Cursor{CreatedAt: time.Now(), ID: 1}.MarshalText()
+	require.NoError(err)
+
+	res, err := http.Get(e.buildURL("/tweets", url.Values{"tag": {"protocol-reboot"}, "cursor": {string(cursor)}, "offset": {"10"}}))
+	require.NoError(err)
+	defer res.Body.Close()
+
+	assert.Equal(http.StatusBadRequest, res.StatusCode, "Expected `status code` to be `400`")
+	output := e.unmarshalError(res)
+
+	assert.Equal(models.ErrKindInvalid, output.Kind, "Expected `error kind` to be `invalid`")
+	assert.True(strings.Contains(output.Message, "offset"), "Expected `error message` to contain `offset`")
+}
+
+func (e *E2ETestSuite) Test_GetTweetsSorted() {
+	var (
+		require = require.New(e.T())
+		assert  = assert.New(e.T())
+	)
+
+	for _, sort := range []string{"", "oldest", "newest"} {
+		res, err := http.Get(e.buildURL("/tweets", url.Values{"tag": {"protocol-reboot"}, "sort": {sort}}))
+		require.NoError(err)
+		defer res.Body.Close()
+
+		assert.Equal(http.StatusOK, res.StatusCode)
+		tweets := e.unmarshalTweets(res)
+		for idx := 1; idx < len(tweets); idx++ {
+			previous, current := tweets[idx-1], tweets[idx]
+			if sort == "newest" {
+				previous, current = current, previous
+			}
+
+			assert.Truef(
+				previous.CreatedAt.Before(current.CreatedAt) || (previous.CreatedAt.Equal(current.CreatedAt) && previous.ID < current.ID),
+				"Expected tweets to be ordered by `created at` and `id` when sorting by `%s`", sort,
+			)
+		}
+	}
+}
+
+func (e *E2ETestSuite) Test_GetTweetsSortedNewestWithCursor() {
+	var (
+		require = require.New(e.T())
+		assert  = assert.New(e.T())
+		tag     = e.uniqueTag("e2e-sort")
+	)
+
+	var created []int64
+	for i := range 3 {
+		res, err := http.Post(e.buildURL("/tweets", nil), "application/json", e.marshalTweet(models.CreateTweetRequest{
+			Message: fmt.Sprintf("Sorted tweet number %d", i),
+			Tag:     tag,
+		}))
+		require.NoError(err)
+		require.Equal(http.StatusCreated, res.StatusCode)
+		created = append([]int64{e.unmarshalTweet(res).ID}, created...)
+		res.Body.Close()
+	}
+
+	res, err := http.Get(e.buildURL("/tweets", url.Values{"tag": {tag}, "sort": {"newest"}, "limit": {"2"}}))
+	require.NoError(err)
+	defer res.Body.Close()
+
+	require.Equal(http.StatusOK, res.StatusCode)
+	first := e.unmarshalTweetPage(res)
+	require.NotNil(first.NextCursor, "Expected a `next cursor` when there are more tweets")
+
+	cursor, err := first.NextCursor.MarshalText()
+	require.NoError(err)
+
+	res, err = http.Get(e.buildURL("/tweets", url.Values{"tag": {tag}, "sort": {"newest"}, "limit": {"2"}, "cursor": {string(cursor)}}))
+	require.NoError(err)
+	defer res.Body.Close()
+
+	require.Equal(http.StatusOK, res.StatusCode)
+	second := e.unmarshalTweetPage(res)
+	assert.Nil(second.NextCursor, "Expected no `next cursor` on the last page")
+
+	var listed []int64
+	for _, tweet := range append(first.Tweets, second.Tweets...) {
+		listed = append(listed, tweet.ID)
+	}
+	assert.Equal(created, listed, "Expected tweets to be listed newest first")
+}
+
+func (e *E2ETestSuite) Test_GetTweetsWithInvalidSort() {
+	var (
+		require = require.New(e.T())
+		assert  = assert.New(e.T())
+	)
+
+	res, err := http.Get(e.buildURL("/tweets", url.Values{"tag": {"protocol-reboot"}, "sort": {"random"}}))
+	require.NoError(err)
+	defer res.Body.Close()
+
+	assert.Equal(http.StatusBadRequest, res.StatusCode, "Expected `status code` to be `400`")
+	output := e.unmarshalError(res)
+
+	assert.Equal(models.ErrKindInvalid, output.Kind, "Expected `error kind` to be `invalid`")
+	assert.True(strings.Contains(output.Message, "sort"), "Expected `error message` to contain `sort`")
 }
@@ -0,0 +1,68 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"simple_twitter/api"
+	"simple_twitter/models"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rateLimitedServer starts a server in front of the suite's storage that allows 2 tweets an hour per client
+func (e *E2ETestSuite) rateLimitedServer() *httptest.Server {
+	server := api.NewServer("", e.twitter,
+		api.WithAuthentication(e.twitter, api.DefaultAnonymousRoutes...),
+		api.WithRateLimits(api.NewMemoryRateLimitStore(), map[string]api.RateLimit{
+			"POST /tweets": {Requests: 2, Period: time.Hour},
+		}),
+	)
+	s := httptest.NewServer(server.Handler)
+	e.T().Cleanup(s.Close)
+	return s
+}
+
+func (e *E2ETestSuite) Test_RateLimitCreateTweet() {
+	var (
+		require = require.New(e.T())
+		assert  = assert.New(e.T())
+		server  = e.rateLimitedServer()
+		tweet   = models.CreateTweetRequest{Message: "This tweet is rate limited", Tag: "e2e-tests"}
+	)
+
+	_, token, err := e.twitter.CreateToken(context.Background(), "e2e", "", []string{"writer"})
+	require.NoError(err)
+
+	_, other, err := e.twitter.CreateToken(context.Background(), "e2e", "", []string{"writer"})
+	require.NoError(err)
+
+	for _, remaining := range []string{"1", "0"} {
+		res := e.request(server, http.MethodPost, "/tweets", token, tweet)
+		defer res.Body.Close()
+
+		assert.Equal(http.StatusCreated, res.StatusCode, "Expected `status code` within the limit to be `201`")
+		assert.Equal("2", res.Header.Get("RateLimit-Limit"))
+		assert.Equal(remaining, res.Header.Get("RateLimit-Remaining"))
+	}
+
+	res := e.request(server, http.MethodPost, "/tweets", token, tweet)
+	defer res.Body.Close()
+
+	assert.Equal(http.StatusTooManyRequests, res.StatusCode, "Expected `status code` beyond the limit to be `429`")
+	assert.Equal("1800", res.Header.Get("Retry-After"), "Expected to retry once a request is refilled")
+	assert.Equal("0", res.Header.Get("RateLimit-Remaining"))
+	output := e.unmarshalError(res)
+	assert.Equal(models.ErrKindRateLimited, output.Kind, "Expected `error kind` to be `rate_limited`")
+
+	res = e.request(server, http.MethodPost, "/tweets", other, tweet)
+	defer res.Body.Close()
+	assert.Equal(http.StatusCreated, res.StatusCode, "Expected tokens to be limited separately")
+
+	res = e.request(server, http.MethodGet, "/tweets", token, nil)
+	defer res.Body.Close()
+	assert.Equal(http.StatusOK, res.StatusCode, "Expected routes without a limit to not be limited")
+	assert.Empty(res.Header.Get("RateLimit-Limit"))
+}
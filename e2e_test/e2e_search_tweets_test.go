@@ -0,0 +1,185 @@
+package test
+
+import (
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"simple_twitter/models"
+	"strings"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (e *E2ETestSuite) Test_SearchTweets() {
+	var (
+		require = require.New(e.T())
+		assert  = assert.New(e.T())
+		word    = fmt.Sprintf("e2e%08x", rand.Uint32())
+	)
+
+	var (
+		alpha = e.createTweet(word+" alpha", "e2e-tests")
+		beta  = e.createTweet(word+" beta", "e2e-tests")
+		gamma = e.createTweet("gamma "+word+" delta", "e2e-tests")
+	)
+
+	for _, test := range []struct {
+		q        string
+		expected []int64
+	}{
+		{q: word, expected: []int64{alpha.ID, beta.ID, gamma.ID}},
+		{q: strings.ToUpper(word), expected: []int64{alpha.ID, beta.ID, gamma.ID}},
+		{q: word + " alpha", expected: []int64{alpha.ID}},
+		{q: word + " -beta", expected: []int64{alpha.ID, gamma.ID}},
+		{q: fmt.Sprintf(`"%s delta"`, word), expected: []int64{gamma.ID}},
+		{q: fmt.Sprintf(`"gamma %s"`, word), expected: []int64{gamma.ID}},
+		{q: fmt.Sprintf(`%s -"gamma %s"`, word, word), expected: []int64{alpha.ID, beta.ID}},
+		{q: word + " +alpha* (beta)", expected: []int64{}}, // Operators are only separators
+	} {
+		res, err := http.Get(e.buildURL("/tweets/_search", url.Values{"q": {test.q}}))
+		require.NoError(err)
+		defer res.Body.Close()
+
+		assert.Equal(http.StatusOK, res.StatusCode, "Expected `status code` to be `200` for %q", test.q)
+		page := e.unmarshalSearchPage(res)
+
+		var ids []int64
+		for _, tweet := range page.Tweets {
+			ids = append(ids, tweet.ID)
+		}
+		assert.ElementsMatch(test.expected, ids, "Expected search for %q to find the matching tweets", test.q)
+	}
+}
+
+func (e *E2ETestSuite) Test_SearchTweetsWithTag() {
+	var (
+		require = require.New(e.T())
+		assert  = assert.New(e.T())
+		word    = fmt.Sprintf("e2e%08x", rand.Uint32())
+		tag     = e.uniqueTag("e2e-search")
+	)
+
+	e.createTweet(word, "e2e-tests")
+	tagged := e.createTweet(word, tag)
+
+	res, err := http.Get(e.buildURL("/tweets/_search", url.Values{"q": {word}, "tag": {tag}}))
+	require.NoError(err)
+	defer res.Body.Close()
+
+	assert.Equal(http.StatusOK, res.StatusCode)
+	page := e.unmarshalSearchPage(res)
+	require.Len(page.Tweets, 1, "Expected only tweets with the tag to be found")
+	assert.Equal(tagged.ID, page.Tweets[0].ID, "Expected only tweets with the tag to be found")
+}
+
+func (e *E2ETestSuite) Test_SearchTweetsWithPagination() {
+	var (
+		require = require.New(e.T())
+		assert  = assert.New(e.T())
+		word    = fmt.Sprintf("e2e%08x", rand.Uint32())
+	)
+
+	var created []int64
+	for range 3 {
+		created = append(created, e.createTweet(word, "e2e-tests").ID)
+	}
+
+	var (
+		found  []int64
+		offset = 0
+	)
+	for range 3 {
+		res, err := http.Get(e.buildURL("/tweets/_search", url.Values{"q": {word}, "limit": {"2"}, "offset": {fmt.Sprint(offset)}}))
+		require.NoError(err)
+		defer res.Body.Close()
+
+		assert.Equal(http.StatusOK, res.StatusCode)
+		page := e.unmarshalSearchPage(res)
+		for _, tweet := range page.Tweets {
+			found = append(found, tweet.ID)
+		}
+
+		if page.NextOffset == 0 {
+			break
+		}
+		offset = page.NextOffset
+	}
+
+	assert.ElementsMatch(created, found, "Expected paging through the results to find every tweet once")
+}
+
+func (e *E2ETestSuite) Test_SearchTweetsWithInvalidQuery() {
+	var (
+		require = require.New(e.T())
+		assert  = assert.New(e.T())
+	)
+
+	for _, q := range []string{
+		"",
+		"   ",
+		"+-*()",
+		`"never closed`,
+		"-excluded -only",
+		strings.Repeat("word ", 100),
+	} {
+		res, err := http.Get(e.buildURL("/tweets/_search", url.Values{"q": {q}}))
+		require.NoError(err)
+		defer res.Body.Close()
+
+		assert.Equal(http.StatusBadRequest, res.StatusCode, "Expected `status code` to be `400` for %q", q)
+		output := e.unmarshalError(res)
+
+		assert.Equal(models.ErrKindInvalid, output.Kind, "Expected `error kind` to be `invalid` for %q", q)
+		assert.True(strings.Contains(output.Message, "q"), "Expected `error message` to contain `q` for %q", q)
+	}
+}
+
+func (e *E2ETestSuite) Test_SearchTweetsWithPrefix() {
+	var (
+		require = require.New(e.T())
+		assert  = assert.New(e.T())
+		word    = fmt.Sprintf("e2e%08x", rand.Uint32())
+	)
+
+	var (
+		prefixed = e.createTweet(word+"suffix", "e2e-tests")
+		exact    = e.createTweet(word, "e2e-tests")
+	)
+
+	res, err := http.Get(e.buildURL("/tweets/_search", url.Values{"q": {word + "*"}}))
+	require.NoError(err)
+	defer res.Body.Close()
+
+	assert.Equal(http.StatusOK, res.StatusCode)
+	page := e.unmarshalSearchPage(res)
+
+	var ids []int64
+	for _, tweet := range page.Tweets {
+		ids = append(ids, tweet.ID)
+	}
+	assert.ElementsMatch([]int64{prefixed.ID, exact.ID}, ids, "Expected every word starting with the prefix to match")
+}
+
+func (e *E2ETestSuite) Test_SearchTweetsSeeded() {
+	e.skipUnlessSeeded()
+
+	var (
+		require = require.New(e.T())
+		assert  = assert.New(e.T())
+	)
+
+	res, err := http.Get(e.buildURL("/tweets/_search", url.Values{"q": {`"hard drive" transmit`}, "limit": {"500"}}))
+	require.NoError(err)
+	defer res.Body.Close()
+
+	assert.Equal(http.StatusOK, res.StatusCode)
+	page := e.unmarshalSearchPage(res)
+
+	assert.NotEmpty(page.Tweets, "Expected tweets in the seed data to be found")
+	for _, tweet := range page.Tweets {
+		message := strings.ToLower(tweet.Message)
+		assert.True(strings.Contains(message, "hard drive") && strings.Contains(message, "transmit"), "Expected %q to match", tweet.Message)
+	}
+}
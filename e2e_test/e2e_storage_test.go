@@ -0,0 +1,84 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"simple_twitter/database"
+	"simple_twitter/models"
+	"simple_twitter/twitter/storagetest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMySQLStorageConformance(t *testing.T) {
+	storagetest.Run(t, func(t *testing.T) storagetest.Harness {
+		conn, dbName := createMySQLDatabase(t)
+		t.Cleanup(func() {
+			// NB: Don't do this in production
+			_, err := conn.Exec(fmt.Sprintf("DROP DATABASE `%s`", dbName))
+			require.NoError(t, err)
+			require.NoError(t, conn.Close())
+		})
+
+		return storagetest.Harness{
+			Storage: database.NewTwitterDatabase(conn),
+			InsertTweet: func(ctx context.Context, tweet models.Tweet) (int64, error) {
+				result, err := conn.ExecContext(
+					ctx,
+					"INSERT INTO Tweets (message, tag, created_at) VALUES (?, ?, ?)",
+					tweet.Message, tweet.Tags[0], tweet.CreatedAt,
+				)
+				if err != nil {
+					return 0, err
+				}
+
+				id, err := result.LastInsertId()
+				if err != nil {
+					return 0, err
+				}
+
+				for position, tag := range tweet.Tags {
+					_, err := conn.ExecContext(ctx, "INSERT INTO TweetTags (tweet_id, position, tag, created_at) SELECT id, ?, ?, created_at FROM Tweets WHERE id = ?", position, tag, id)
+					if err != nil {
+						return 0, err
+					}
+				}
+
+				return id, nil
+			},
+		}
+	})
+}
+
+func TestPostgresStorageConformance(t *testing.T) {
+	storagetest.Run(t, func(t *testing.T) storagetest.Harness {
+		conn, dbName := createPostgresDatabase(t)
+		t.Cleanup(func() { dropPostgresDatabase(t, conn, dbName) })
+
+		return storagetest.Harness{
+			Storage: database.NewPostgresTwitterDatabase(conn),
+			InsertTweet: func(ctx context.Context, tweet models.Tweet) (int64, error) {
+				var id int64
+				err := conn.GetContext(
+					ctx,
+					&id,
+					"INSERT INTO Tweets (message, tag, created_at) VALUES ($1, $2, $3) RETURNING id",
+					tweet.Message, tweet.Tags[0], tweet.CreatedAt.UTC(),
+				)
+				if err != nil {
+					return 0, err
+				}
+
+				for position, tag := range tweet.Tags {
+					_, err := conn.ExecContext(ctx, "INSERT INTO TweetTags (tweet_id, position, tag, created_at) SELECT id, $1, $2, created_at FROM Tweets WHERE id = $3", position, tag, id)
+					if err != nil {
+						return 0, err
+					}
+				}
+
+				return id, nil
+			},
+		}
+	})
+}
@@ -2,7 +2,7 @@ package test
 
 import (
 	"bytes"
-	"encoding/hex"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -10,16 +10,19 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"os"
+	"path/filepath"
 	"simple_twitter/api"
 	"simple_twitter/database"
 	"simple_twitter/models"
+	"simple_twitter/search"
 	"simple_twitter/twitter"
 	"testing"
-	"time"
 
-	"github.com/golang-migrate/migrate"
-	"github.com/golang-migrate/migrate/database/mysql"
-	_ "github.com/golang-migrate/migrate/source/file"
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database/mysql"
+	"github.com/golang-migrate/migrate/v4/database/postgres"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/stretchr/testify/require"
@@ -27,25 +30,157 @@ import (
 )
 
 func TestE2ETestSuite(t *testing.T) {
-	suite.Run(t, &E2ETestSuite{})
+	suite.Run(t, &E2ETestSuite{storageDriver: "mysql"})
+}
+
+func TestE2EInMemoryTestSuite(t *testing.T) {
+	suite.Run(t, &E2ETestSuite{storageDriver: "memory"})
+}
+
+func TestE2EPostgresTestSuite(t *testing.T) {
+	suite.Run(t, &E2ETestSuite{storageDriver: "postgres"})
+}
+
+func TestE2ESQLiteTestSuite(t *testing.T) {
+	suite.Run(t, &E2ETestSuite{storageDriver: "sqlite"})
 }
 
 type E2ETestSuite struct {
 	suite.Suite
 
+	storageDriver string
+	seeded        bool // Whether the storage contains the seed data from `database/seeds`
+	searchIndex   bool // Whether tweets are searched with an in process index, as the storage can't search
+
 	conn   *sqlx.DB
 	dbName string
 
-	server *httptest.Server
+	postgres       *sqlx.DB
+	postgresDBName string
+
+	sqlite *sqlx.DB
+
+	twitter twitter.Twitter
+	server  *httptest.Server
 }
 
 func (e *E2ETestSuite) SetupSuite() {
+	var storage twitter.Storage
+	switch e.storageDriver {
+	case "mysql":
+		storage = e.setupMySQL()
+		e.seeded = true
+	case "postgres":
+		storage = e.setupPostgres()
+		e.seeded = true
+		e.searchIndex = true
+	case "sqlite":
+		storage = e.setupSQLite()
+		e.seeded = true
+		e.searchIndex = true
+	case "memory":
+		storage = database.NewInMemoryTwitterDatabase()
+		e.searchIndex = true
+	default:
+		e.T().Fatalf("unknown storage driver %q", e.storageDriver)
+	}
+
+	var options []twitter.Option
+	if e.searchIndex {
+		options = append(options, twitter.WithSearchIndex(search.NewIndex()))
+	}
+
+	twitter := twitter.NewTwitter(storage, options...)
+	err := twitter.IndexTweets(context.Background())
+	require.NoError(e.T(), err)
+
+	server := api.NewServer("", twitter)
+
+	e.twitter = twitter
+	e.server = httptest.NewServer(server.Handler)
+}
+
+func (e *E2ETestSuite) setupMySQL() twitter.Storage {
+	var (
+		require = require.New(e.T())
+	)
+
+	conn, dbName := createMySQLDatabase(e.T())
+
+	e.conn = conn
+	e.dbName = dbName
+
+	m, err := mysql.WithInstance(conn.DB, &mysql.Config{MigrationsTable: "seed_migrations"})
+	require.NoError(err)
+
+	seeds, err := migrate.NewWithDatabaseInstance("file://../database/seeds", "mysql", m)
+	require.NoError(err)
+
+	err = seeds.Up()
+	require.NoError(err)
+
+	return database.NewTwitterDatabase(e.conn)
+}
+
+func (e *E2ETestSuite) setupPostgres() twitter.Storage {
+	var (
+		require = require.New(e.T())
+	)
+
+	conn, dbName := createPostgresDatabase(e.T())
+
+	e.postgres = conn
+	e.postgresDBName = dbName
+
+	m, err := postgres.WithInstance(conn.DB, &postgres.Config{MigrationsTable: "seed_migrations"})
+	require.NoError(err)
+
+	seeds, err := migrate.NewWithDatabaseInstance("file://../database/postgres/seeds", "postgres", m)
+	require.NoError(err)
+
+	err = seeds.Up()
+	require.NoError(err)
+
+	return database.NewPostgresTwitterDatabase(conn)
+}
+
+func (e *E2ETestSuite) setupSQLite() twitter.Storage {
+	var (
+		require = require.New(e.T())
+	)
+
+	conn, err := database.ConnectSQLite(filepath.Join(e.T().TempDir(), "twitter.db"))
+	require.NoError(err)
+
+	err = database.MigrateSQLite(context.Background(), conn)
+	require.NoError(err)
+
+	// The MySQL seed files are plain enough SQL for SQLite to understand as well
+	files, err := filepath.Glob("../database/seeds/*.up.sql")
+	require.NoError(err)
+
+	for _, file := range files {
+		seeds, err := os.ReadFile(file)
+		require.NoError(err)
+
+		_, err = conn.Exec(string(seeds))
+		require.NoError(err)
+	}
+
+	e.sqlite = conn
+
+	return database.NewSQLiteTwitterDatabase(conn)
+}
+
+// createMySQLDatabase creates a new, randomly named, database with all migrations applied. The caller
+// is responsible for dropping the database and closing the connection.
+func createMySQLDatabase(t *testing.T) (*sqlx.DB, string) {
 	var (
 		username = "root"
 		password = "TopSecret"
 		host     = "localhost:3308"
 
-		require = require.New(e.T())
+		require = require.New(t)
 	)
 
 	conn, err := sqlx.Open("mysql", fmt.Sprintf("%s:%s@tcp(%s)/?parseTime=true&multiStatements=true", username, password, host))
@@ -54,9 +189,7 @@ func (e *E2ETestSuite) SetupSuite() {
 	err = conn.Ping()
 	require.NoError(err)
 
-	b := make([]byte, 8)
-	rand.New(rand.NewSource(time.Now().Unix())).Read(b)
-	dbName := hex.EncodeToString(b)
+	dbName := fmt.Sprintf("%016x", rand.Uint64())
 
 	// NB: Don't do this in production
 	_, err = conn.Exec(fmt.Sprintf("CREATE DATABASE `%s`;", dbName))
@@ -68,9 +201,6 @@ func (e *E2ETestSuite) SetupSuite() {
 	conn, err = sqlx.Open("mysql", fmt.Sprintf("%s:%s@tcp(%s)/%s?parseTime=true&multiStatements=true", username, password, host, dbName))
 	require.NoError(err)
 
-	e.conn = conn
-	e.dbName = dbName
-
 	m, err := mysql.WithInstance(conn.DB, &mysql.Config{})
 	require.NoError(err)
 
@@ -80,36 +210,112 @@ func (e *E2ETestSuite) SetupSuite() {
 	err = migrations.Up()
 	require.NoError(err)
 
-	m, err = mysql.WithInstance(conn.DB, &mysql.Config{MigrationsTable: "seed_migrations"})
+	return conn, dbName
+}
+
+func (e *E2ETestSuite) TearDownSuite() {
+	var (
+		require = require.New(e.T())
+	)
+
+	e.server.Close()
+
+	if e.postgres != nil {
+		dropPostgresDatabase(e.T(), e.postgres, e.postgresDBName)
+	}
+
+	if e.sqlite != nil {
+		err := e.sqlite.Close()
+		require.NoError(err)
+	}
+
+	if e.conn == nil {
+		return
+	}
+
+	// NB: Don't do this either in production
+	_, err := e.conn.Exec(fmt.Sprintf("DROP DATABASE `%s`", e.dbName))
 	require.NoError(err)
 
-	seeds, err := migrate.NewWithDatabaseInstance("file://../database/seeds", "mysql", m)
+	err = e.conn.Close()
 	require.NoError(err)
+}
 
-	err = seeds.Up()
+// createPostgresDatabase creates a new, randomly named, database with all migrations applied. The caller
+// is responsible for dropping the database with dropPostgresDatabase.
+func createPostgresDatabase(t *testing.T) (*sqlx.DB, string) {
+	var (
+		username = "postgres"
+		password = "TopSecret"
+		host     = "localhost:5433"
+
+		require = require.New(t)
+	)
+
+	conn, err := database.ConnectPostgres(host, username, password, "postgres", "disable")
 	require.NoError(err)
 
-	twitter := twitter.NewTwitter(database.NewTwitterDatabase(e.conn))
-	server := api.NewServer("", twitter)
+	dbName := fmt.Sprintf("e2e_%016x", rand.Uint64())
 
-	e.server = httptest.NewServer(server.Handler)
+	// NB: Don't do this in production
+	_, err = conn.Exec(fmt.Sprintf(`CREATE DATABASE "%s"`, dbName))
+	require.NoError(err)
+
+	err = conn.Close()
+	require.NoError(err)
+
+	conn, err = database.ConnectPostgres(host, username, password, dbName, "disable")
+	require.NoError(err)
+
+	m, err := postgres.WithInstance(conn.DB, &postgres.Config{})
+	require.NoError(err)
+
+	migrations, err := migrate.NewWithDatabaseInstance("file://../database/postgres/migrations", "postgres", m)
+	require.NoError(err)
+
+	err = migrations.Up()
+	require.NoError(err)
+
+	return conn, dbName
 }
 
-func (e *E2ETestSuite) TearDownSuite() {
+// dropPostgresDatabase closes conn and drops the database it's connected to, which can't be done while
+// there are open connections to it
+func dropPostgresDatabase(t *testing.T, conn *sqlx.DB, dbName string) {
 	var (
-		require = require.New(e.T())
+		require = require.New(t)
 	)
 
-	e.server.Close()
+	err := conn.Close()
+	require.NoError(err)
 
-	// NB: Don't do this either in production
-	_, err := e.conn.Exec(fmt.Sprintf("DROP DATABASE `%s`", e.dbName))
+	conn, err = database.ConnectPostgres("localhost:5433", "postgres", "TopSecret", "postgres", "disable")
 	require.NoError(err)
+	defer conn.Close()
 
-	err = e.conn.Close()
+	// NB: Don't do this either in production
+	_, err = conn.Exec(fmt.Sprintf(`DROP DATABASE "%s"`, dbName))
 	require.NoError(err)
 }
 
+// skipUnlessSeeded skips tests that make assertions about the seed data when running against a storage that
+// isn't seeded
+func (e *E2ETestSuite) skipUnlessSeeded() {
+	if !e.seeded {
+		e.T().Skipf("storage driver %q is not seeded", e.storageDriver)
+	}
+}
+
+// uniqueTag returns a tag no other test uses, for tests that need to know every tweet with their tag
+func (e *E2ETestSuite) uniqueTag(prefix string) string {
+	return fmt.Sprintf("%s-%08x", prefix, rand.Uint32())
+}
+
+// uniqueHandle returns a handle no other test uses
+func (e *E2ETestSuite) uniqueHandle() string {
+	return fmt.Sprintf("e2e_%08x", rand.Uint32())
+}
+
 func (e *E2ETestSuite) buildURL(path string, query url.Values) string {
 	url, err := url.Parse(e.server.URL)
 	require.NoError(e.T(), err)
@@ -120,6 +326,59 @@ func (e *E2ETestSuite) buildURL(path string, query url.Values) string {
 	return url.String()
 }
 
+// createTweet creates a tweet with message and tag, failing the test if it can't be created
+func (e *E2ETestSuite) createTweet(message string, tag string) models.Tweet {
+	res, err := http.Post(e.buildURL("/tweets", nil), "application/json", e.marshalTweet(models.CreateTweetRequest{
+		Message: message,
+		Tag:     tag,
+	}))
+	require.NoError(e.T(), err)
+	defer res.Body.Close()
+
+	require.Equal(e.T(), http.StatusCreated, res.StatusCode)
+	return e.unmarshalTweet(res)
+}
+
+// createUser creates a user with handle, failing the test if it can't be created
+func (e *E2ETestSuite) createUser(handle string) models.User {
+	res := e.postJSON("/users", models.CreateUserRequest{Handle: handle})
+	defer res.Body.Close()
+
+	require.Equal(e.T(), http.StatusCreated, res.StatusCode)
+	return e.unmarshalUser(res)
+}
+
+func (e *E2ETestSuite) postJSON(path string, body any) *http.Response {
+	b, err := json.Marshal(body)
+	require.NoError(e.T(), err)
+
+	res, err := http.Post(e.buildURL(path, nil), "application/json", bytes.NewReader(b))
+	require.NoError(e.T(), err)
+	return res
+}
+
+func (e *E2ETestSuite) deleteTweet(id int64) *http.Response {
+	req, err := http.NewRequest(http.MethodDelete, e.buildURL(fmt.Sprintf("/tweets/%d", id), nil), nil)
+	require.NoError(e.T(), err)
+
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(e.T(), err)
+	return res
+}
+
+func (e *E2ETestSuite) editTweet(id int64, patch models.TweetPatch) *http.Response {
+	b, err := json.Marshal(patch)
+	require.NoError(e.T(), err)
+
+	req, err := http.NewRequest(http.MethodPatch, e.buildURL(fmt.Sprintf("/tweets/%d", id), nil), bytes.NewReader(b))
+	require.NoError(e.T(), err)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(e.T(), err)
+	return res
+}
+
 func (e *E2ETestSuite) unmarshalTweet(res *http.Response) models.Tweet {
 	var tweet models.Tweet
 	err := json.NewDecoder(res.Body).Decode(&tweet)
@@ -127,17 +386,42 @@ func (e *E2ETestSuite) unmarshalTweet(res *http.Response) models.Tweet {
 	return tweet
 }
 
-func (e *E2ETestSuite) marshalTweet(tweet models.Tweet) io.Reader {
+func (e *E2ETestSuite) marshalTweet(tweet models.CreateTweetRequest) io.Reader {
 	b, err := json.Marshal(tweet)
 	require.NoError(e.T(), err)
 	return bytes.NewReader(b)
 }
 
 func (e *E2ETestSuite) unmarshalTweets(res *http.Response) []models.Tweet {
-	var tweets []models.Tweet
-	err := json.NewDecoder(res.Body).Decode(&tweets)
+	return e.unmarshalTweetPage(res).Tweets
+}
+
+func (e *E2ETestSuite) unmarshalTweetPage(res *http.Response) models.TweetPage {
+	var page models.TweetPage
+	err := json.NewDecoder(res.Body).Decode(&page)
+	require.NoError(e.T(), err)
+	return page
+}
+
+func (e *E2ETestSuite) unmarshalSearchPage(res *http.Response) models.SearchPage {
+	var page models.SearchPage
+	err := json.NewDecoder(res.Body).Decode(&page)
+	require.NoError(e.T(), err)
+	return page
+}
+
+func (e *E2ETestSuite) unmarshalUser(res *http.Response) models.User {
+	var user models.User
+	err := json.NewDecoder(res.Body).Decode(&user)
+	require.NoError(e.T(), err)
+	return user
+}
+
+func (e *E2ETestSuite) unmarshalRevisions(res *http.Response) models.TweetRevisions {
+	var revisions models.TweetRevisions
+	err := json.NewDecoder(res.Body).Decode(&revisions)
 	require.NoError(e.T(), err)
-	return tweets
+	return revisions
 }
 
 func (e *E2ETestSuite) unmarshalAggregate(res *http.Response) models.AggregatedTweets {
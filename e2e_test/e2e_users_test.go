@@ -0,0 +1,174 @@
+package test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"simple_twitter/models"
+	"strings"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (e *E2ETestSuite) Test_CreateUser() {
+	var (
+		require = require.New(e.T())
+		assert  = assert.New(e.T())
+		handle  = e.uniqueHandle()
+	)
+
+	res := e.postJSON("/users", models.CreateUserRequest{Handle: "@" + strings.ToUpper(handle), DisplayName: "End to End 🧪"})
+	defer res.Body.Close()
+
+	require.Equal(http.StatusCreated, res.StatusCode, "Expected `status code` to be `201`")
+	user := e.unmarshalUser(res)
+	assert.Greater(user.ID, int64(0))
+	assert.Equal(handle, user.Handle, "Expected `handle` to be in its canonical form")
+	assert.Equal("End to End 🧪", user.DisplayName)
+
+	res, err := http.Get(e.buildURL("/users/"+strings.ToUpper(handle), nil))
+	require.NoError(err)
+	defer res.Body.Close()
+
+	require.Equal(http.StatusOK, res.StatusCode, "Expected `status code` to be `200`")
+	assert.Equal(user, e.unmarshalUser(res), "Expected handles to be case insensitive")
+}
+
+func (e *E2ETestSuite) Test_CreateUserHandleTaken() {
+	var (
+		assert = assert.New(e.T())
+		handle = e.uniqueHandle()
+	)
+
+	e.createUser(handle)
+
+	res := e.postJSON("/users", models.CreateUserRequest{Handle: strings.ToUpper(handle)})
+	defer res.Body.Close()
+
+	assert.Equal(http.StatusConflict, res.StatusCode, "Expected `status code` to be `409`")
+	output := e.unmarshalError(res)
+	assert.Equal(models.ErrKindConflict, output.Kind, "Expected `error kind` to be `conflict`")
+}
+
+func (e *E2ETestSuite) Test_CreateUserInvalid() {
+	var (
+		assert = assert.New(e.T())
+	)
+
+	for name, user := range map[string]models.CreateUserRequest{
+		"empty handle":         {Handle: ""},
+		"too long handle":      {Handle: strings.Repeat("a", 16)},
+		"invalid handle":       {Handle: "not-a-handle"},
+		"non-ASCII handle":     {Handle: "frødé"},
+		"too long displayname": {Handle: e.uniqueHandle(), DisplayName: strings.Repeat("a", 51)},
+	} {
+		res := e.postJSON("/users", user)
+		defer res.Body.Close()
+
+		assert.Equalf(http.StatusBadRequest, res.StatusCode, "Expected `status code` of %s to be `400`", name)
+		output := e.unmarshalError(res)
+		assert.Equalf(models.ErrKindInvalid, output.Kind, "Expected `error kind` of %s to be `invalid`", name)
+	}
+}
+
+func (e *E2ETestSuite) Test_GetUserMissing() {
+	var (
+		require = require.New(e.T())
+		assert  = assert.New(e.T())
+	)
+
+	for _, path := range []string{"/users/" + e.uniqueHandle(), "/users/" + e.uniqueHandle() + "/tweets"} {
+		res, err := http.Get(e.buildURL(path, nil))
+		require.NoError(err)
+		defer res.Body.Close()
+
+		assert.Equalf(http.StatusNotFound, res.StatusCode, "Expected `status code` of %s to be `404`", path)
+		output := e.unmarshalError(res)
+		assert.Equal(models.ErrKindMissing, output.Kind, "Expected `error kind` to be `missing`")
+	}
+}
+
+func (e *E2ETestSuite) Test_ListUserTweets() {
+	var (
+		require = require.New(e.T())
+		assert  = assert.New(e.T())
+		tag     = e.uniqueTag("e2e-users")
+		author  = e.createUser(e.uniqueHandle())
+		other   = e.createUser(e.uniqueHandle())
+	)
+
+	var created []models.Tweet
+	for _, user := range []models.User{author, other, author} {
+		res := e.postJSON("/tweets", models.CreateTweetRequest{Message: "This is a tweet by " + user.Handle, Tag: tag, AuthorID: &user.ID})
+		defer res.Body.Close()
+
+		require.Equal(http.StatusCreated, res.StatusCode, "Expected `status code` to be `201`")
+		tweet := e.unmarshalTweet(res)
+		require.NotNil(tweet.AuthorID, "Expected `author_id` to be set")
+		assert.Equal(user.ID, *tweet.AuthorID)
+		created = append(created, tweet)
+	}
+
+	anonymous := e.createTweet("This is an anonymous tweet", tag)
+	assert.Nil(anonymous.AuthorID, "Expected `author_id` of anonymous tweets to be unset")
+
+	res, err := http.Get(e.buildURL(fmt.Sprintf("/users/%s/tweets", author.Handle), url.Values{"limit": {"1"}}))
+	require.NoError(err)
+	defer res.Body.Close()
+
+	require.Equal(http.StatusOK, res.StatusCode, "Expected `status code` to be `200`")
+	page := e.unmarshalTweetPage(res)
+	require.Len(page.Tweets, 1)
+	assert.Equal(created[0].ID, page.Tweets[0].ID)
+	require.NotNil(page.NextCursor, "Expected a cursor to the next page")
+
+	cursor, err := page.NextCursor.MarshalText()
+	require.NoError(err)
+
+	res, err = http.Get(e.buildURL(fmt.Sprintf("/users/%s/tweets", author.Handle), url.Values{"cursor": {string(cursor)}}))
+	require.NoError(err)
+	defer res.Body.Close()
+
+	require.Equal(http.StatusOK, res.StatusCode, "Expected `status code` to be `200`")
+	page = e.unmarshalTweetPage(res)
+	require.Len(page.Tweets, 1, "Expected tweets by other users to not be listed")
+	assert.Equal(created[2].ID, page.Tweets[0].ID)
+	assert.Nil(page.NextCursor)
+}
+
+func (e *E2ETestSuite) Test_CreateTweetUnknownAuthor() {
+	var (
+		assert   = assert.New(e.T())
+		authorID = int64(999999999)
+	)
+
+	res := e.postJSON("/tweets", models.CreateTweetRequest{Message: "This tweet has no author", Tag: "e2e-tests", AuthorID: &authorID})
+	defer res.Body.Close()
+
+	assert.Equal(http.StatusBadRequest, res.StatusCode, "Expected `status code` to be `400`")
+	output := e.unmarshalError(res)
+	assert.Equal(models.ErrKindInvalid, output.Kind, "Expected `error kind` to be `invalid`")
+}
+
+func (e *E2ETestSuite) Test_CreateUserWithServerOwnedFields() {
+	var (
+		assert = assert.New(e.T())
+		handle = e.uniqueHandle()
+	)
+
+	for _, field := range []string{`"id":1`, `"created_at":"2025-03-16T18:13:11Z"`} {
+		res := e.postJSON("/users", json.RawMessage(`{"handle":"`+handle+`",`+field+`}`))
+		defer res.Body.Close()
+
+		assert.Equalf(http.StatusBadRequest, res.StatusCode, "Expected `status code` with %s to be `400`", field)
+		output := e.unmarshalError(res)
+		assert.Equalf(models.ErrKindInvalid, output.Kind, "Expected `error kind` with %s to be `invalid`", field)
+	}
+
+	res, err := http.Get(e.buildURL("/users/"+handle, nil))
+	assert.NoError(err)
+	defer res.Body.Close()
+	assert.Equal(http.StatusNotFound, res.StatusCode, "Expected no user to be created")
+}
@@ -0,0 +1,115 @@
+package metrics
+
+import (
+	"database/sql"
+	"net/http"
+	"simple_twitter/models"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// MAX_TAGS_LABEL is the number of tags from which tweets are counted together, to keep the `tags` label of
+// `simple_twitter_tweets_created_total` to a handful of values
+const MAX_TAGS_LABEL = 5
+
+// Metrics collects the metrics of the server, and serves them in the Prometheus text exposition format
+type Metrics struct {
+	registry *prometheus.Registry
+
+	requests *prometheus.CounterVec
+	latency  *prometheus.HistogramVec
+	errors   *prometheus.CounterVec
+	tweets   *prometheus.CounterVec
+	edits    prometheus.Counter
+}
+
+// ObserveRequest counts a request to route that responded with status, and observes its latency. Requests that
+// didn't match a route have an empty route.
+func (m *Metrics) ObserveRequest(route string, status int, latency time.Duration) {
+	if route == "" {
+		route = "unmatched"
+	}
+
+	code := strconv.Itoa(status)
+	m.requests.WithLabelValues(route, code).Inc()
+	m.latency.WithLabelValues(route, code).Observe(latency.Seconds())
+}
+
+// ObserveError counts an error a request failed with by its kind
+func (m *Metrics) ObserveError(kind models.ErrorKind) {
+	m.errors.WithLabelValues(kind.String()).Inc()
+}
+
+// TweetCreated counts a tweet created with the given number of tags
+func (m *Metrics) TweetCreated(tags int) {
+	label := strconv.Itoa(tags)
+	if tags >= MAX_TAGS_LABEL {
+		label = strconv.Itoa(MAX_TAGS_LABEL) + "+"
+	}
+
+	m.tweets.WithLabelValues(label).Inc()
+}
+
+// TweetEdited counts an edit of a tweet
+func (m *Metrics) TweetEdited() {
+	m.edits.Inc()
+}
+
+// RegisterDB collects the connection pool stats of db, labelled with the name of the database
+func (m *Metrics) RegisterDB(name string, db *sql.DB) {
+	m.registry.MustRegister(collectors.NewDBStatsCollector(db, name))
+}
+
+// Handler serves the metrics for Prometheus to scrape
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+// NewMetrics creates the metrics of the server, along with the metrics of the Go runtime and the process
+func NewMetrics() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "simple_twitter_http_requests_total",
+			Help: "Number of HTTP requests by route and status code.",
+		}, []string{"route", "status"}),
+
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "simple_twitter_http_request_duration_seconds",
+			Help:    "Latency of HTTP requests by route and status code.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"route", "status"}),
+
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "simple_twitter_http_errors_total",
+			Help: "Number of HTTP requests that failed by the kind of error.",
+		}, []string{"kind"}),
+
+		tweets: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "simple_twitter_tweets_created_total",
+			Help: "Number of tweets created by their number of tags.",
+		}, []string{"tags"}),
+
+		edits: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "simple_twitter_tweets_edited_total",
+			Help: "Number of edits of tweets.",
+		}),
+	}
+
+	m.registry.MustRegister(
+		m.requests,
+		m.latency,
+		m.errors,
+		m.tweets,
+		m.edits,
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+
+	return m
+}
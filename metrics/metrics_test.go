@@ -0,0 +1,58 @@
+package metrics
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"simple_twitter/models"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	_ "modernc.org/sqlite"
+)
+
+func TestMetrics(t *testing.T) {
+	var (
+		require = require.New(t)
+		assert  = assert.New(t)
+		metrics = NewMetrics()
+	)
+
+	conn, err := sqlx.Open("sqlite", ":memory:")
+	require.NoError(err)
+	t.Cleanup(func() { conn.Close() })
+
+	metrics.RegisterDB("sqlite", conn.DB)
+	metrics.ObserveRequest("GET /tweets/{id}", http.StatusOK, 20*time.Millisecond)
+	metrics.ObserveRequest("", http.StatusNotFound, time.Millisecond)
+	metrics.ObserveError(models.ErrKindMissing)
+	for _, tags := range []int{0, 1, 1, 5, 10} {
+		metrics.TweetCreated(tags)
+	}
+	metrics.TweetEdited()
+
+	res := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(res, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(http.StatusOK, res.Code)
+
+	body, err := io.ReadAll(res.Body)
+	require.NoError(err)
+
+	for _, line := range []string{
+		`simple_twitter_http_requests_total{route="GET /tweets/{id}",status="200"} 1`,
+		`simple_twitter_http_requests_total{route="unmatched",status="404"} 1`,
+		`simple_twitter_http_request_duration_seconds_bucket{route="GET /tweets/{id}",status="200",le="0.025"} 1`,
+		`simple_twitter_http_errors_total{kind="missing"} 1`,
+		`simple_twitter_tweets_created_total{tags="0"} 1`,
+		`simple_twitter_tweets_created_total{tags="1"} 2`,
+		`simple_twitter_tweets_created_total{tags="5+"} 2`,
+		`simple_twitter_tweets_edited_total 1`,
+		`go_sql_max_open_connections{db_name="sqlite"} 0`,
+		`go_goroutines`,
+	} {
+		assert.Contains(string(body), line)
+	}
+}
@@ -11,6 +11,15 @@ const (
 	ErrKindInternal ErrorKind = iota
 	ErrKindInvalid
 	ErrKindMissing
+	ErrKindUnsupported
+	ErrKindGone
+	ErrKindConflict
+	ErrKindUnauthenticated
+	ErrKindForbidden
+	ErrKindRateLimited
+	ErrKindUnavailable
+	ErrKindTooLarge
+	ErrKindUnsupportedMediaType
 )
 
 func (e ErrorKind) String() string {
@@ -19,6 +28,24 @@ func (e ErrorKind) String() string {
 		return "invalid"
 	case ErrKindMissing:
 		return "missing"
+	case ErrKindUnsupported:
+		return "unsupported"
+	case ErrKindGone:
+		return "gone"
+	case ErrKindConflict:
+		return "conflict"
+	case ErrKindUnauthenticated:
+		return "unauthenticated"
+	case ErrKindForbidden:
+		return "forbidden"
+	case ErrKindRateLimited:
+		return "rate_limited"
+	case ErrKindUnavailable:
+		return "unavailable"
+	case ErrKindTooLarge:
+		return "too_large"
+	case ErrKindUnsupportedMediaType:
+		return "unsupported_media_type"
 	case ErrKindInternal:
 		fallthrough
 	default:
@@ -46,6 +73,24 @@ func (e *ErrorKind) UnmarshalJSON(data []byte) error {
 		*e = ErrKindInvalid
 	case kind == ErrKindMissing.String():
 		*e = ErrKindMissing
+	case kind == ErrKindUnsupported.String():
+		*e = ErrKindUnsupported
+	case kind == ErrKindGone.String():
+		*e = ErrKindGone
+	case kind == ErrKindConflict.String():
+		*e = ErrKindConflict
+	case kind == ErrKindUnauthenticated.String():
+		*e = ErrKindUnauthenticated
+	case kind == ErrKindForbidden.String():
+		*e = ErrKindForbidden
+	case kind == ErrKindRateLimited.String():
+		*e = ErrKindRateLimited
+	case kind == ErrKindUnavailable.String():
+		*e = ErrKindUnavailable
+	case kind == ErrKindTooLarge.String():
+		*e = ErrKindTooLarge
+	case kind == ErrKindUnsupportedMediaType.String():
+		*e = ErrKindUnsupportedMediaType
 	case kind == ErrKindInternal.String():
 		*e = ErrKindInternal
 	default:
@@ -89,6 +134,46 @@ func ErrMissingf(message string, args ...any) Error {
 	return ErrWithCause(ErrKindMissing, fmt.Sprintf(message, args...), nil)
 }
 
+func ErrGonef(message string, args ...any) Error {
+	return ErrWithCause(ErrKindGone, fmt.Sprintf(message, args...), nil)
+}
+
+func ErrConflictf(message string, args ...any) Error {
+	return ErrWithCause(ErrKindConflict, fmt.Sprintf(message, args...), nil)
+}
+
+func ErrUnauthenticated(message string) Error {
+	return ErrWithCause(ErrKindUnauthenticated, message, nil)
+}
+
+func ErrForbiddenf(message string, args ...any) Error {
+	return ErrWithCause(ErrKindForbidden, fmt.Sprintf(message, args...), nil)
+}
+
+func ErrRateLimited(message string) Error {
+	return ErrWithCause(ErrKindRateLimited, message, nil)
+}
+
+func ErrUnavailable(message string) Error {
+	return ErrWithCause(ErrKindUnavailable, message, nil)
+}
+
+func ErrUnavailableWithCause(message string, cause error) Error {
+	return ErrWithCause(ErrKindUnavailable, message, cause)
+}
+
+func ErrTooLargef(message string, args ...any) Error {
+	return ErrWithCause(ErrKindTooLarge, fmt.Sprintf(message, args...), nil)
+}
+
+func ErrUnsupportedMediaType(message string) Error {
+	return ErrWithCause(ErrKindUnsupportedMediaType, message, nil)
+}
+
+func ErrUnsupported(message string) Error {
+	return ErrWithCause(ErrKindUnsupported, message, nil)
+}
+
 func ErrInternalWithCause(message string, cause error) Error {
 	return ErrWithCause(ErrKindInternal, message, cause)
 }
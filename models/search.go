@@ -0,0 +1,25 @@
+package models
+
+// SearchQuery describes a page of tweets to find by the words in their message. Tweets are ordered by how
+// relevant they are to the terms, most relevant first.
+type SearchQuery struct {
+	Text  string       // The query as written by the client, parsed into Terms by the business layer
+	Terms []SearchTerm // Every term that isn't excluded must match
+	Tag   string       // Only find tweets that have this among their tags
+
+	Limit  int
+	Offset int
+}
+
+// SearchTerm is a single word, or a phrase of words that must appear next to each other in the given order.
+// Words are lowercase and only contain letters, marks and digits.
+type SearchTerm struct {
+	Words   []string
+	Exclude bool // Only find tweets that don't match the term
+	Prefix  bool // Match any word starting with the single word of the term
+}
+
+type SearchPage struct {
+	Tweets     []Tweet `json:"tweets"`
+	NextOffset int     `json:"next_offset,omitempty"` // Unset when there are no more tweets
+}
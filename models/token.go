@@ -0,0 +1,46 @@
+package models
+
+import (
+	"slices"
+	"time"
+)
+
+// Token is an API token clients authenticate with. Only a hash of the secret is stored, the secret itself is
+// handed out once when the token is created.
+type Token struct {
+	ID        int64      `json:"id" db:"id"`
+	Name      string     `json:"name" db:"name"`       // Describes who or what the token was handed out to
+	UserID    *int64     `json:"user_id" db:"user_id"` // Unset for tokens that don't act on behalf of a user
+	Roles     []string   `json:"roles" db:"-"`         // Grant the token the scopes the roles are configured with
+	CreatedAt time.Time  `json:"created_at" db:"created_at"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty" db:"revoked_at"`
+}
+
+// Principal is who an authenticated request is made on behalf of
+type Principal struct {
+	TokenID int64
+	Name    string
+	UserID  *int64  // Unset for tokens that don't act on behalf of a user
+	Scopes  []Scope // What the principal is allowed to do, granted by the roles of the token
+}
+
+func (p Principal) HasScope(scope Scope) bool {
+	return slices.Contains(p.Scopes, scope)
+}
+
+// Scope allows a principal to request the routes that require it
+type Scope string
+
+const (
+	ScopeTweetsRead  Scope = "tweets:read"
+	ScopeTweetsWrite Scope = "tweets:write"
+	ScopeUsersRead   Scope = "users:read"
+	ScopeUsersWrite  Scope = "users:write"
+	ScopeAdminStats  Scope = "admin:stats" // Aggregated statistics of all tweets
+
+	// Edit and delete the tweets of every user, rather than only the tweets of the user of the token
+	ScopeTweetsModerate Scope = "tweets:moderate"
+)
+
+// Scopes are all the scopes there are
+var Scopes = []Scope{ScopeTweetsRead, ScopeTweetsWrite, ScopeTweetsModerate, ScopeUsersRead, ScopeUsersWrite, ScopeAdminStats}
@@ -1,16 +1,124 @@
 package models
 
 import (
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 )
 
 type Tweet struct {
-	ID        int64     `json:"id" db:"id"`
-	Message   string    `json:"message" db:"message"`
-	Tag       string    `json:"tag" db:"tag"`
-	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	ID        int64      `json:"id" db:"id"`
+	Message   string     `json:"message" db:"message"`
+	Tag       string     `json:"tag" db:"tag"`                       // The first of Tags, kept for clients that predate multiple tags
+	Tags      []string   `json:"tags" db:"-"`                        // Explicit tags followed by the hashtags of Message
+	Hashtags  []string   `json:"hashtags" db:"-"`                    // Hashtags written inline in Message, without the `#`
+	AuthorID  *int64     `json:"author_id,omitempty" db:"author_id"` // Unset for tweets posted before tweets had authors
+	CreatedAt time.Time  `json:"created_at" db:"created_at"`
+	EditedAt  *time.Time `json:"edited_at,omitempty" db:"edited_at"` // Unset when the tweet has never been edited
+	Revisions int        `json:"revisions" db:"revisions"`           // Number of prior versions of the tweet
+}
+
+// CreateTweetRequest describes a tweet to post. It only has the fields clients can set, the rest of a tweet is
+// set by the server.
+type CreateTweetRequest struct {
+	Message  string   `json:"message"`
+	Tag      string   `json:"tag"` // Kept for clients that predate multiple tags, becomes the first of Tags
+	Tags     []string `json:"tags"`
+	AuthorID *int64   `json:"author_id,omitempty"` // The user to post as, defaults to the user of the token
+}
+
+// TweetPatch describes changes to a tweet, fields that are nil are left unchanged
+type TweetPatch struct {
+	Message *string  `json:"message"`
+	Tag     *string  `json:"tag"` // Kept for clients that predate multiple tags, becomes the first of Tags
+	Tags    []string `json:"tags"`
+}
+
+// TweetRevision is a prior version of a tweet, as it was before it was edited
+type TweetRevision struct {
+	Revision  int       `json:"revision"` // Starts at 1 for the version the tweet was created as
+	Message   string    `json:"message"`
+	Tags      []string  `json:"tags"`
+	CreatedAt time.Time `json:"created_at"` // When the tweet was created or edited into this version
+}
+
+type TweetRevisions struct {
+	Revisions []TweetRevision `json:"revisions"`
+}
+
+// TweetQuery describes a page of tweets to list. Tweets are ordered by `created_at` and then `id`, in the
+// direction given by Sort. Zero valued filters are ignored, so the zero query lists tweets across all tags.
+type TweetQuery struct {
+	Tag      string // Only list tweets that have this among their tags
+	AuthorID int64  // Only list tweets posted by the user with this id
+
+	Since   time.Time // Only list tweets created at or after this time
+	Until   time.Time // Only list tweets created before this time
+	SinceID int64     // Only list tweets with an id greater than this
+	MaxID   int64     // Only list tweets with an id less than or equal to this
+
+	Sort  SortOrder
+	After *Cursor // Only list tweets positioned after this cursor in the sort order
+	Limit int
+
+	// Deprecated: Offset pagination gets slower the deeper you page, use After instead
+	Offset int
+}
+
+type SortOrder string
+
+const (
+	SortOldest SortOrder = "oldest" // Ascending `created_at`, the default
+	SortNewest SortOrder = "newest" // Descending `created_at`
+)
+
+type TweetPage struct {
+	Tweets     []Tweet `json:"tweets"`
+	NextCursor *Cursor `json:"next_cursor,omitempty"` // Unset when there are no more tweets
+}
+
+// Cursor is the position of a tweet in a listing. It's marshalled to an opaque string which
+// clients pass back to continue listing where they left off.
+type Cursor struct {
+	CreatedAt time.Time
+	ID        int64
+}
+
+func CursorOf(tweet Tweet) *Cursor {
+	return &Cursor{CreatedAt: tweet.CreatedAt, ID: tweet.ID}
+}
+
+func (c Cursor) MarshalText() ([]byte, error) {
+	raw := c.CreatedAt.UTC().Format(time.RFC3339Nano) + "," + strconv.FormatInt(c.ID, 10)
+	return []byte(base64.RawURLEncoding.EncodeToString([]byte(raw))), nil
+}
+
+func (c *Cursor) UnmarshalText(data []byte) error {
+	raw, err := base64.RawURLEncoding.DecodeString(string(data))
+	if err != nil {
+		return fmt.Errorf("malformed cursor: %w", err)
+	}
+
+	createdAt, id, ok := strings.Cut(string(raw), ",")
+	if !ok {
+		return errors.New("malformed cursor")
+	}
+
+	c.CreatedAt, err = time.Parse(time.RFC3339Nano, createdAt)
+	if err != nil {
+		return fmt.Errorf("malformed cursor: %w", err)
+	}
+
+	c.ID, err = strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return fmt.Errorf("malformed cursor: %w", err)
+	}
+
+	return nil
 }
 
 type AggregatedTweets struct {
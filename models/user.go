@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+type User struct {
+	ID          int64     `json:"id" db:"id"`
+	Handle      string    `json:"handle" db:"handle"` // Unique, lower case name the user is addressed by, e.g in URLs
+	DisplayName string    `json:"display_name" db:"display_name"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+}
+
+// CreateUserRequest describes a user to create, with only the fields clients can set
+type CreateUserRequest struct {
+	Handle      string `json:"handle"`
+	DisplayName string `json:"display_name"`
+}
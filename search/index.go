@@ -0,0 +1,274 @@
+package search
+
+import (
+	"math"
+	"simple_twitter/models"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MIN_WORD_LENGTH is the length in characters of the shortest words that are indexed, the same as the default
+// `innodb_ft_min_token_size` of MySQL's InnoDB full-text search
+const MIN_WORD_LENGTH = 3
+
+// Index is a concurrency safe, in process inverted index of tweet messages. It gives storages that can't
+// search themselves the same search as MySQL's boolean mode full-text search: every term that isn't excluded
+// must match, stop words and words shorter than MIN_WORD_LENGTH are ignored and words can be matched by
+// prefix. All data is lost when the process exits, so the index has to be rebuilt from the storage on startup.
+type Index struct {
+	mu         sync.RWMutex
+	postings   map[string]map[int64]int // Word to the number of times it appears in each tweet
+	vocabulary []string                 // Every indexed word, sorted to find words by prefix
+	tweets     map[int64]document
+}
+
+type document struct {
+	words []string // Every word of the message in order, including stop words
+	tags  []string
+}
+
+// Add indexes tweet, replacing it if it's already indexed
+func (i *Index) Add(tweet models.Tweet) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.remove(tweet.ID)
+
+	doc := document{words: Words(tweet.Message), tags: slices.Clone(tweet.Tags)}
+	for _, word := range doc.words {
+		if ignoredWord(word) {
+			continue
+		}
+
+		if i.postings[word] == nil {
+			i.postings[word] = map[int64]int{}
+			idx, _ := slices.BinarySearch(i.vocabulary, word)
+			i.vocabulary = slices.Insert(i.vocabulary, idx, word)
+		}
+		i.postings[word][tweet.ID]++
+	}
+
+	i.tweets[tweet.ID] = doc
+}
+
+// Remove removes the tweet with id from the index, if it's indexed
+func (i *Index) Remove(id int64) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.remove(id)
+}
+
+func (i *Index) remove(id int64) {
+	doc, ok := i.tweets[id]
+	if !ok {
+		return
+	}
+
+	for _, word := range doc.words {
+		if _, ok := i.postings[word][id]; !ok {
+			continue
+		}
+
+		delete(i.postings[word], id)
+		if len(i.postings[word]) == 0 {
+			delete(i.postings, word)
+			idx, _ := slices.BinarySearch(i.vocabulary, word)
+			i.vocabulary = slices.Delete(i.vocabulary, idx, idx+1)
+		}
+	}
+
+	delete(i.tweets, id)
+}
+
+// Search returns the ids of the tweets matching query, most relevant first. Tweets are scored by how often
+// the words they match appear in them, weighted by how rare the words are across all tweets.
+func (i *Index) Search(query models.SearchQuery) []int64 {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	var (
+		scores   map[int64]float64
+		included bool
+	)
+
+	for _, term := range query.Terms {
+		if term.Exclude || Ignored(term) {
+			continue
+		}
+
+		matches := i.match(term)
+		if !included {
+			scores = make(map[int64]float64, len(matches))
+			for id, score := range matches {
+				scores[id] = score
+			}
+			included = true
+			continue
+		}
+
+		for id := range scores {
+			score, ok := matches[id]
+			if !ok {
+				delete(scores, id)
+				continue
+			}
+			scores[id] += score
+		}
+	}
+
+	for _, term := range query.Terms {
+		if !term.Exclude || Ignored(term) {
+			continue
+		}
+
+		for id := range i.match(term) {
+			delete(scores, id)
+		}
+	}
+
+	ids := make([]int64, 0, len(scores))
+	for id := range scores {
+		if query.Tag != "" && !slices.Contains(i.tweets[id].tags, query.Tag) {
+			continue
+		}
+		ids = append(ids, id)
+	}
+
+	sort.Slice(ids, func(a, b int) bool {
+		if scores[ids[a]] != scores[ids[b]] {
+			return scores[ids[a]] > scores[ids[b]]
+		}
+		return ids[a] > ids[b]
+	})
+
+	ids = ids[min(max(query.Offset, 0), len(ids)):]
+	return ids[:min(max(query.Limit, 0), len(ids))]
+}
+
+// Ignored reports whether term is only made up of stop words and words shorter than MIN_WORD_LENGTH, which
+// aren't indexed and can't be searched for, by the Index or by MySQL
+func Ignored(term models.SearchTerm) bool {
+	if term.Prefix {
+		return false
+	}
+
+	for _, word := range term.Words {
+		if !ignoredWord(word) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// match returns the score of each tweet that matches term
+func (i *Index) match(term models.SearchTerm) map[int64]float64 {
+	if term.Prefix {
+		prefix := term.Words[len(term.Words)-1]
+		matches := map[int64]float64{}
+
+		idx, _ := slices.BinarySearch(i.vocabulary, prefix)
+		for _, word := range i.vocabulary[idx:] {
+			if !strings.HasPrefix(word, prefix) {
+				break
+			}
+
+			for id, score := range i.matchWord(word) {
+				matches[id] += score
+			}
+		}
+
+		return matches
+	}
+
+	if len(term.Words) == 1 {
+		return i.matchWord(term.Words[0])
+	}
+
+	return i.matchPhrase(term.Words)
+}
+
+func (i *Index) matchWord(word string) map[int64]float64 {
+	var (
+		postings = i.postings[word]
+		idf      = i.idf(word)
+		matches  = make(map[int64]float64, len(postings))
+	)
+
+	for id, occurrences := range postings {
+		matches[id] = float64(occurrences) * idf
+	}
+
+	return matches
+}
+
+// matchPhrase finds the tweets that have every word of the phrase, and then checks the words are next to each
+// other in the given order
+func (i *Index) matchPhrase(phrase []string) map[int64]float64 {
+	var (
+		candidates map[int64]int
+		idf        float64
+	)
+
+	for _, word := range phrase {
+		if ignoredWord(word) {
+			continue
+		}
+
+		idf += i.idf(word)
+		if candidates == nil || len(i.postings[word]) < len(candidates) {
+			candidates = i.postings[word]
+		}
+	}
+
+	matches := map[int64]float64{}
+	for id := range candidates {
+		if occurrences := occurrencesOf(phrase, i.tweets[id].words); occurrences > 0 {
+			matches[id] = float64(occurrences) * idf
+		}
+	}
+
+	return matches
+}
+
+// idf returns the inverse document frequency of word, which is higher the fewer tweets have the word
+func (i *Index) idf(word string) float64 {
+	return math.Log(1 + float64(len(i.tweets))/float64(max(len(i.postings[word]), 1)))
+}
+
+// occurrencesOf counts the number of times phrase appears in words
+func occurrencesOf(phrase []string, words []string) int {
+	var occurrences int
+	for idx := 0; idx+len(phrase) <= len(words); idx++ {
+		if slices.Equal(phrase, words[idx:idx+len(phrase)]) {
+			occurrences++
+		}
+	}
+
+	return occurrences
+}
+
+// ignoredWord reports whether word is left out of the index, for being a stop word or too short
+func ignoredWord(word string) bool {
+	return stopWords[word] || utf8.RuneCountInString(word) < MIN_WORD_LENGTH
+}
+
+// Words splits text into lowercase words of letters, marks and digits, which is how both messages and search
+// queries are tokenized
+func Words(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsMark(r) && !unicode.IsDigit(r)
+	})
+}
+
+func NewIndex() *Index {
+	return &Index{
+		postings: map[string]map[int64]int{},
+		tweets:   map[int64]document{},
+	}
+}
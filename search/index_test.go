@@ -0,0 +1,157 @@
+package search
+
+import (
+	"simple_twitter/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndexSearch(t *testing.T) {
+	index := NewIndex()
+	index.Add(models.Tweet{ID: 1, Message: "The quick brown fox", Tags: []string{"animals"}})
+	index.Add(models.Tweet{ID: 2, Message: "A quick, quick brown dog!", Tags: []string{"animals", "dogs"}})
+	index.Add(models.Tweet{ID: 3, Message: "Brown bread is QUICK to make", Tags: []string{"food"}})
+	index.Add(models.Tweet{ID: 4, Message: "Foxes are quicker than dogs", Tags: []string{"animals"}})
+	index.Add(models.Tweet{ID: 5, Message: "Go go go, ok?", Tags: []string{"games"}})
+
+	for _, test := range []struct {
+		name     string
+		query    models.SearchQuery
+		expected []int64
+	}{
+		{
+			name:     "word",
+			query:    query(word("brown")),
+			expected: []int64{3, 2, 1},
+		},
+		{
+			name:     "words are required",
+			query:    query(word("quick"), word("dog")),
+			expected: []int64{2},
+		},
+		{
+			name:     "more occurrences rank higher",
+			query:    query(word("quick")),
+			expected: []int64{2, 3, 1},
+		},
+		{
+			name:     "phrase",
+			query:    query(phrase("quick", "brown")),
+			expected: []int64{2, 1},
+		},
+		{
+			name:     "phrase with stop words",
+			query:    query(phrase("the", "quick", "brown")),
+			expected: []int64{1},
+		},
+		{
+			name:     "exclude",
+			query:    query(word("brown"), exclude(word("dog"))),
+			expected: []int64{3, 1},
+		},
+		{
+			name:     "exclude phrase",
+			query:    query(word("brown"), exclude(phrase("brown", "bread"))),
+			expected: []int64{2, 1},
+		},
+		{
+			name:     "prefix",
+			query:    query(prefix("fox")),
+			expected: []int64{4, 1},
+		},
+		{
+			name:     "stop words are ignored",
+			query:    query(word("the"), word("fox")),
+			expected: []int64{1},
+		},
+		{
+			name:     "only stop words",
+			query:    query(word("the")),
+			expected: []int64{},
+		},
+		{
+			name:     "short words are ignored",
+			query:    query(word("go"), word("fox")),
+			expected: []int64{1},
+		},
+		{
+			name:     "only short words",
+			query:    query(word("go"), word("ok")),
+			expected: []int64{},
+		},
+		{
+			name:     "unknown word",
+			query:    query(word("cat")),
+			expected: []int64{},
+		},
+		{
+			name:     "tag",
+			query:    models.SearchQuery{Terms: []models.SearchTerm{word("brown")}, Tag: "animals", Limit: 10},
+			expected: []int64{2, 1},
+		},
+		{
+			name:     "limit and offset",
+			query:    models.SearchQuery{Terms: []models.SearchTerm{word("brown")}, Limit: 1, Offset: 1},
+			expected: []int64{2},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, index.Search(test.query))
+		})
+	}
+}
+
+func TestIndexAddReplaces(t *testing.T) {
+	var (
+		assert = assert.New(t)
+		index  = NewIndex()
+	)
+
+	index.Add(models.Tweet{ID: 1, Message: "first message"})
+	index.Add(models.Tweet{ID: 1, Message: "second message"})
+
+	assert.Empty(index.Search(query(word("first"))), "Expected the words of the replaced tweet to be removed")
+	assert.Equal([]int64{1}, index.Search(query(word("second"))))
+	assert.Equal([]string{"message", "second"}, index.vocabulary, "Expected words no tweet has to be removed")
+}
+
+func TestIndexRemove(t *testing.T) {
+	var (
+		assert = assert.New(t)
+		index  = NewIndex()
+	)
+
+	index.Add(models.Tweet{ID: 1, Message: "first message"})
+	index.Add(models.Tweet{ID: 2, Message: "second message"})
+	index.Remove(1)
+	index.Remove(3)
+
+	assert.Equal([]int64{2}, index.Search(query(word("message"))))
+	assert.Equal([]string{"message", "second"}, index.vocabulary, "Expected words no tweet has to be removed")
+}
+
+func TestWords(t *testing.T) {
+	assert.Equal(t, []string{"e", "mail", "ünïcode", "測試", "42"}, Words("E-mail ÜNÏCODE, 測試! #42"))
+}
+
+func query(terms ...models.SearchTerm) models.SearchQuery {
+	return models.SearchQuery{Terms: terms, Limit: 10}
+}
+
+func word(word string) models.SearchTerm {
+	return models.SearchTerm{Words: []string{word}}
+}
+
+func phrase(words ...string) models.SearchTerm {
+	return models.SearchTerm{Words: words}
+}
+
+func prefix(word string) models.SearchTerm {
+	return models.SearchTerm{Words: []string{word}, Prefix: true}
+}
+
+func exclude(term models.SearchTerm) models.SearchTerm {
+	term.Exclude = true
+	return term
+}
@@ -0,0 +1,11 @@
+package search
+
+// stopWords are too common to be worth indexing. It's the same list as the default stop words of MySQL's InnoDB
+// full-text search, so search behaves the same regardless of storage.
+var stopWords = map[string]bool{
+	"a": true, "about": true, "an": true, "are": true, "as": true, "at": true, "be": true, "by": true,
+	"com": true, "de": true, "en": true, "for": true, "from": true, "how": true, "i": true, "in": true,
+	"is": true, "it": true, "la": true, "of": true, "on": true, "or": true, "that": true, "the": true,
+	"this": true, "to": true, "was": true, "what": true, "when": true, "where": true, "who": true,
+	"will": true, "with": true, "und": true, "www": true,
+}
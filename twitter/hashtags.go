@@ -0,0 +1,65 @@
+package twitter
+
+import (
+	"slices"
+	"unicode"
+	"unicode/utf8"
+)
+
+// extractHashtags returns the canonical form of the hashtags written inline in message, without the leading
+// `#`, in the order they first appear. A hashtag is a `#` (or the full width `＃`) at the start of the message
+// or after a character that can't be part of a hashtag, followed by letters, marks, digits and underscores in
+// any script. Hashtags made up of digits only (e.g "#1") and hashtags longer than MAX_TWEET_TAG_LENGTH bytes
+// are ignored, see NormalizeTag.
+func extractHashtags(message string) []string {
+	var (
+		hashtags []string
+		previous rune
+	)
+
+	for idx := 0; idx < len(message); {
+		r, size := utf8.DecodeRuneInString(message[idx:])
+		idx += size
+
+		if !isHashSign(r) || isHashtagRune(previous) || isHashSign(previous) {
+			previous = r
+			continue
+		}
+
+		start := idx
+		for idx < len(message) {
+			r, size := utf8.DecodeRuneInString(message[idx:])
+			if !isHashtagRune(r) {
+				break
+			}
+			idx += size
+		}
+
+		hashtag, err := NormalizeTag(message[start:idx])
+		if err == nil && !onlyDigits(hashtag) && !slices.Contains(hashtags, hashtag) {
+			hashtags = append(hashtags, hashtag)
+		}
+
+		previous, _ = utf8.DecodeLastRuneInString(message[:idx])
+	}
+
+	return hashtags
+}
+
+func isHashSign(r rune) bool {
+	return r == '#' || r == '＃'
+}
+
+func isHashtagRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsMark(r) || unicode.IsDigit(r)
+}
+
+func onlyDigits(hashtag string) bool {
+	for _, r := range hashtag {
+		if !unicode.IsDigit(r) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,66 @@
+package twitter
+
+import (
+	"encoding/json"
+	"fmt"
+	"simple_twitter/models"
+	"slices"
+	"strings"
+)
+
+// Roles maps the name of each role tokens can be given to the scopes the role grants
+type Roles map[string][]models.Scope
+
+// DefaultRoles returns the roles tokens can be given unless others are configured, see WithRoles
+func DefaultRoles() Roles {
+	return Roles{
+		"reader": {models.ScopeTweetsRead, models.ScopeUsersRead},
+		"writer": {models.ScopeTweetsRead, models.ScopeTweetsWrite, models.ScopeUsersRead},
+		"admin":  slices.Clone(models.Scopes),
+	}
+}
+
+// WithRoles sets the roles tokens can be given, replacing the default roles
+func WithRoles(roles Roles) Option {
+	return func(t *Twitter) {
+		t.roles = roles
+	}
+}
+
+// ParseRoles parses roles from a JSON object mapping the name of each role to the scopes it grants, e.g
+// `{ "reader": ["tweets:read", "users:read"] }`
+func ParseRoles(data []byte) (Roles, error) {
+	var roles Roles
+	err := json.Unmarshal(data, &roles)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse roles: %w", err)
+	}
+
+	for role, scopes := range roles {
+		if role == "" || strings.ContainsAny(role, ", ") {
+			return nil, fmt.Errorf("invalid role %q, roles can't be empty or contain commas or spaces", role)
+		}
+
+		for _, scope := range scopes {
+			if !slices.Contains(models.Scopes, scope) {
+				return nil, fmt.Errorf("role %q has unknown scope %q", role, scope)
+			}
+		}
+	}
+
+	return roles, nil
+}
+
+// scopesOf returns the scopes granted by roles, roles that aren't configured grant none
+func (t Twitter) scopesOf(roles []string) []models.Scope {
+	var scopes []models.Scope
+	for _, role := range roles {
+		for _, scope := range t.roles[role] {
+			if !slices.Contains(scopes, scope) {
+				scopes = append(scopes, scope)
+			}
+		}
+	}
+
+	return scopes
+}
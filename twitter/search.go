@@ -0,0 +1,229 @@
+package twitter
+
+import (
+	"context"
+	"simple_twitter/models"
+	"simple_twitter/search"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	MAX_SEARCH_QUERY_LENGTH_UTF8 = 256 // Max length of a search query (UTF8 length)
+	MAX_SEARCH_TERMS             = 16  // Max number of words and phrases in a search query
+)
+
+func (t Twitter) SearchTweets(ctx context.Context, query models.SearchQuery) (models.SearchPage, error) {
+	terms, err := parseSearchQuery(query.Text)
+	if err != nil {
+		return models.SearchPage{}, err
+	}
+	query.Terms = terms
+
+	if query.Tag != "" {
+		tag, err := NormalizeTag(query.Tag)
+		if err != nil {
+			return models.SearchPage{}, err
+		}
+		query.Tag = tag
+	}
+
+	if query.Offset < 0 {
+		return models.SearchPage{}, models.ErrInvalid("`offset` can't be negative")
+	}
+
+	if query.Limit < 0 {
+		return models.SearchPage{}, models.ErrInvalid("`limit` can't be negative")
+	}
+
+	if query.Limit > MAX_PAGE_SIZE {
+		query.Limit = MAX_PAGE_SIZE
+	}
+
+	page, err := t.searchTweets(ctx, query)
+	if e, ok := err.(models.Error); ok && e.Kind == models.ErrKindUnsupported {
+		return models.SearchPage{}, e
+	}
+
+	if err != nil {
+		return models.SearchPage{}, models.ErrInternalWithCause("failed to search tweets", err)
+	}
+
+	for idx := range page.Tweets {
+		page.Tweets[idx] = withHashtags(page.Tweets[idx])
+	}
+
+	return page, nil
+}
+
+// searchTweets searches the index if there is one, and the storage otherwise
+func (t Twitter) searchTweets(ctx context.Context, query models.SearchQuery) (models.SearchPage, error) {
+	if t.index != nil {
+		return t.searchIndex(ctx, query)
+	}
+
+	// Ask for one more tweet than requested to know whether there is a next page
+	limit := query.Limit
+	query.Limit++
+
+	tweets, err := t.tweets.SearchTweets(ctx, query)
+	if err != nil {
+		return models.SearchPage{}, err
+	}
+
+	page := models.SearchPage{Tweets: tweets}
+	if len(tweets) > limit {
+		page.Tweets = tweets[:limit]
+		if limit > 0 {
+			page.NextOffset = query.Offset + limit
+		}
+	}
+
+	return page, nil
+}
+
+// searchIndex fills a page with the tweets found in the index. Tweets deleted after they were found in the
+// index are skipped, and the index is searched again from where it left off until the page is full, so the
+// offsets of the pages count the tweets found in the index rather than the tweets returned.
+func (t Twitter) searchIndex(ctx context.Context, query models.SearchQuery) (models.SearchPage, error) {
+	var (
+		page   = models.SearchPage{Tweets: []models.Tweet{}}
+		limit  = query.Limit
+		offset = query.Offset // Of the next tweet found in the index
+	)
+
+	for {
+		// Ask for one more tweet than is missing from the page to know whether there is a next page
+		query.Offset, query.Limit = offset, limit-len(page.Tweets)+1
+		ids := t.index.Search(query)
+
+		tweets, err := t.tweets.GetTweets(ctx, ids)
+		if err != nil {
+			return models.SearchPage{}, err
+		}
+
+		byID := make(map[int64]models.Tweet, len(tweets))
+		for _, tweet := range tweets {
+			byID[tweet.ID] = tweet
+		}
+
+		for _, id := range ids {
+			tweet, ok := byID[id]
+			if !ok {
+				// Deleted after it was found in the index
+				offset++
+				continue
+			}
+
+			if len(page.Tweets) == limit {
+				if limit > 0 {
+					page.NextOffset = offset
+				}
+				return page, nil
+			}
+
+			page.Tweets = append(page.Tweets, tweet)
+			offset++
+		}
+
+		if len(ids) < query.Limit {
+			return page, nil
+		}
+	}
+}
+
+// IndexTweets adds every stored tweet to the search index, if there is one. It's meant to be called once on
+// startup, as the index is kept up to date with the tweets created after that.
+func (t Twitter) IndexTweets(ctx context.Context) error {
+	if t.index == nil {
+		return nil
+	}
+
+	query := models.TweetQuery{Sort: models.SortOldest, Limit: MAX_PAGE_SIZE}
+	for {
+		tweets, err := t.tweets.ListTweets(ctx, query)
+		if err != nil {
+			return models.ErrInternalWithCause("failed to index tweets", err)
+		}
+
+		for _, tweet := range tweets {
+			t.index.Add(tweet)
+		}
+
+		if len(tweets) < query.Limit {
+			return nil
+		}
+		query.After = models.CursorOf(tweets[len(tweets)-1])
+	}
+}
+
+// parseSearchQuery parses a search query as written by a client into the terms to search for. Words are
+// separated by whitespace, words in double quotes make up a phrase, a word or phrase prefixed with `-` is
+// excluded and a word suffixed with `*` matches any word starting with it. Anything else that isn't a letter,
+// mark or digit only separates words, so characters that are operators to the storage can never reach it,
+// e.g `e-mail` is the phrase "e mail".
+func parseSearchQuery(text string) ([]models.SearchTerm, error) {
+	if utf8.RuneCountInString(text) > MAX_SEARCH_QUERY_LENGTH_UTF8 {
+		return nil, models.ErrInvalidf("`q` is too long, must be shorter than %d code points", MAX_SEARCH_QUERY_LENGTH_UTF8)
+	}
+
+	var (
+		terms    []models.SearchTerm
+		included bool
+	)
+
+	for text = strings.TrimSpace(text); text != ""; text = strings.TrimLeftFunc(text, unicode.IsSpace) {
+		var term models.SearchTerm
+		if len(text) > 1 && text[0] == '-' {
+			term.Exclude = true
+			text = text[1:]
+		}
+
+		var (
+			raw    string
+			quoted = text[0] == '"'
+		)
+
+		if quoted {
+			end := strings.IndexByte(text[1:], '"')
+			if end == -1 {
+				return nil, models.ErrInvalid("`q` has a quoted phrase that is never closed")
+			}
+			raw, text = text[1:end+1], text[end+2:]
+		} else {
+			end := strings.IndexFunc(text, unicode.IsSpace)
+			if end == -1 {
+				end = len(text)
+			}
+			raw, text = text[:end], text[end:]
+		}
+
+		term.Words = search.Words(raw)
+		if !quoted && len(term.Words) == 1 && strings.HasSuffix(raw, "*") {
+			term.Prefix = true
+		}
+
+		if len(term.Words) == 0 {
+			// Nothing but operators or punctuation, which there is nothing to search for in
+			continue
+		}
+
+		terms = append(terms, term)
+		included = included || !term.Exclude
+	}
+
+	if len(terms) == 0 {
+		return nil, models.ErrInvalid("`q` must contain at least one word")
+	}
+
+	if !included {
+		return nil, models.ErrInvalid("`q` must contain at least one word that isn't excluded")
+	}
+
+	if len(terms) > MAX_SEARCH_TERMS {
+		return nil, models.ErrInvalidf("`q` has too many words and phrases, can have at most %d", MAX_SEARCH_TERMS)
+	}
+
+	return terms, nil
+}
@@ -0,0 +1,59 @@
+package twitter_test
+
+import (
+	"context"
+	"simple_twitter/database"
+	"simple_twitter/models"
+	"simple_twitter/search"
+	"simple_twitter/twitter"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchTweetsSkipsDeletedTweets(t *testing.T) {
+	var (
+		require = require.New(t)
+		assert  = assert.New(t)
+		ctx     = context.Background()
+		storage = database.NewInMemoryTwitterDatabase()
+		tw      = twitter.NewTwitter(storage, twitter.WithSearchIndex(search.NewIndex()))
+	)
+
+	var created []int64
+	for range 6 {
+		tweet, err := tw.CreateTweet(ctx, nil, "Hello world!", []string{"greetings"})
+		require.NoError(err)
+		created = append(created, tweet.ID)
+	}
+
+	// Deleted from the storage behind the back of the index, as if deleted while searching
+	require.NoError(storage.DeleteTweet(ctx, created[4]))
+	require.NoError(storage.DeleteTweet(ctx, created[3]))
+
+	var (
+		found  []int64
+		pages  int
+		offset = 0
+	)
+	for {
+		page, err := tw.SearchTweets(ctx, models.SearchQuery{Text: "world", Limit: 2, Offset: offset})
+		require.NoError(err)
+		pages++
+
+		for _, tweet := range page.Tweets {
+			found = append(found, tweet.ID)
+		}
+
+		if page.NextOffset == 0 {
+			assert.NotEmpty(page.Tweets, "Expected no empty last page")
+			break
+		}
+		assert.Len(page.Tweets, 2, "Expected every page but the last to be full")
+		offset = page.NextOffset
+	}
+
+	assert.Equal([]int64{created[5], created[2], created[1], created[0]}, found, "Expected every tweet that isn't deleted to be found once")
+	assert.Equal(2, pages)
+}
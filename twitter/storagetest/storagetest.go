@@ -0,0 +1,1132 @@
+// Package storagetest provides a conformance test suite for implementations of twitter.Storage.
+//
+// Storage implementations are expected to behave exactly like the MySQL backed database.TwitterDatabase,
+// and running this suite against a new implementation is the way to prove that they do.
+package storagetest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"simple_twitter/models"
+	"simple_twitter/twitter"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Harness is a storage under test together with the hooks the suite needs to exercise it
+type Harness struct {
+	Storage twitter.Storage
+
+	// InsertTweet inserts a tweet with the given message, tags and creation time, bypassing the
+	// storage's default of setting `created_at` to the current time. It returns the id of the
+	// inserted tweet. Tests that depend on it are skipped when it is nil.
+	InsertTweet func(ctx context.Context, tweet models.Tweet) (int64, error)
+}
+
+// Factory creates a harness around a new and empty storage. It's called once for every test
+// and is responsible for registering any cleanup with t.Cleanup.
+type Factory func(t *testing.T) Harness
+
+// Run runs the full conformance suite against the storages produced by newHarness
+func Run(t *testing.T, newHarness Factory) {
+	tests := []struct {
+		name string
+		test func(t *testing.T, h Harness)
+	}{
+		{"CreateTweet", testCreateTweet},
+		{"CreateTweetWithMultipleTags", testCreateTweetWithMultipleTags},
+		{"CreateTweetIDsAreMonotonic", testCreateTweetIDsAreMonotonic},
+		{"CreateTweetConcurrently", testCreateTweetConcurrently},
+		{"CreateTweetUnicode", testCreateTweetUnicode},
+		{"GetTweetMissing", testGetTweetMissing},
+		{"GetTweets", testGetTweets},
+		{"ListTweetsFiltersByTag", testListTweetsFiltersByTag},
+		{"ListTweetsMatchesAnyTag", testListTweetsMatchesAnyTag},
+		{"ListTweetsUnknownTag", testListTweetsUnknownTag},
+		{"ListTweetsPagination", testListTweetsPagination},
+		{"ListTweetsPaginationBoundaries", testListTweetsPaginationBoundaries},
+		{"ListTweetsAllTags", testListTweetsAllTags},
+		{"ListTweetsTimeWindow", testListTweetsTimeWindow},
+		{"ListTweetsIDWindow", testListTweetsIDWindow},
+		{"ListTweetsOrder", testListTweetsOrder},
+		{"ListTweetsOrderNewest", testListTweetsOrderNewest},
+		{"ListTweetsAfterCursor", testListTweetsAfterCursor},
+		{"ListTweetsAfterCursorNewest", testListTweetsAfterCursorNewest},
+		{"AggregateTweetsByYear", testAggregateTweetsByYear},
+		{"AggregateTweetsByMonth", testAggregateTweetsByMonth},
+		{"AggregateTweetsRangeIsInclusive", testAggregateTweetsRangeIsInclusive},
+		{"AggregateTweetsEmptyRange", testAggregateTweetsEmptyRange},
+		{"UpdateTweet", testUpdateTweet},
+		{"UpdateTweetReplacesTags", testUpdateTweetReplacesTags},
+		{"UpdateTweetMissing", testUpdateTweetMissing},
+		{"UpdateTweetEditsCurrentVersion", testUpdateTweetEditsCurrentVersion},
+		{"ListRevisionsMissing", testListRevisionsMissing},
+		{"DeleteTweet", testDeleteTweet},
+		{"DeleteTweetMissing", testDeleteTweetMissing},
+		{"DeleteTweetExcludesFromListing", testDeleteTweetExcludesFromListing},
+		{"DeleteTweetExcludesFromAggregates", testDeleteTweetExcludesFromAggregates},
+		{"PurgeTweets", testPurgeTweets},
+		{"CreateUser", testCreateUser},
+		{"CreateUserHandleTaken", testCreateUserHandleTaken},
+		{"GetUserMissing", testGetUserMissing},
+		{"ListTweetsFiltersByAuthor", testListTweetsFiltersByAuthor},
+		{"CreateToken", testCreateToken},
+		{"RevokeToken", testRevokeToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.test(t, newHarness(t))
+		})
+	}
+}
+
+func testCreateTweet(t *testing.T, h Harness) {
+	var (
+		require = require.New(t)
+		assert  = assert.New(t)
+		ctx     = context.Background()
+	)
+
+	id, err := h.Storage.CreateTweet(ctx, nil, "This is a test tweet!", []string{"conformance"})
+	require.NoError(err)
+	assert.Greater(id, int64(0), "Expected `id` of created tweet to be positive")
+
+	tweet, err := h.Storage.GetTweet(ctx, id)
+	require.NoError(err)
+
+	assert.Equal(id, tweet.ID, "Expected `id` to be the same as the one returned on create")
+	assert.Equal("This is a test tweet!", tweet.Message, "Expected `message` to be stored")
+	assert.Equal("conformance", tweet.Tag, "Expected `tag` to be stored")
+	assert.Equal([]string{"conformance"}, tweet.Tags, "Expected `tags` to be stored")
+	assert.False(tweet.CreatedAt.IsZero(), "Expected `created at` to default to the current time")
+}
+
+func testCreateTweetWithMultipleTags(t *testing.T, h Harness) {
+	var (
+		require = require.New(t)
+		assert  = assert.New(t)
+		ctx     = context.Background()
+	)
+
+	id, err := h.Storage.CreateTweet(ctx, nil, "This is a cross posted tweet!", []string{"second", "first", "third"})
+	require.NoError(err)
+
+	tweet, err := h.Storage.GetTweet(ctx, id)
+	require.NoError(err)
+	assert.Equal([]string{"second", "first", "third"}, tweet.Tags, "Expected `tags` to be stored in the order given")
+	assert.Equal("second", tweet.Tag, "Expected `tag` to be the first of the `tags`")
+
+	tweets, err := h.Storage.ListTweets(ctx, models.TweetQuery{Tag: "third", Limit: 10})
+	require.NoError(err)
+	require.Len(tweets, 1, "Expected tweet to be listed by any of its tags")
+	assert.Equal([]string{"second", "first", "third"}, tweets[0].Tags, "Expected listed tweets to have all their `tags`")
+}
+
+func testCreateTweetIDsAreMonotonic(t *testing.T, h Harness) {
+	var (
+		require = require.New(t)
+		assert  = assert.New(t)
+		ctx     = context.Background()
+	)
+
+	var previous int64
+	for i := range 10 {
+		id, err := h.Storage.CreateTweet(ctx, nil, fmt.Sprintf("Tweet number %d", i), []string{"conformance"})
+		require.NoError(err)
+		assert.Greater(id, previous, "Expected `id` of created tweets to be strictly increasing")
+		previous = id
+	}
+}
+
+func testCreateTweetConcurrently(t *testing.T, h Harness) {
+	var (
+		require = require.New(t)
+		assert  = assert.New(t)
+		ctx     = context.Background()
+
+		workers         = 8
+		tweetsPerWorker = 25
+	)
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		ids  = map[int64]bool{}
+		errs []error
+	)
+
+	for worker := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range tweetsPerWorker {
+				id, err := h.Storage.CreateTweet(ctx, nil, fmt.Sprintf("Tweet %d from worker %d", i, worker), []string{"conformance"})
+
+				mu.Lock()
+				if err != nil {
+					errs = append(errs, err)
+				} else {
+					assert.False(ids[id], "Expected `id` %d to only be handed out once", id)
+					ids[id] = true
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	require.NoError(errors.Join(errs...))
+	require.Len(ids, workers*tweetsPerWorker, "Expected every concurrently created tweet to get an `id`")
+
+	tweets, err := h.Storage.ListTweets(ctx, models.TweetQuery{Tag: "conformance", Limit: workers*tweetsPerWorker + 1})
+	require.NoError(err)
+	assert.Len(tweets, workers*tweetsPerWorker, "Expected every concurrently created tweet to be listed")
+}
+
+func testCreateTweetUnicode(t *testing.T, h Harness) {
+	var (
+		require = require.New(t)
+		assert  = assert.New(t)
+		ctx     = context.Background()
+	)
+
+	messages := []string{
+		"This is a test tweet! ✅",
+		"👋 Emoji outside of the basic multilingual plane 🤔🎉",
+		"日本語のツイートです",
+		"Combining characters: é ä ñ",
+		"Right to left: שלום עולם مرحبا بالعالم",
+	}
+
+	for _, message := range messages {
+		id, err := h.Storage.CreateTweet(ctx, nil, message, []string{"ünïcødé-✅"})
+		require.NoError(err)
+
+		tweet, err := h.Storage.GetTweet(ctx, id)
+		require.NoError(err)
+		assert.Equal(message, tweet.Message, "Expected `message` to survive a round trip unchanged")
+		assert.Equal("ünïcødé-✅", tweet.Tag, "Expected `tag` to survive a round trip unchanged")
+	}
+
+	tweets, err := h.Storage.ListTweets(ctx, models.TweetQuery{Tag: "ünïcødé-✅", Limit: len(messages) + 1})
+	require.NoError(err)
+	assert.Len(tweets, len(messages), "Expected tweets to be listed by their unicode `tag`")
+}
+
+func testGetTweetMissing(t *testing.T, h Harness) {
+	var (
+		require = require.New(t)
+		assert  = assert.New(t)
+		ctx     = context.Background()
+	)
+
+	id, err := h.Storage.CreateTweet(ctx, nil, "This is a test tweet!", []string{"conformance"})
+	require.NoError(err)
+
+	_, err = h.Storage.GetTweet(ctx, id+1000)
+	requireErrorKind(t, models.ErrKindMissing, err)
+
+	_, err = h.Storage.GetTweet(ctx, 0)
+	requireErrorKind(t, models.ErrKindMissing, err)
+
+	_, err = h.Storage.GetTweet(ctx, -1)
+	requireErrorKind(t, models.ErrKindMissing, err)
+
+	_, err = h.Storage.GetTweet(ctx, id)
+	assert.NoError(err, "Expected existing tweet to still be found")
+}
+
+func testGetTweets(t *testing.T, h Harness) {
+	var (
+		require = require.New(t)
+		assert  = assert.New(t)
+		ctx     = context.Background()
+	)
+
+	created := createTweets(t, h, "conformance", 3)
+
+	err := h.Storage.DeleteTweet(ctx, created[1])
+	require.NoError(err)
+
+	tweets, err := h.Storage.GetTweets(ctx, []int64{created[2], created[1], created[0], created[2] + 1000})
+	require.NoError(err)
+	assert.ElementsMatch([]int64{created[0], created[2]}, ids(tweets), "Expected missing and deleted tweets to be left out")
+
+	for _, tweet := range tweets {
+		assert.Equal([]string{"conformance"}, tweet.Tags, "Expected tweets to have their tags")
+	}
+
+	tweets, err = h.Storage.GetTweets(ctx, nil)
+	require.NoError(err)
+	assert.Empty(tweets)
+}
+
+func testListTweetsFiltersByTag(t *testing.T, h Harness) {
+	var (
+		require = require.New(t)
+		assert  = assert.New(t)
+		ctx     = context.Background()
+	)
+
+	want := createTweets(t, h, "wanted", 5)
+	createTweets(t, h, "unwanted", 5)
+	createTweets(t, h, "wanted-too", 5)
+
+	tweets, err := h.Storage.ListTweets(ctx, models.TweetQuery{Tag: "wanted", Limit: 50})
+	require.NoError(err)
+
+	assert.ElementsMatch(want, ids(tweets), "Expected only tweets with the tag `wanted` to be listed")
+	for _, tweet := range tweets {
+		assert.Equal("wanted", tweet.Tag, "Expected all tweets to have tag `wanted`")
+	}
+}
+
+func testListTweetsMatchesAnyTag(t *testing.T, h Harness) {
+	var (
+		require = require.New(t)
+		assert  = assert.New(t)
+		ctx     = context.Background()
+	)
+
+	var want []int64
+	for _, tags := range [][]string{{"wanted"}, {"other", "wanted"}, {"wanted", "other"}} {
+		id, err := h.Storage.CreateTweet(ctx, nil, "This is a cross posted tweet!", tags)
+		require.NoError(err)
+		want = append(want, id)
+	}
+	createTweets(t, h, "other", 3)
+
+	tweets, err := h.Storage.ListTweets(ctx, models.TweetQuery{Tag: "wanted", Limit: 50})
+	require.NoError(err)
+	assert.Equal(want, unsortedIDs(tweets), "Expected tweets with `wanted` among their tags to be listed, once each")
+}
+
+func testListTweetsUnknownTag(t *testing.T, h Harness) {
+	var (
+		require = require.New(t)
+		assert  = assert.New(t)
+	)
+
+	createTweets(t, h, "conformance", 3)
+
+	tweets, err := h.Storage.ListTweets(context.Background(), models.TweetQuery{Tag: "unknown", Limit: 50})
+	require.NoError(err)
+	assert.NotNil(tweets, "Expected an empty, not nil, list of tweets")
+	assert.Empty(tweets, "Expected no tweets for an unknown tag")
+}
+
+func testListTweetsPagination(t *testing.T, h Harness) {
+	var (
+		require = require.New(t)
+		assert  = assert.New(t)
+		ctx     = context.Background()
+	)
+
+	want := createTweets(t, h, "conformance", 23)
+
+	var (
+		got  []int64
+		seen = map[int64]bool{}
+	)
+	for offset := 0; offset < len(want); offset += 5 {
+		page, err := h.Storage.ListTweets(ctx, models.TweetQuery{Tag: "conformance", Offset: offset, Limit: 5})
+		require.NoError(err)
+
+		expected := min(5, len(want)-offset)
+		assert.Lenf(page, expected, "Expected page at offset %d to have %d tweets", offset, expected)
+		for _, tweet := range page {
+			assert.Falsef(seen[tweet.ID], "Expected tweet %d to only be listed on a single page", tweet.ID)
+			seen[tweet.ID] = true
+			got = append(got, tweet.ID)
+		}
+	}
+
+	assert.ElementsMatch(want, got, "Expected paging through all tweets to list every tweet exactly once")
+}
+
+func testListTweetsPaginationBoundaries(t *testing.T, h Harness) {
+	var (
+		require = require.New(t)
+		assert  = assert.New(t)
+		ctx     = context.Background()
+	)
+
+	createTweets(t, h, "conformance", 10)
+
+	tweets, err := h.Storage.ListTweets(ctx, models.TweetQuery{Tag: "conformance", Limit: 0})
+	require.NoError(err)
+	assert.Empty(tweets, "Expected no tweets with a limit of 0")
+
+	tweets, err = h.Storage.ListTweets(ctx, models.TweetQuery{Tag: "conformance", Limit: 10})
+	require.NoError(err)
+	assert.Len(tweets, 10, "Expected a limit equal to the number of tweets to list all tweets")
+
+	tweets, err = h.Storage.ListTweets(ctx, models.TweetQuery{Tag: "conformance", Limit: 11})
+	require.NoError(err)
+	assert.Len(tweets, 10, "Expected a limit larger than the number of tweets to list all tweets")
+
+	tweets, err = h.Storage.ListTweets(ctx, models.TweetQuery{Tag: "conformance", Offset: 9, Limit: 10})
+	require.NoError(err)
+	assert.Len(tweets, 1, "Expected the last page to only hold the remaining tweet")
+
+	tweets, err = h.Storage.ListTweets(ctx, models.TweetQuery{Tag: "conformance", Offset: 10, Limit: 10})
+	require.NoError(err)
+	assert.NotNil(tweets, "Expected an empty, not nil, list of tweets")
+	assert.Empty(tweets, "Expected no tweets with an offset equal to the number of tweets")
+
+	tweets, err = h.Storage.ListTweets(ctx, models.TweetQuery{Tag: "conformance", Offset: 1000, Limit: 10})
+	require.NoError(err)
+	assert.Empty(tweets, "Expected no tweets with an offset past the number of tweets")
+}
+
+func testListTweetsAllTags(t *testing.T, h Harness) {
+	var (
+		require = require.New(t)
+		assert  = assert.New(t)
+	)
+
+	want := append(createTweets(t, h, "first", 3), createTweets(t, h, "second", 3)...)
+
+	tweets, err := h.Storage.ListTweets(context.Background(), models.TweetQuery{Limit: 50})
+	require.NoError(err)
+	assert.Equal(want, unsortedIDs(tweets), "Expected tweets with any tag to be listed without a `tag`")
+}
+
+func testListTweetsTimeWindow(t *testing.T, h Harness) {
+	var (
+		require = require.New(t)
+		assert  = assert.New(t)
+		ctx     = context.Background()
+
+		since = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+		until = time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	requireInsertTweet(t, h)
+	inserted := insertTweets(t, h,
+		since.Add(-time.Second),
+		since,
+		until.Add(-time.Second),
+		until,
+	)
+
+	tweets, err := h.Storage.ListTweets(ctx, models.TweetQuery{Since: since, Until: until, Limit: 50})
+	require.NoError(err)
+	assert.Equal([]int64{inserted[1], inserted[2]}, unsortedIDs(tweets), "Expected `since` to be inclusive and `until` to be exclusive")
+
+	tweets, err = h.Storage.ListTweets(ctx, models.TweetQuery{Since: since, Limit: 50})
+	require.NoError(err)
+	assert.Equal(inserted[1:], unsortedIDs(tweets), "Expected only `since` to bound the window")
+
+	tweets, err = h.Storage.ListTweets(ctx, models.TweetQuery{Until: until, Limit: 50})
+	require.NoError(err)
+	assert.Equal(inserted[:3], unsortedIDs(tweets), "Expected only `until` to bound the window")
+
+	tweets, err = h.Storage.ListTweets(ctx, models.TweetQuery{Tag: "other", Since: since, Until: until, Limit: 50})
+	require.NoError(err)
+	assert.Empty(tweets, "Expected the window to be combined with `tag`")
+}
+
+func testListTweetsIDWindow(t *testing.T, h Harness) {
+	var (
+		require = require.New(t)
+		assert  = assert.New(t)
+		ctx     = context.Background()
+	)
+
+	created := createTweets(t, h, "conformance", 6)
+
+	tweets, err := h.Storage.ListTweets(ctx, models.TweetQuery{SinceID: created[1], MaxID: created[4], Limit: 50})
+	require.NoError(err)
+	assert.Equal(created[2:5], unsortedIDs(tweets), "Expected `since id` to be exclusive and `max id` to be inclusive")
+
+	tweets, err = h.Storage.ListTweets(ctx, models.TweetQuery{SinceID: created[3], Limit: 50})
+	require.NoError(err)
+	assert.Equal(created[4:], unsortedIDs(tweets), "Expected only `since id` to bound the window")
+
+	tweets, err = h.Storage.ListTweets(ctx, models.TweetQuery{MaxID: created[1], Limit: 50})
+	require.NoError(err)
+	assert.Equal(created[:2], unsortedIDs(tweets), "Expected only `max id` to bound the window")
+}
+
+func testListTweetsOrder(t *testing.T, h Harness) {
+	var (
+		require = require.New(t)
+		assert  = assert.New(t)
+		ctx     = context.Background()
+	)
+
+	requireInsertTweet(t, h)
+	inserted := insertTweets(t, h,
+		time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+	)
+
+	tweets, err := h.Storage.ListTweets(ctx, models.TweetQuery{Tag: "conformance", Sort: models.SortOldest, Limit: 10})
+	require.NoError(err)
+	assert.Equal(
+		[]int64{inserted[1], inserted[3], inserted[2], inserted[0]},
+		unsortedIDs(tweets),
+		"Expected tweets to be ordered by ascending `created at` and then `id`",
+	)
+}
+
+func testListTweetsOrderNewest(t *testing.T, h Harness) {
+	var (
+		require = require.New(t)
+		assert  = assert.New(t)
+		ctx     = context.Background()
+	)
+
+	requireInsertTweet(t, h)
+	inserted := insertTweets(t, h,
+		time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+	)
+
+	tweets, err := h.Storage.ListTweets(ctx, models.TweetQuery{Tag: "conformance", Sort: models.SortNewest, Limit: 10})
+	require.NoError(err)
+	assert.Equal(
+		[]int64{inserted[0], inserted[2], inserted[3], inserted[1]},
+		unsortedIDs(tweets),
+		"Expected tweets to be ordered by descending `created at` and then `id`",
+	)
+}
+
+func testListTweetsAfterCursor(t *testing.T, h Harness) {
+	// Tweets created within the same second share `created at`, so paging has to break ties on `id`
+	want := createTweets(t, h, "conformance", 23)
+	createTweets(t, h, "other", 5)
+
+	assert.Equal(t, want, pageWithCursor(t, h, models.SortOldest), "Expected paging with cursors to list every tweet exactly once, in order")
+}
+
+func testListTweetsAfterCursorNewest(t *testing.T, h Harness) {
+	want := createTweets(t, h, "conformance", 23)
+	createTweets(t, h, "other", 5)
+	slices.Reverse(want)
+
+	assert.Equal(t, want, pageWithCursor(t, h, models.SortNewest), "Expected paging with cursors to list every tweet exactly once, in order")
+}
+
+// pageWithCursor lists all tweets tagged `conformance` five at a time, using the cursor of the last tweet
+// on a page to get the next, and returns their ids in the order they were listed
+func pageWithCursor(t *testing.T, h Harness, order models.SortOrder) []int64 {
+	t.Helper()
+
+	var (
+		ids    []int64
+		cursor *models.Cursor
+	)
+	for {
+		page, err := h.Storage.ListTweets(context.Background(), models.TweetQuery{Tag: "conformance", Sort: order, After: cursor, Limit: 5})
+		require.NoError(t, err)
+
+		if len(page) == 0 {
+			return ids
+		}
+
+		ids = append(ids, unsortedIDs(page)...)
+		cursor = models.CursorOf(page[len(page)-1])
+	}
+}
+
+func testAggregateTweetsByYear(t *testing.T, h Harness) {
+	var (
+		require = require.New(t)
+		assert  = assert.New(t)
+		ctx     = context.Background()
+	)
+
+	requireInsertTweet(t, h)
+	insertTweets(t, h,
+		time.Date(2023, time.December, 31, 23, 59, 59, 0, time.UTC),
+		time.Date(2022, time.June, 1, 12, 0, 0, 0, time.UTC),
+		time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, time.March, 14, 19, 36, 22, 0, time.UTC),
+		time.Date(2022, time.February, 1, 12, 0, 0, 0, time.UTC),
+		time.Date(2024, time.July, 1, 8, 0, 0, 0, time.UTC),
+	)
+
+	aggregates, err := h.Storage.AggregateTweetsByYear(
+		ctx,
+		time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, time.December, 31, 23, 59, 59, 0, time.UTC),
+	)
+	require.NoError(err)
+
+	assert.Equal([]models.YearlyAggregate{
+		{Year: 2022, Tweets: 2},
+		{Year: 2023, Tweets: 2},
+		{Year: 2024, Tweets: 3},
+	}, aggregates, "Expected one aggregate per year in ascending order")
+}
+
+func testAggregateTweetsByMonth(t *testing.T, h Harness) {
+	var (
+		require = require.New(t)
+		assert  = assert.New(t)
+		ctx     = context.Background()
+	)
+
+	requireInsertTweet(t, h)
+	insertTweets(t, h,
+		time.Date(2024, time.March, 14, 19, 36, 22, 0, time.UTC),
+		time.Date(2023, time.December, 31, 23, 59, 59, 0, time.UTC),
+		time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, time.January, 31, 23, 59, 59, 0, time.UTC),
+		time.Date(2024, time.March, 31, 12, 0, 0, 0, time.UTC),
+	)
+
+	aggregates, err := h.Storage.AggregateTweetsByMonth(
+		ctx,
+		time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, time.December, 31, 23, 59, 59, 0, time.UTC),
+	)
+	require.NoError(err)
+
+	assert.Equal([]models.MonthlyAggregate{
+		{Year: 2023, Month: 3, Tweets: 1},
+		{Year: 2023, Month: 12, Tweets: 1},
+		{Year: 2024, Month: 1, Tweets: 2},
+		{Year: 2024, Month: 3, Tweets: 3},
+	}, aggregates, "Expected one aggregate per month in ascending order")
+}
+
+func testAggregateTweetsRangeIsInclusive(t *testing.T, h Harness) {
+	var (
+		require = require.New(t)
+		assert  = assert.New(t)
+		ctx     = context.Background()
+
+		from = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+		to   = time.Date(2024, time.December, 31, 0, 0, 0, 0, time.UTC)
+	)
+
+	requireInsertTweet(t, h)
+	insertTweets(t, h,
+		from.Add(-time.Second),
+		from,
+		to,
+		to.Add(time.Second),
+	)
+
+	yearly, err := h.Storage.AggregateTweetsByYear(ctx, from, to)
+	require.NoError(err)
+	assert.Equal([]models.YearlyAggregate{{Year: 2024, Tweets: 2}}, yearly, "Expected both `from` and `to` to be inclusive")
+
+	monthly, err := h.Storage.AggregateTweetsByMonth(ctx, from, to)
+	require.NoError(err)
+	assert.Equal([]models.MonthlyAggregate{
+		{Year: 2024, Month: 1, Tweets: 1},
+		{Year: 2024, Month: 12, Tweets: 1},
+	}, monthly, "Expected both `from` and `to` to be inclusive")
+
+	yearly, err = h.Storage.AggregateTweetsByYear(ctx, from, from)
+	require.NoError(err)
+	assert.Equal([]models.YearlyAggregate{{Year: 2024, Tweets: 1}}, yearly, "Expected a range of a single instant to be inclusive")
+}
+
+func testAggregateTweetsEmptyRange(t *testing.T, h Harness) {
+	var (
+		require = require.New(t)
+		assert  = assert.New(t)
+		ctx     = context.Background()
+
+		from = time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC)
+		to   = time.Date(1990, time.December, 31, 0, 0, 0, 0, time.UTC)
+	)
+
+	createTweets(t, h, "conformance", 3)
+
+	yearly, err := h.Storage.AggregateTweetsByYear(ctx, from, to)
+	require.NoError(err)
+	assert.Empty(yearly, "Expected no aggregates for a range without tweets")
+
+	monthly, err := h.Storage.AggregateTweetsByMonth(ctx, from, to)
+	require.NoError(err)
+	assert.Empty(monthly, "Expected no aggregates for a range without tweets")
+}
+
+func testUpdateTweet(t *testing.T, h Harness) {
+	var (
+		require = require.New(t)
+		assert  = assert.New(t)
+		ctx     = context.Background()
+	)
+
+	id, err := h.Storage.CreateTweet(ctx, nil, "This is a tset tweet!", []string{"conformance"})
+	require.NoError(err)
+
+	original, err := h.Storage.GetTweet(ctx, id)
+	require.NoError(err)
+	assert.Nil(original.EditedAt, "Expected `edited_at` to be unset before the tweet is edited")
+	assert.Zero(original.Revisions, "Expected no `revisions` before the tweet is edited")
+
+	revisions, err := h.Storage.ListRevisions(ctx, id)
+	require.NoError(err)
+	assert.Empty(revisions, "Expected no revisions before the tweet is edited")
+
+	err = h.Storage.UpdateTweet(ctx, id, replaceWith("This is a test tweet!", []string{"conformance"}))
+	require.NoError(err)
+
+	err = h.Storage.UpdateTweet(ctx, id, replaceWith("This is a test tweet, edited twice!", []string{"conformance", "edited"}))
+	require.NoError(err)
+
+	tweet, err := h.Storage.GetTweet(ctx, id)
+	require.NoError(err)
+	assert.Equal("This is a test tweet, edited twice!", tweet.Message)
+	assert.Equal([]string{"conformance", "edited"}, tweet.Tags)
+	assert.Equal(original.CreatedAt, tweet.CreatedAt, "Expected `created_at` to not change when the tweet is edited")
+	assert.Equal(2, tweet.Revisions)
+	require.NotNil(tweet.EditedAt, "Expected `edited_at` to be set once the tweet is edited")
+	assert.WithinDuration(time.Now(), *tweet.EditedAt, 5*time.Second)
+
+	revisions, err = h.Storage.ListRevisions(ctx, id)
+	require.NoError(err)
+	require.Len(revisions, 2)
+
+	assert.Equal(1, revisions[0].Revision)
+	assert.Equal("This is a tset tweet!", revisions[0].Message)
+	assert.Equal([]string{"conformance"}, revisions[0].Tags)
+	assert.Equal(original.CreatedAt, revisions[0].CreatedAt, "Expected the first revision to date from when the tweet was created")
+
+	assert.Equal(2, revisions[1].Revision)
+	assert.Equal("This is a test tweet!", revisions[1].Message)
+	assert.Equal([]string{"conformance"}, revisions[1].Tags)
+	assert.WithinDuration(time.Now(), revisions[1].CreatedAt, 5*time.Second)
+}
+
+func testUpdateTweetReplacesTags(t *testing.T, h Harness) {
+	var (
+		require = require.New(t)
+		assert  = assert.New(t)
+		ctx     = context.Background()
+	)
+
+	created := createTweets(t, h, "conformance", 2)
+
+	err := h.Storage.UpdateTweet(ctx, created[0], replaceWith("This is a retagged tweet!", []string{"retagged"}))
+	require.NoError(err)
+
+	tweets, err := h.Storage.ListTweets(ctx, models.TweetQuery{Tag: "conformance", Limit: 10})
+	require.NoError(err)
+	assert.Equal([]int64{created[1]}, ids(tweets), "Expected edited tweets to not be listed by their old tags")
+
+	tweets, err = h.Storage.ListTweets(ctx, models.TweetQuery{Tag: "retagged", Limit: 10})
+	require.NoError(err)
+	require.Len(tweets, 1, "Expected edited tweets to be listed by their new tags")
+	assert.Equal(created[0], tweets[0].ID)
+	assert.Equal("retagged", tweets[0].Tag)
+	assert.Equal(1, tweets[0].Revisions)
+}
+
+func testUpdateTweetMissing(t *testing.T, h Harness) {
+	created := createTweets(t, h, "conformance", 2)
+
+	err := h.Storage.UpdateTweet(context.Background(), created[0]+1000, replaceWith("This is a test tweet!", []string{"conformance"}))
+	requireErrorKind(t, models.ErrKindMissing, err)
+
+	err = h.Storage.DeleteTweet(context.Background(), created[1])
+	require.NoError(t, err)
+
+	err = h.Storage.UpdateTweet(context.Background(), created[1], replaceWith("This is a test tweet!", []string{"conformance"}))
+	requireErrorKind(t, models.ErrKindGone, err)
+}
+
+func testUpdateTweetEditsCurrentVersion(t *testing.T, h Harness) {
+	var (
+		require = require.New(t)
+		assert  = assert.New(t)
+		ctx     = context.Background()
+	)
+
+	id, err := h.Storage.CreateTweet(ctx, nil, "This is a test tweet!", []string{"conformance", "first"})
+	require.NoError(err)
+
+	err = h.Storage.UpdateTweet(ctx, id, func(current models.Tweet) (string, []string, error) {
+		assert.Equal(id, current.ID)
+		assert.Equal("This is a test tweet!", current.Message)
+		assert.Equal([]string{"conformance", "first"}, current.Tags, "Expected edit to be given the tags of the tweet")
+		return current.Message + " Edited!", append(current.Tags, "second"), nil
+	})
+	require.NoError(err)
+
+	err = h.Storage.UpdateTweet(ctx, id, func(current models.Tweet) (string, []string, error) {
+		assert.Equal("This is a test tweet! Edited!", current.Message, "Expected edit to be given the latest version of the tweet")
+		return "", nil, models.ErrForbiddenf("not allowed to edit tweet %d", id)
+	})
+	requireErrorKind(t, models.ErrKindForbidden, err)
+
+	tweet, err := h.Storage.GetTweet(ctx, id)
+	require.NoError(err)
+	assert.Equal("This is a test tweet! Edited!", tweet.Message, "Expected the tweet to not change when edit fails")
+	assert.Equal([]string{"conformance", "first", "second"}, tweet.Tags)
+	assert.Equal(1, tweet.Revisions)
+}
+
+func testListRevisionsMissing(t *testing.T, h Harness) {
+	created := createTweets(t, h, "conformance", 1)
+
+	_, err := h.Storage.ListRevisions(context.Background(), created[0]+1000)
+	requireErrorKind(t, models.ErrKindMissing, err)
+
+	err = h.Storage.DeleteTweet(context.Background(), created[0])
+	require.NoError(t, err)
+
+	_, err = h.Storage.ListRevisions(context.Background(), created[0])
+	requireErrorKind(t, models.ErrKindGone, err)
+}
+
+func testDeleteTweet(t *testing.T, h Harness) {
+	var (
+		require = require.New(t)
+		ctx     = context.Background()
+	)
+
+	created := createTweets(t, h, "conformance", 2)
+
+	err := h.Storage.DeleteTweet(ctx, created[0])
+	require.NoError(err)
+
+	_, err = h.Storage.GetTweet(ctx, created[0])
+	requireErrorKind(t, models.ErrKindGone, err)
+
+	err = h.Storage.DeleteTweet(ctx, created[0])
+	requireErrorKind(t, models.ErrKindGone, err)
+
+	_, err = h.Storage.GetTweet(ctx, created[1])
+	require.NoError(err, "Expected other tweets to not be deleted")
+}
+
+func testDeleteTweetMissing(t *testing.T, h Harness) {
+	created := createTweets(t, h, "conformance", 1)
+
+	err := h.Storage.DeleteTweet(context.Background(), created[0]+1000)
+	requireErrorKind(t, models.ErrKindMissing, err)
+}
+
+func testDeleteTweetExcludesFromListing(t *testing.T, h Harness) {
+	var (
+		require = require.New(t)
+		assert  = assert.New(t)
+		ctx     = context.Background()
+	)
+
+	created := createTweets(t, h, "conformance", 3)
+
+	err := h.Storage.DeleteTweet(ctx, created[1])
+	require.NoError(err)
+
+	tweets, err := h.Storage.ListTweets(ctx, models.TweetQuery{Tag: "conformance", Limit: 10})
+	require.NoError(err)
+	assert.Equal([]int64{created[0], created[2]}, ids(tweets), "Expected deleted tweets to not be listed by tag")
+
+	tweets, err = h.Storage.ListTweets(ctx, models.TweetQuery{Limit: 10})
+	require.NoError(err)
+	assert.Equal([]int64{created[0], created[2]}, ids(tweets), "Expected deleted tweets to not be listed across all tags")
+}
+
+func testDeleteTweetExcludesFromAggregates(t *testing.T, h Harness) {
+	var (
+		require = require.New(t)
+		assert  = assert.New(t)
+		ctx     = context.Background()
+
+		from = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+		to   = time.Date(2024, time.December, 31, 0, 0, 0, 0, time.UTC)
+	)
+
+	requireInsertTweet(t, h)
+	created := insertTweets(t, h,
+		time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, time.March, 2, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC),
+	)
+
+	err := h.Storage.DeleteTweet(ctx, created[0])
+	require.NoError(err)
+
+	err = h.Storage.DeleteTweet(ctx, created[2])
+	require.NoError(err)
+
+	yearly, err := h.Storage.AggregateTweetsByYear(ctx, from, to)
+	require.NoError(err)
+	assert.Equal([]models.YearlyAggregate{{Year: 2024, Tweets: 1}}, yearly, "Expected deleted tweets to not be counted")
+
+	monthly, err := h.Storage.AggregateTweetsByMonth(ctx, from, to)
+	require.NoError(err)
+	assert.Equal([]models.MonthlyAggregate{{Year: 2024, Month: 3, Tweets: 1}}, monthly, "Expected deleted tweets to not be counted")
+}
+
+func testPurgeTweets(t *testing.T, h Harness) {
+	var (
+		require = require.New(t)
+		assert  = assert.New(t)
+		ctx     = context.Background()
+	)
+
+	created := createTweets(t, h, "conformance", 3)
+
+	err := h.Storage.DeleteTweet(ctx, created[0])
+	require.NoError(err)
+
+	err = h.Storage.DeleteTweet(ctx, created[1])
+	require.NoError(err)
+
+	purged, err := h.Storage.PurgeTweets(ctx, time.Now().Add(-time.Hour))
+	require.NoError(err)
+	assert.Zero(purged, "Expected tweets deleted after the cutoff to not be purged")
+
+	_, err = h.Storage.GetTweet(ctx, created[0])
+	requireErrorKind(t, models.ErrKindGone, err)
+
+	purged, err = h.Storage.PurgeTweets(ctx, time.Now().Add(time.Hour))
+	require.NoError(err)
+	assert.Equal(int64(2), purged, "Expected tweets deleted before the cutoff to be purged")
+
+	_, err = h.Storage.GetTweet(ctx, created[0])
+	requireErrorKind(t, models.ErrKindMissing, err)
+
+	_, err = h.Storage.GetTweet(ctx, created[2])
+	require.NoError(err, "Expected tweets that aren't deleted to not be purged")
+
+	id, err := h.Storage.CreateTweet(ctx, nil, "This is a test tweet!", []string{"conformance"})
+	require.NoError(err)
+	assert.Greater(id, created[2], "Expected `id` of purged tweets to not be reused")
+}
+
+func testCreateUser(t *testing.T, h Harness) {
+	var (
+		require = require.New(t)
+		assert  = assert.New(t)
+		ctx     = context.Background()
+	)
+
+	id, err := h.Storage.CreateUser(ctx, "conformance", "Conformance Suite 🧪")
+	require.NoError(err)
+	assert.Greater(id, int64(0), "Expected `id` to be a positive integer")
+
+	user, err := h.Storage.GetUser(ctx, id)
+	require.NoError(err)
+	assert.Equal(id, user.ID)
+	assert.Equal("conformance", user.Handle)
+	assert.Equal("Conformance Suite 🧪", user.DisplayName)
+	assert.WithinDuration(time.Now(), user.CreatedAt, 5*time.Second)
+
+	byHandle, err := h.Storage.GetUserByHandle(ctx, "conformance")
+	require.NoError(err)
+	assert.Equal(user, byHandle, "Expected the same user by id and by handle")
+
+	other, err := h.Storage.CreateUser(ctx, "other", "")
+	require.NoError(err)
+	assert.Greater(other, id, "Expected `id` of users to be monotonically increasing")
+}
+
+func testCreateUserHandleTaken(t *testing.T, h Harness) {
+	ctx := context.Background()
+
+	_, err := h.Storage.CreateUser(ctx, "conformance", "")
+	require.NoError(t, err)
+
+	_, err = h.Storage.CreateUser(ctx, "conformance", "Someone else")
+	requireErrorKind(t, models.ErrKindConflict, err)
+}
+
+func testGetUserMissing(t *testing.T, h Harness) {
+	ctx := context.Background()
+
+	id, err := h.Storage.CreateUser(ctx, "conformance", "")
+	require.NoError(t, err)
+
+	_, err = h.Storage.GetUser(ctx, id+1000)
+	requireErrorKind(t, models.ErrKindMissing, err)
+
+	_, err = h.Storage.GetUserByHandle(ctx, "missing")
+	requireErrorKind(t, models.ErrKindMissing, err)
+}
+
+func testListTweetsFiltersByAuthor(t *testing.T, h Harness) {
+	var (
+		require = require.New(t)
+		assert  = assert.New(t)
+		ctx     = context.Background()
+	)
+
+	author, err := h.Storage.CreateUser(ctx, "author", "")
+	require.NoError(err)
+
+	other, err := h.Storage.CreateUser(ctx, "other", "")
+	require.NoError(err)
+
+	var created []int64
+	for _, authorID := range []*int64{&author, nil, &other, &author} {
+		id, err := h.Storage.CreateTweet(ctx, authorID, "This is a test tweet!", []string{"conformance"})
+		require.NoError(err)
+		created = append(created, id)
+	}
+
+	tweet, err := h.Storage.GetTweet(ctx, created[0])
+	require.NoError(err)
+	require.NotNil(tweet.AuthorID, "Expected `author_id` to be set")
+	assert.Equal(author, *tweet.AuthorID)
+
+	tweet, err = h.Storage.GetTweet(ctx, created[1])
+	require.NoError(err)
+	assert.Nil(tweet.AuthorID, "Expected `author_id` of anonymous tweets to be unset")
+
+	tweets, err := h.Storage.ListTweets(ctx, models.TweetQuery{AuthorID: author, Limit: 10})
+	require.NoError(err)
+	assert.Equal([]int64{created[0], created[3]}, ids(tweets), "Expected only tweets by the author to be listed")
+
+	tweets, err = h.Storage.ListTweets(ctx, models.TweetQuery{AuthorID: other, Tag: "conformance", Limit: 10})
+	require.NoError(err)
+	assert.Equal([]int64{created[2]}, ids(tweets), "Expected the author filter to combine with the tag filter")
+}
+
+func testCreateToken(t *testing.T, h Harness) {
+	var (
+		require = require.New(t)
+		assert  = assert.New(t)
+		ctx     = context.Background()
+		hash    = strings.Repeat("ab", 32)
+	)
+
+	userID, err := h.Storage.CreateUser(ctx, "conformance", "")
+	require.NoError(err)
+
+	id, err := h.Storage.CreateToken(ctx, "conformance", &userID, []string{"writer", "admin"}, hash)
+	require.NoError(err)
+	assert.Greater(id, int64(0), "Expected `id` to be a positive integer")
+
+	token, err := h.Storage.GetTokenByHash(ctx, hash)
+	require.NoError(err)
+	assert.Equal(id, token.ID)
+	assert.Equal("conformance", token.Name)
+	require.NotNil(token.UserID, "Expected `user_id` to be set")
+	assert.Equal(userID, *token.UserID)
+	assert.Equal([]string{"writer", "admin"}, token.Roles, "Expected `roles` to be kept in order")
+	assert.WithinDuration(time.Now(), token.CreatedAt, 5*time.Second)
+	assert.Nil(token.RevokedAt, "Expected `revoked_at` to be unset")
+
+	other, err := h.Storage.CreateToken(ctx, "service", nil, []string{}, strings.Repeat("cd", 32))
+	require.NoError(err)
+	assert.Greater(other, id, "Expected `id` of tokens to be monotonically increasing")
+
+	token, err = h.Storage.GetTokenByHash(ctx, strings.Repeat("cd", 32))
+	require.NoError(err)
+	assert.Nil(token.UserID, "Expected `user_id` of tokens without a user to be unset")
+	assert.Empty(token.Roles, "Expected no `roles`")
+
+	_, err = h.Storage.GetTokenByHash(ctx, strings.Repeat("ef", 32))
+	requireErrorKind(t, models.ErrKindMissing, err)
+}
+
+func testRevokeToken(t *testing.T, h Harness) {
+	var (
+		require = require.New(t)
+		assert  = assert.New(t)
+		ctx     = context.Background()
+		hash    = strings.Repeat("ab", 32)
+	)
+
+	id, err := h.Storage.CreateToken(ctx, "conformance", nil, []string{"reader"}, hash)
+	require.NoError(err)
+
+	err = h.Storage.RevokeToken(ctx, id)
+	require.NoError(err)
+
+	token, err := h.Storage.GetTokenByHash(ctx, hash)
+	require.NoError(err, "Expected revoked tokens to still be found")
+	require.NotNil(token.RevokedAt, "Expected `revoked_at` to be set")
+	assert.WithinDuration(time.Now(), *token.RevokedAt, 5*time.Second)
+
+	err = h.Storage.RevokeToken(ctx, id)
+	requireErrorKind(t, models.ErrKindGone, err)
+
+	err = h.Storage.RevokeToken(ctx, id+1000)
+	requireErrorKind(t, models.ErrKindMissing, err)
+}
+
+func createTweets(t *testing.T, h Harness, tag string, n int) []int64 {
+	t.Helper()
+
+	var ids []int64
+	for i := range n {
+		id, err := h.Storage.CreateTweet(context.Background(), nil, fmt.Sprintf("Tweet number %d tagged %s", i, tag), []string{tag})
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+
+	return ids
+}
+
+func insertTweets(t *testing.T, h Harness, createdAt ...time.Time) []int64 {
+	t.Helper()
+
+	var ids []int64
+	for i, at := range createdAt {
+		id, err := h.InsertTweet(context.Background(), models.Tweet{
+			Message:   fmt.Sprintf("Tweet number %d created at %s", i, at.Format(time.RFC3339)),
+			Tags:      []string{"conformance"},
+			CreatedAt: at,
+		})
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+
+	return ids
+}
+
+func requireInsertTweet(t *testing.T, h Harness) {
+	t.Helper()
+
+	if h.InsertTweet == nil {
+		t.Skip("harness can't insert tweets with a given creation time")
+	}
+}
+
+// replaceWith returns an edit for UpdateTweet that replaces the message and tags of the tweet
+func replaceWith(message string, tags []string) func(models.Tweet) (string, []string, error) {
+	return func(models.Tweet) (string, []string, error) {
+		return message, tags, nil
+	}
+}
+
+func requireErrorKind(t *testing.T, kind models.ErrorKind, err error) {
+	t.Helper()
+
+	var e models.Error
+	require.ErrorAs(t, err, &e, "Expected error to be a `models.Error`")
+	require.Equalf(t, kind, e.Kind, "Expected `error kind` to be `%s`", kind)
+}
+
+func ids(tweets []models.Tweet) []int64 {
+	ids := unsortedIDs(tweets)
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func unsortedIDs(tweets []models.Tweet) []int64 {
+	ids := make([]int64, 0, len(tweets))
+	for _, tweet := range tweets {
+		ids = append(ids, tweet.ID)
+	}
+	return ids
+}
@@ -0,0 +1,39 @@
+package twitter
+
+import (
+	"simple_twitter/models"
+	"strings"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+// NormalizeTag returns the canonical form of tag, which is how tags are stored, queried and returned. Surrounding
+// whitespace is trimmed and the tag is case folded and put in Unicode NFC, so "Go", " go" and "GO" are all the
+// same tag "go". Accents are kept, "gö" and "go" are different tags. A canonical tag is made up of letters,
+// marks, digits, `-` and `_` in any script and is at most MAX_TWEET_TAG_LENGTH bytes long.
+func NormalizeTag(tag string) (string, error) {
+	// Case folding can leave the string denormalized, e.g by folding a precomposed character to a base
+	// character and a combining mark, so it's normalized both before and after
+	tag = norm.NFC.String(cases.Fold().String(norm.NFC.String(strings.TrimSpace(tag))))
+
+	if tag == "" {
+		return "", models.ErrInvalid("`tag` can't be empty")
+	}
+
+	for _, r := range tag {
+		if !isTagRune(r) {
+			return "", models.ErrInvalid("`tag` can only contain letters, digits, `-` and `_`")
+		}
+	}
+
+	if len(tag) > MAX_TWEET_TAG_LENGTH {
+		return "", models.ErrInvalidf("`tag` is too long, must be shorter than %d bytes", MAX_TWEET_TAG_LENGTH)
+	}
+
+	return tag, nil
+}
+
+func isTagRune(r rune) bool {
+	return r == '-' || isHashtagRune(r)
+}
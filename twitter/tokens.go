@@ -0,0 +1,126 @@
+package twitter
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"simple_twitter/models"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	TOKEN_PREFIX               = "st_" // Prefix of token secrets, to tell them apart from other credentials
+	MAX_TOKEN_NAME_LENGTH_UTF8 = 64    // Max length of the name of a token (UTF8 length)
+)
+
+type TokenStorage interface {
+	CreateToken(ctx context.Context, name string, userID *int64, roles []string, hash string) (int64, error)
+	GetTokenByHash(ctx context.Context, hash string) (models.Token, error)
+	RevokeToken(ctx context.Context, id int64) error
+}
+
+// CreateToken creates an API token named name with roles, acting on behalf of the user with handle unless handle is
+// empty. It returns the token together with its secret, which isn't stored and can't be retrieved later.
+func (t Twitter) CreateToken(ctx context.Context, name string, handle string, roles []string) (models.Token, string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return models.Token{}, "", models.ErrInvalid("`name` can't be empty")
+	}
+
+	if utf8.RuneCountInString(name) > MAX_TOKEN_NAME_LENGTH_UTF8 {
+		return models.Token{}, "", models.ErrInvalidf("`name` is too long, must be at most %d code points", MAX_TOKEN_NAME_LENGTH_UTF8)
+	}
+
+	if len(roles) == 0 {
+		return models.Token{}, "", models.ErrInvalid("`roles` can't be empty")
+	}
+
+	for _, role := range roles {
+		if _, ok := t.roles[role]; !ok {
+			return models.Token{}, "", models.ErrInvalidf("unknown role %q", role)
+		}
+	}
+
+	var userID *int64
+	if handle != "" {
+		user, err := t.GetUser(ctx, handle)
+		if err != nil {
+			return models.Token{}, "", err
+		}
+		userID = &user.ID
+	}
+
+	secret := make([]byte, 32)
+	_, err := rand.Read(secret)
+	if err != nil {
+		return models.Token{}, "", models.ErrInternalWithCause("failed to generate token", err)
+	}
+
+	token := TOKEN_PREFIX + base64.RawURLEncoding.EncodeToString(secret)
+	_, err = t.tokens.CreateToken(ctx, name, userID, roles, hashToken(token))
+	if err != nil {
+		return models.Token{}, "", models.ErrInternalWithCause("failed to create token", err)
+	}
+
+	created, err := t.tokens.GetTokenByHash(ctx, hashToken(token))
+	if err != nil {
+		return models.Token{}, "", models.ErrInternalWithCause("failed to create token", err)
+	}
+
+	return created, token, nil
+}
+
+// RevokeToken revokes the token with id, requests authenticated with it are rejected from then on
+func (t Twitter) RevokeToken(ctx context.Context, id int64) error {
+	if id <= 0 {
+		return models.ErrInvalid("`id` must be a positive integer")
+	}
+
+	err := t.tokens.RevokeToken(ctx, id)
+	if e, ok := err.(models.Error); ok && (e.Kind == models.ErrKindMissing || e.Kind == models.ErrKindGone) {
+		return e
+	}
+
+	if err != nil {
+		return models.ErrInternalWithCause("failed to revoke token", err)
+	}
+
+	return nil
+}
+
+// Authenticate returns the principal the token with secret acts on behalf of
+func (t Twitter) Authenticate(ctx context.Context, secret string) (models.Principal, error) {
+	if !strings.HasPrefix(secret, TOKEN_PREFIX) {
+		return models.Principal{}, models.ErrUnauthenticated("malformed token")
+	}
+
+	token, err := t.tokens.GetTokenByHash(ctx, hashToken(secret))
+	if e, ok := err.(models.Error); ok && e.Kind == models.ErrKindMissing {
+		return models.Principal{}, models.ErrUnauthenticated("invalid token")
+	}
+
+	if err != nil {
+		return models.Principal{}, models.ErrInternalWithCause("failed to authenticate token", err)
+	}
+
+	if token.RevokedAt != nil {
+		return models.Principal{}, models.ErrUnauthenticated("token has been revoked")
+	}
+
+	return models.Principal{
+		TokenID: token.ID,
+		Name:    token.Name,
+		UserID:  token.UserID,
+		Scopes:  t.scopesOf(token.Roles),
+	}, nil
+}
+
+// hashToken returns the hash tokens are stored and looked up by. Secrets are random enough that a fast, unsalted
+// hash doesn't make them any easier to guess, and it lets tokens be looked up by their hash.
+func hashToken(secret string) string {
+	hash := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(hash[:])
+}
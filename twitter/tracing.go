@@ -0,0 +1,154 @@
+package twitter
+
+import (
+	"context"
+	"simple_twitter/models"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracedTwitter traces every call to the methods of Twitter with a span named after the method
+type TracedTwitter struct {
+	Twitter
+	tracer trace.Tracer
+}
+
+func (t TracedTwitter) CreateTweet(ctx context.Context, authorID *int64, message string, tags []string) (models.Tweet, error) {
+	ctx, span := t.tracer.Start(ctx, "Twitter.CreateTweet")
+	tweet, err := t.Twitter.CreateTweet(ctx, authorID, message, tags)
+	end(span, err)
+	return tweet, err
+}
+
+func (t TracedTwitter) GetTweet(ctx context.Context, id int64) (models.Tweet, error) {
+	ctx, span := t.tracer.Start(ctx, "Twitter.GetTweet", trace.WithAttributes(attribute.Int64("tweet.id", id)))
+	tweet, err := t.Twitter.GetTweet(ctx, id)
+	end(span, err)
+	return tweet, err
+}
+
+func (t TracedTwitter) EditTweet(ctx context.Context, editor *models.Principal, id int64, patch models.TweetPatch) (models.Tweet, error) {
+	ctx, span := t.tracer.Start(ctx, "Twitter.EditTweet", trace.WithAttributes(attribute.Int64("tweet.id", id)))
+	tweet, err := t.Twitter.EditTweet(ctx, editor, id, patch)
+	end(span, err)
+	return tweet, err
+}
+
+func (t TracedTwitter) ListRevisions(ctx context.Context, id int64) (models.TweetRevisions, error) {
+	ctx, span := t.tracer.Start(ctx, "Twitter.ListRevisions", trace.WithAttributes(attribute.Int64("tweet.id", id)))
+	revisions, err := t.Twitter.ListRevisions(ctx, id)
+	end(span, err)
+	return revisions, err
+}
+
+func (t TracedTwitter) DeleteTweet(ctx context.Context, editor *models.Principal, id int64) error {
+	ctx, span := t.tracer.Start(ctx, "Twitter.DeleteTweet", trace.WithAttributes(attribute.Int64("tweet.id", id)))
+	err := t.Twitter.DeleteTweet(ctx, editor, id)
+	end(span, err)
+	return err
+}
+
+func (t TracedTwitter) PurgeTweets(ctx context.Context, retention time.Duration) (int64, error) {
+	ctx, span := t.tracer.Start(ctx, "Twitter.PurgeTweets")
+	purged, err := t.Twitter.PurgeTweets(ctx, retention)
+	end(span, err)
+	return purged, err
+}
+
+func (t TracedTwitter) ListTweets(ctx context.Context, query models.TweetQuery) (models.TweetPage, error) {
+	ctx, span := t.tracer.Start(ctx, "Twitter.ListTweets")
+	page, err := t.Twitter.ListTweets(ctx, query)
+	end(span, err)
+	return page, err
+}
+
+func (t TracedTwitter) SearchTweets(ctx context.Context, query models.SearchQuery) (models.SearchPage, error) {
+	ctx, span := t.tracer.Start(ctx, "Twitter.SearchTweets")
+	page, err := t.Twitter.SearchTweets(ctx, query)
+	end(span, err)
+	return page, err
+}
+
+func (t TracedTwitter) IndexTweets(ctx context.Context) error {
+	ctx, span := t.tracer.Start(ctx, "Twitter.IndexTweets")
+	err := t.Twitter.IndexTweets(ctx)
+	end(span, err)
+	return err
+}
+
+func (t TracedTwitter) AggregateTweets(ctx context.Context, from time.Time, to time.Time, groupBy string) (models.AggregatedTweets, error) {
+	ctx, span := t.tracer.Start(ctx, "Twitter.AggregateTweets")
+	tweets, err := t.Twitter.AggregateTweets(ctx, from, to, groupBy)
+	end(span, err)
+	return tweets, err
+}
+
+func (t TracedTwitter) CreateUser(ctx context.Context, handle string, displayName string) (models.User, error) {
+	ctx, span := t.tracer.Start(ctx, "Twitter.CreateUser")
+	user, err := t.Twitter.CreateUser(ctx, handle, displayName)
+	end(span, err)
+	return user, err
+}
+
+func (t TracedTwitter) GetUser(ctx context.Context, handle string) (models.User, error) {
+	ctx, span := t.tracer.Start(ctx, "Twitter.GetUser")
+	user, err := t.Twitter.GetUser(ctx, handle)
+	end(span, err)
+	return user, err
+}
+
+func (t TracedTwitter) ListUserTweets(ctx context.Context, handle string, query models.TweetQuery) (models.TweetPage, error) {
+	ctx, span := t.tracer.Start(ctx, "Twitter.ListUserTweets")
+	page, err := t.Twitter.ListUserTweets(ctx, handle, query)
+	end(span, err)
+	return page, err
+}
+
+func (t TracedTwitter) CreateToken(ctx context.Context, name string, handle string, roles []string) (models.Token, string, error) {
+	ctx, span := t.tracer.Start(ctx, "Twitter.CreateToken")
+	token, secret, err := t.Twitter.CreateToken(ctx, name, handle, roles)
+	end(span, err)
+	return token, secret, err
+}
+
+func (t TracedTwitter) RevokeToken(ctx context.Context, id int64) error {
+	ctx, span := t.tracer.Start(ctx, "Twitter.RevokeToken")
+	err := t.Twitter.RevokeToken(ctx, id)
+	end(span, err)
+	return err
+}
+
+func (t TracedTwitter) Authenticate(ctx context.Context, secret string) (models.Principal, error) {
+	ctx, span := t.tracer.Start(ctx, "Twitter.Authenticate")
+	principal, err := t.Twitter.Authenticate(ctx, secret)
+	end(span, err)
+	return principal, err
+}
+
+// end ends span, recording err if the call failed. Only internal errors fail the span, as the other kinds of
+// errors are the caller's doing.
+func end(span trace.Span, err error) {
+	if err != nil {
+		kind := models.ErrKindInternal
+		if e, ok := err.(models.Error); ok {
+			kind = e.Kind
+		}
+
+		span.RecordError(err)
+		span.SetAttributes(semconv.ErrorTypeKey.String(kind.String()))
+		if kind == models.ErrKindInternal {
+			span.SetStatus(codes.Error, err.Error())
+		}
+	}
+
+	span.End()
+}
+
+// NewTracedTwitter traces the calls to twitter with the tracers of provider
+func NewTracedTwitter(twitter Twitter, provider trace.TracerProvider) TracedTwitter {
+	return TracedTwitter{Twitter: twitter, tracer: provider.Tracer("simple_twitter/twitter")}
+}
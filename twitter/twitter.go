@@ -3,6 +3,7 @@ package twitter
 import (
 	"context"
 	"simple_twitter/models"
+	"slices"
 	"time"
 	"unicode/utf8"
 )
@@ -11,33 +12,102 @@ const (
 	MAX_PAGE_SIZE                 = 500 // Max number of tweets you can request at once
 	MAX_TWEET_MESSAGE_LENGTH_UTF8 = 140 // Max length of a tweet message (UTF8 length)
 	MAX_TWEET_TAG_LENGTH          = 32  // Max length of tag (byte length)
+	MAX_TWEET_TAGS                = 10  // Default max number of tags on a tweet, see WithMaxTags
 )
 
 type Twitter struct {
-	tweets TweetStorage
+	tweets  TweetStorage
+	users   UserStorage
+	tokens  TokenStorage
+	roles   Roles
+	index   SearchIndex
+	metrics Metrics
+	maxTags int
+}
+
+type Option func(*Twitter)
+
+// WithMaxTags sets the max number of tags a tweet can have
+func WithMaxTags(maxTags int) Option {
+	return func(t *Twitter) {
+		t.maxTags = maxTags
+	}
+}
+
+// WithSearchIndex searches tweets with index rather than the storage, for storages that can't search
+// themselves. The index is kept up to date with the tweets created, see IndexTweets to build it on startup.
+func WithSearchIndex(index SearchIndex) Option {
+	return func(t *Twitter) {
+		t.index = index
+	}
+}
+
+// WithMetrics counts the tweets created and edited with metrics
+func WithMetrics(metrics Metrics) Option {
+	return func(t *Twitter) {
+		t.metrics = metrics
+	}
+}
+
+// Metrics counts what happens to tweets
+type Metrics interface {
+	TweetCreated(tags int)
+	TweetEdited()
+}
+
+// SearchIndex finds the ids of tweets matching a search query, most relevant first
+type SearchIndex interface {
+	Add(tweet models.Tweet)
+	Remove(id int64)
+	Search(query models.SearchQuery) []int64
+}
+
+// Storage stores the tweets, the users who post them and the API tokens clients authenticate with
+type Storage interface {
+	TweetStorage
+	UserStorage
+	TokenStorage
 }
 
 type TweetStorage interface {
 	GetTweet(ctx context.Context, id int64) (models.Tweet, error)
-	ListTweets(ctx context.Context, tag string, offset int, limit int) ([]models.Tweet, error)
-	CreateTweet(ctx context.Context, message string, tag string) (int64, error)
+	GetTweets(ctx context.Context, ids []int64) ([]models.Tweet, error)
+	ListTweets(ctx context.Context, query models.TweetQuery) ([]models.Tweet, error)
+	CreateTweet(ctx context.Context, authorID *int64, message string, tags []string) (int64, error)
+	SearchTweets(ctx context.Context, query models.SearchQuery) ([]models.Tweet, error)
+	UpdateTweet(ctx context.Context, id int64, edit func(current models.Tweet) (message string, tags []string, err error)) error
+	ListRevisions(ctx context.Context, id int64) ([]models.TweetRevision, error)
+	DeleteTweet(ctx context.Context, id int64) error
+	PurgeTweets(ctx context.Context, deletedBefore time.Time) (int64, error)
 
 	AggregateTweetsByYear(ctx context.Context, from time.Time, to time.Time) ([]models.YearlyAggregate, error)
 	AggregateTweetsByMonth(ctx context.Context, from time.Time, to time.Time) ([]models.MonthlyAggregate, error)
 }
 
-func (t Twitter) CreateTweet(ctx context.Context, message string, tag string) (models.Tweet, error) {
+// CreateTweet posts a tweet on behalf of the user with authorID, or anonymously if authorID is nil
+func (t Twitter) CreateTweet(ctx context.Context, authorID *int64, message string, tags []string) (models.Tweet, error) {
 	err := validateMessage(message)
 	if err != nil {
 		return models.Tweet{}, err
 	}
 
-	err = validateTag(tag)
+	tags, err = t.validateTags(tags)
 	if err != nil {
 		return models.Tweet{}, err
 	}
 
-	id, err := t.tweets.CreateTweet(ctx, message, tag)
+	if authorID != nil {
+		_, err := t.users.GetUser(ctx, *authorID)
+		if e, ok := err.(models.Error); ok && e.Kind == models.ErrKindMissing {
+			return models.Tweet{}, models.ErrInvalidf("found no user with `author_id` %d", *authorID)
+		}
+
+		if err != nil {
+			return models.Tweet{}, models.ErrInternalWithCause("failed to get author of tweet", err)
+		}
+	}
+
+	id, err := t.tweets.CreateTweet(ctx, authorID, message, withHashtagTags(tags, message))
 	if err != nil {
 		return models.Tweet{}, models.ErrInternalWithCause("failed to create tweet", err)
 	}
@@ -47,28 +117,257 @@ func (t Twitter) CreateTweet(ctx context.Context, message string, tag string) (m
 		return models.Tweet{}, models.ErrInternalWithCause("failed to create tweet", err)
 	}
 
-	return tweet, nil
+	if t.index != nil {
+		t.index.Add(tweet)
+	}
+
+	if t.metrics != nil {
+		t.metrics.TweetCreated(len(tweet.Tags))
+	}
+
+	return withHashtags(tweet), nil
+}
+
+func (t Twitter) GetTweet(ctx context.Context, id int64) (models.Tweet, error) {
+	if id <= 0 {
+		return models.Tweet{}, models.ErrInvalid("`id` must be a positive integer")
+	}
+
+	tweet, err := t.tweets.GetTweet(ctx, id)
+	if e, ok := err.(models.Error); ok && (e.Kind == models.ErrKindMissing || e.Kind == models.ErrKindGone) {
+		return models.Tweet{}, e
+	}
+
+	if err != nil {
+		return models.Tweet{}, models.ErrInternalWithCause("failed to get tweet", err)
+	}
+
+	return withHashtags(tweet), nil
+}
+
+// EditTweet applies patch to the tweet with id on behalf of editor, see authorize. The version of the tweet it replaces is kept as a revision,
+// see ListRevisions. Tags that came from hashtags follow the new message, unless patch replaces the tags too.
+func (t Twitter) EditTweet(ctx context.Context, editor *models.Principal, id int64, patch models.TweetPatch) (models.Tweet, error) {
+	if id <= 0 {
+		return models.Tweet{}, models.ErrInvalid("`id` must be a positive integer")
+	}
+
+	if patch.Message == nil && patch.Tag == nil && patch.Tags == nil {
+		return models.Tweet{}, models.ErrInvalid("nothing to edit, set at least one of `message`, `tag` or `tags`")
+	}
+
+	// The patch is applied to the tweet as the storage reads it when updating it, so concurrent edits build on
+	// each other instead of overwriting one another
+	err := t.tweets.UpdateTweet(ctx, id, func(current models.Tweet) (string, []string, error) {
+		return t.applyPatch(editor, withHashtags(current), patch)
+	})
+	if e, ok := err.(models.Error); ok {
+		return models.Tweet{}, e
+	}
+
+	if err != nil {
+		return models.Tweet{}, models.ErrInternalWithCause("failed to edit tweet", err)
+	}
+
+	tweet, err := t.tweets.GetTweet(ctx, id)
+	if err != nil {
+		return models.Tweet{}, models.ErrInternalWithCause("failed to edit tweet", err)
+	}
+
+	if t.index != nil {
+		t.index.Add(tweet)
+	}
+
+	if t.metrics != nil {
+		t.metrics.TweetEdited()
+	}
+
+	return withHashtags(tweet), nil
+}
+
+// applyPatch returns the message and tags current has once patch is applied to it by editor
+func (t Twitter) applyPatch(editor *models.Principal, current models.Tweet, patch models.TweetPatch) (string, []string, error) {
+	err := authorize(editor, current, "edit")
+	if err != nil {
+		return "", nil, err
+	}
+
+	message := current.Message
+	if patch.Message != nil {
+		message = *patch.Message
+		err := validateMessage(message)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	var tags []string
+	if patch.Tag != nil || patch.Tags != nil {
+		tags = patch.Tags
+		if patch.Tag != nil && !slices.Contains(tags, *patch.Tag) {
+			tags = append([]string{*patch.Tag}, tags...)
+		}
+
+		tags, err = t.validateTags(tags)
+		if err != nil {
+			return "", nil, err
+		}
+	} else if len(current.Tags) > 0 {
+		// Keep the explicit tags, the first tag is always one even if the message has it as a hashtag too
+		tags = slices.Clone(current.Tags[:1])
+		for _, tag := range current.Tags[1:] {
+			if !slices.Contains(current.Hashtags, tag) {
+				tags = append(tags, tag)
+			}
+		}
+	}
+
+	// Tweets stored before every tweet had to have a tag can be left without one, unless the edit gives them one
+	tags = withHashtagTags(tags, message)
+	if len(tags) == 0 {
+		return "", nil, models.ErrInvalid("the tweet has no tags to keep, set `tag` or `tags` to edit it")
+	}
+
+	return message, tags, nil
+}
+
+// ListRevisions returns the prior versions of the tweet with id, oldest first
+func (t Twitter) ListRevisions(ctx context.Context, id int64) (models.TweetRevisions, error) {
+	if id <= 0 {
+		return models.TweetRevisions{}, models.ErrInvalid("`id` must be a positive integer")
+	}
+
+	revisions, err := t.tweets.ListRevisions(ctx, id)
+	if e, ok := err.(models.Error); ok && (e.Kind == models.ErrKindMissing || e.Kind == models.ErrKindGone) {
+		return models.TweetRevisions{}, e
+	}
+
+	if err != nil {
+		return models.TweetRevisions{}, models.ErrInternalWithCause("failed to list tweet revisions", err)
+	}
+
+	return models.TweetRevisions{Revisions: revisions}, nil
+}
+
+// DeleteTweet soft deletes the tweet with id on behalf of editor, like EditTweet. It's left out of listings,
+// searches and aggregates right away, but isn't removed from the storage until it's purged, see PurgeTweets.
+func (t Twitter) DeleteTweet(ctx context.Context, editor *models.Principal, id int64) error {
+	tweet, err := t.GetTweet(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = authorize(editor, tweet, "delete")
+	if err != nil {
+		return err
+	}
+
+	err = t.tweets.DeleteTweet(ctx, id)
+	if e, ok := err.(models.Error); ok && (e.Kind == models.ErrKindMissing || e.Kind == models.ErrKindGone) {
+		return e
+	}
+
+	if err != nil {
+		return models.ErrInternalWithCause("failed to delete tweet", err)
+	}
+
+	if t.index != nil {
+		t.index.Remove(id)
+	}
+
+	return nil
 }
 
-func (t Twitter) ListTweets(ctx context.Context, tag string, offset int, limit int) ([]models.Tweet, error) {
-	if offset < 0 {
-		return nil, models.ErrInvalid("`offset` can't be negative")
+// PurgeTweets permanently removes the tweets that were deleted more than retention ago from the storage, and
+// returns the number of tweets purged. A retention of zero purges every deleted tweet.
+func (t Twitter) PurgeTweets(ctx context.Context, retention time.Duration) (int64, error) {
+	if retention < 0 {
+		return 0, models.ErrInvalid("`retention` can't be negative")
+	}
+
+	purged, err := t.tweets.PurgeTweets(ctx, time.Now().Add(-retention))
+	if err != nil {
+		return 0, models.ErrInternalWithCause("failed to purge tweets", err)
+	}
+
+	return purged, nil
+}
+
+func (t Twitter) ListTweets(ctx context.Context, query models.TweetQuery) (models.TweetPage, error) {
+	if query.Offset < 0 {
+		return models.TweetPage{}, models.ErrInvalid("`offset` can't be negative")
+	}
+
+	if query.Limit < 0 {
+		return models.TweetPage{}, models.ErrInvalid("`limit` can't be negative")
+	}
+
+	if query.Offset > 0 && query.After != nil {
+		return models.TweetPage{}, models.ErrInvalid("`offset` can't be combined with `cursor`")
+	}
+
+	switch query.Sort {
+	case "":
+		query.Sort = models.SortOldest
+	case models.SortOldest, models.SortNewest:
+	default:
+		return models.TweetPage{}, models.ErrInvalid("`sort` must be one of [`newest`, `oldest`]")
+	}
+
+	if !query.Since.IsZero() && !query.Until.IsZero() && !query.Since.Before(query.Until) {
+		return models.TweetPage{}, models.ErrInvalid("`since` must be before `until`")
+	}
+
+	if query.SinceID < 0 {
+		return models.TweetPage{}, models.ErrInvalid("`since_id` can't be negative")
+	}
+
+	if query.MaxID < 0 {
+		return models.TweetPage{}, models.ErrInvalid("`max_id` can't be negative")
+	}
+
+	if query.SinceID > 0 && query.MaxID > 0 && query.SinceID >= query.MaxID {
+		return models.TweetPage{}, models.ErrInvalid("`since_id` must be less than `max_id`")
+	}
+
+	if query.AuthorID < 0 {
+		return models.TweetPage{}, models.ErrInvalid("`author_id` can't be negative")
 	}
 
-	if tag == "" {
-		return []models.Tweet{}, nil
+	if query.Tag != "" {
+		tag, err := NormalizeTag(query.Tag)
+		if err != nil {
+			return models.TweetPage{}, err
+		}
+		query.Tag = tag
 	}
 
-	if limit > MAX_PAGE_SIZE {
-		limit = MAX_PAGE_SIZE
+	if query.Limit > MAX_PAGE_SIZE {
+		query.Limit = MAX_PAGE_SIZE
 	}
 
-	tweets, err := t.tweets.ListTweets(ctx, tag, offset, limit)
+	// Ask for one more tweet than requested to know whether there is a next page
+	limit := query.Limit
+	query.Limit++
+
+	tweets, err := t.tweets.ListTweets(ctx, query)
 	if err != nil {
-		return nil, models.ErrInternalWithCause("failed to create tweet", err)
+		return models.TweetPage{}, models.ErrInternalWithCause("failed to list tweets", err)
+	}
+
+	for idx := range tweets {
+		tweets[idx] = withHashtags(tweets[idx])
 	}
 
-	return tweets, nil
+	page := models.TweetPage{Tweets: tweets}
+	if len(tweets) > limit {
+		page.Tweets = tweets[:limit]
+		if limit > 0 {
+			page.NextCursor = models.CursorOf(page.Tweets[limit-1])
+		}
+	}
+
+	return page, nil
 }
 
 func (t Twitter) AggregateTweets(ctx context.Context, from time.Time, to time.Time, groupBy string) (models.AggregatedTweets, error) {
@@ -119,16 +418,72 @@ func (t Twitter) AggregateTweets(ctx context.Context, from time.Time, to time.Ti
 	}, nil
 }
 
-func validateTag(tag string) error {
-	if tag == "" {
-		return models.ErrInvalid("`tag` can't be empty")
+// authorize returns an error unless editor can change tweet. Users can change the tweets they posted, every other
+// tweet, including anonymous ones, can only be changed with the `tweets:moderate` scope. A nil editor means requests
+// aren't authenticated, which trusts everyone to change every tweet.
+func authorize(editor *models.Principal, tweet models.Tweet, change string) error {
+	if editor == nil || editor.HasScope(models.ScopeTweetsModerate) {
+		return nil
 	}
 
-	if len(tag) > MAX_TWEET_TAG_LENGTH {
-		return models.ErrInvalidf("`tag` is too long, must be shorter than %d bytes", MAX_TWEET_TAG_LENGTH)
+	if editor.UserID != nil && tweet.AuthorID != nil && *editor.UserID == *tweet.AuthorID {
+		return nil
 	}
 
-	return nil
+	if editor.UserID == nil {
+		return models.ErrForbiddenf("only the author of a tweet, or a token with the `%s` scope, can %s it", models.ScopeTweetsModerate, change)
+	}
+
+	return models.ErrForbiddenf("can't %s the tweets of another user", change)
+}
+
+// validateTags validates each of tags and returns their canonical forms with duplicates removed
+func (t Twitter) validateTags(tags []string) ([]string, error) {
+	if len(tags) == 0 {
+		return nil, models.ErrInvalid("`tag` can't be empty")
+	}
+
+	var unique []string
+	for _, tag := range tags {
+		tag, err := NormalizeTag(tag)
+		if err != nil {
+			return nil, err
+		}
+
+		if !slices.Contains(unique, tag) {
+			unique = append(unique, tag)
+		}
+	}
+
+	if len(unique) > t.maxTags {
+		return nil, models.ErrInvalidf("too many `tags`, a tweet can have at most %d tags", t.maxTags)
+	}
+
+	return unique, nil
+}
+
+// withHashtagTags appends the hashtags of message to tags. Hashtags are indexed as tags too, so the tweet can
+// be listed by them. They don't count towards the max number of tags as the message length already limits how
+// many there can be.
+func withHashtagTags(tags []string, message string) []string {
+	tags = slices.Clone(tags)
+	for _, hashtag := range extractHashtags(message) {
+		if !slices.Contains(tags, hashtag) {
+			tags = append(tags, hashtag)
+		}
+	}
+
+	return tags
+}
+
+// withHashtags populates the hashtags of tweet from its message
+func withHashtags(tweet models.Tweet) models.Tweet {
+	tweet.Hashtags = extractHashtags(tweet.Message)
+	if tweet.Hashtags == nil {
+		tweet.Hashtags = []string{}
+	}
+
+	return tweet
 }
 
 func validateMessage(message string) error {
@@ -143,6 +498,11 @@ func validateMessage(message string) error {
 	return nil
 }
 
-func NewTwitter(tweets TweetStorage) Twitter {
-	return Twitter{tweets}
+func NewTwitter(storage Storage, options ...Option) Twitter {
+	t := Twitter{tweets: storage, users: storage, tokens: storage, roles: DefaultRoles(), maxTags: MAX_TWEET_TAGS}
+	for _, option := range options {
+		option(&t)
+	}
+
+	return t
 }
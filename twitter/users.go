@@ -0,0 +1,97 @@
+package twitter
+
+import (
+	"context"
+	"simple_twitter/models"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	MAX_USER_HANDLE_LENGTH            = 15 // Max length of a user handle (byte length, handles are ASCII)
+	MAX_USER_DISPLAY_NAME_LENGTH_UTF8 = 50 // Max length of a user's display name (UTF8 length)
+)
+
+type UserStorage interface {
+	CreateUser(ctx context.Context, handle string, displayName string) (int64, error)
+	GetUser(ctx context.Context, id int64) (models.User, error)
+	GetUserByHandle(ctx context.Context, handle string) (models.User, error)
+}
+
+func (t Twitter) CreateUser(ctx context.Context, handle string, displayName string) (models.User, error) {
+	handle, err := NormalizeHandle(handle)
+	if err != nil {
+		return models.User{}, err
+	}
+
+	displayName = strings.TrimSpace(displayName)
+	if utf8.RuneCountInString(displayName) > MAX_USER_DISPLAY_NAME_LENGTH_UTF8 {
+		return models.User{}, models.ErrInvalidf("`display_name` is too long, must be at most %d code points", MAX_USER_DISPLAY_NAME_LENGTH_UTF8)
+	}
+
+	id, err := t.users.CreateUser(ctx, handle, displayName)
+	if e, ok := err.(models.Error); ok && e.Kind == models.ErrKindConflict {
+		return models.User{}, e
+	}
+
+	if err != nil {
+		return models.User{}, models.ErrInternalWithCause("failed to create user", err)
+	}
+
+	user, err := t.users.GetUser(ctx, id)
+	if err != nil {
+		return models.User{}, models.ErrInternalWithCause("failed to create user", err)
+	}
+
+	return user, nil
+}
+
+func (t Twitter) GetUser(ctx context.Context, handle string) (models.User, error) {
+	handle, err := NormalizeHandle(handle)
+	if err != nil {
+		return models.User{}, err
+	}
+
+	user, err := t.users.GetUserByHandle(ctx, handle)
+	if e, ok := err.(models.Error); ok && e.Kind == models.ErrKindMissing {
+		return models.User{}, e
+	}
+
+	if err != nil {
+		return models.User{}, models.ErrInternalWithCause("failed to get user", err)
+	}
+
+	return user, nil
+}
+
+// ListUserTweets lists the tweets posted by the user with handle, query is applied the same way as by ListTweets
+func (t Twitter) ListUserTweets(ctx context.Context, handle string, query models.TweetQuery) (models.TweetPage, error) {
+	user, err := t.GetUser(ctx, handle)
+	if err != nil {
+		return models.TweetPage{}, err
+	}
+
+	query.AuthorID = user.ID
+	return t.ListTweets(ctx, query)
+}
+
+// NormalizeHandle validates handle and returns its canonical form. Handles are case insensitive, so the canonical
+// form is lower case, and can only contain ASCII letters, digits and `_` to be usable in URLs as is.
+func NormalizeHandle(handle string) (string, error) {
+	handle = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(handle), "@"))
+	if handle == "" {
+		return "", models.ErrInvalid("`handle` can't be empty")
+	}
+
+	if len(handle) > MAX_USER_HANDLE_LENGTH {
+		return "", models.ErrInvalidf("`handle` is too long, must be at most %d characters", MAX_USER_HANDLE_LENGTH)
+	}
+
+	for _, r := range handle {
+		if !(r >= 'a' && r <= 'z') && !(r >= '0' && r <= '9') && r != '_' {
+			return "", models.ErrInvalidf("`handle` can only contain letters a to z, digits and `_`, found %q", r)
+		}
+	}
+
+	return handle, nil
+}